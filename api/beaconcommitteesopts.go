@@ -0,0 +1,29 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/jefmcl/go-eth2-client/spec/phase0"
+
+// BeaconCommitteesOpts are the options for obtaining beacon committees.
+type BeaconCommitteesOpts struct {
+	// State is the state at which the committees are obtained.
+	State string
+	// Epoch is the epoch for which the committees are obtained.
+	// If not supplied the committees for the epoch of the state are obtained.
+	Epoch *phase0.Epoch
+	// Slot restricts the committees to those for the given slot.
+	Slot *phase0.Slot
+	// Index restricts the committees to those with the given committee index.
+	Index *phase0.CommitteeIndex
+}
@@ -0,0 +1,25 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/jefmcl/go-eth2-client/spec/phase0"
+
+// BeaconStateRandaoOpts are the options for obtaining the RANDAO of a beacon state.
+type BeaconStateRandaoOpts struct {
+	// State is the state from which the RANDAO is obtained.
+	State string
+	// Epoch is the epoch for which the RANDAO is obtained.
+	// If not supplied the RANDAO for the epoch of the state is obtained.
+	Epoch *phase0.Epoch
+}
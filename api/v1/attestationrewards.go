@@ -0,0 +1,291 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationRewards contains the attestation rewards for an epoch.
+type AttestationRewards struct {
+	// IdealRewards are the maximum rewards obtainable by validators for each effective balance.
+	IdealRewards []*IdealAttestationRewards
+	// TotalRewards are the rewards obtained by each validator.
+	TotalRewards []*ValidatorAttestationRewards
+}
+
+// attestationRewardsJSON is the spec representation of the struct.
+type attestationRewardsJSON struct {
+	IdealRewards []*IdealAttestationRewards     `json:"ideal_rewards"`
+	TotalRewards []*ValidatorAttestationRewards `json:"total_rewards"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationRewards) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationRewardsJSON{
+		IdealRewards: a.IdealRewards,
+		TotalRewards: a.TotalRewards,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationRewards) UnmarshalJSON(input []byte) error {
+	var attestationRewardsJSON attestationRewardsJSON
+	if err := json.Unmarshal(input, &attestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if attestationRewardsJSON.IdealRewards == nil {
+		return errors.New("ideal rewards missing")
+	}
+	a.IdealRewards = attestationRewardsJSON.IdealRewards
+	if attestationRewardsJSON.TotalRewards == nil {
+		return errors.New("total rewards missing")
+	}
+	a.TotalRewards = attestationRewardsJSON.TotalRewards
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (a *AttestationRewards) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// IdealAttestationRewards contains the maximum attestation rewards obtainable by a validator with a given effective balance.
+type IdealAttestationRewards struct {
+	// EffectiveBalance is the effective balance to which the rewards apply.
+	EffectiveBalance phase0.Gwei
+	// Head is the reward for a correct head vote.
+	Head int64
+	// Target is the reward for a correct target vote.
+	Target int64
+	// Source is the reward for a correct source vote.
+	Source int64
+	// InclusionDelay is the reward for timely inclusion.  It is only present for phase 0.
+	InclusionDelay *int64
+	// Inactivity is the inactivity penalty.
+	Inactivity int64
+}
+
+// idealAttestationRewardsJSON is the spec representation of the struct.
+type idealAttestationRewardsJSON struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	InclusionDelay   string `json:"inclusion_delay,omitempty"`
+	Inactivity       string `json:"inactivity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *IdealAttestationRewards) MarshalJSON() ([]byte, error) {
+	idealAttestationRewardsJSON := &idealAttestationRewardsJSON{
+		EffectiveBalance: fmt.Sprintf("%d", i.EffectiveBalance),
+		Head:             fmt.Sprintf("%d", i.Head),
+		Target:           fmt.Sprintf("%d", i.Target),
+		Source:           fmt.Sprintf("%d", i.Source),
+		Inactivity:       fmt.Sprintf("%d", i.Inactivity),
+	}
+	if i.InclusionDelay != nil {
+		idealAttestationRewardsJSON.InclusionDelay = fmt.Sprintf("%d", *i.InclusionDelay)
+	}
+
+	return json.Marshal(idealAttestationRewardsJSON)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *IdealAttestationRewards) UnmarshalJSON(input []byte) error {
+	var idealAttestationRewardsJSON idealAttestationRewardsJSON
+	if err := json.Unmarshal(input, &idealAttestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if idealAttestationRewardsJSON.EffectiveBalance == "" {
+		return errors.New("effective balance missing")
+	}
+	effectiveBalance, err := strconv.ParseUint(idealAttestationRewardsJSON.EffectiveBalance, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for effective balance")
+	}
+	i.EffectiveBalance = phase0.Gwei(effectiveBalance)
+	if idealAttestationRewardsJSON.Head == "" {
+		return errors.New("head missing")
+	}
+	head, err := strconv.ParseInt(idealAttestationRewardsJSON.Head, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for head")
+	}
+	i.Head = head
+	if idealAttestationRewardsJSON.Target == "" {
+		return errors.New("target missing")
+	}
+	target, err := strconv.ParseInt(idealAttestationRewardsJSON.Target, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for target")
+	}
+	i.Target = target
+	if idealAttestationRewardsJSON.Source == "" {
+		return errors.New("source missing")
+	}
+	source, err := strconv.ParseInt(idealAttestationRewardsJSON.Source, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for source")
+	}
+	i.Source = source
+	if idealAttestationRewardsJSON.InclusionDelay != "" {
+		inclusionDelay, err := strconv.ParseInt(idealAttestationRewardsJSON.InclusionDelay, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for inclusion delay")
+		}
+		i.InclusionDelay = &inclusionDelay
+	}
+	if idealAttestationRewardsJSON.Inactivity == "" {
+		return errors.New("inactivity missing")
+	}
+	inactivity, err := strconv.ParseInt(idealAttestationRewardsJSON.Inactivity, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for inactivity")
+	}
+	i.Inactivity = inactivity
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *IdealAttestationRewards) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// ValidatorAttestationRewards contains the attestation rewards obtained by a validator.
+type ValidatorAttestationRewards struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Head is the reward for the head vote.
+	Head int64
+	// Target is the reward or penalty for the target vote.
+	Target int64
+	// Source is the reward or penalty for the source vote.
+	Source int64
+	// InclusionDelay is the reward for timely inclusion.  It is only present for phase 0.
+	InclusionDelay *int64
+	// Inactivity is the inactivity penalty.
+	Inactivity int64
+}
+
+// validatorAttestationRewardsJSON is the spec representation of the struct.
+type validatorAttestationRewardsJSON struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	InclusionDelay string `json:"inclusion_delay,omitempty"`
+	Inactivity     string `json:"inactivity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorAttestationRewards) MarshalJSON() ([]byte, error) {
+	validatorAttestationRewardsJSON := &validatorAttestationRewardsJSON{
+		ValidatorIndex: fmt.Sprintf("%d", v.ValidatorIndex),
+		Head:           fmt.Sprintf("%d", v.Head),
+		Target:         fmt.Sprintf("%d", v.Target),
+		Source:         fmt.Sprintf("%d", v.Source),
+		Inactivity:     fmt.Sprintf("%d", v.Inactivity),
+	}
+	if v.InclusionDelay != nil {
+		validatorAttestationRewardsJSON.InclusionDelay = fmt.Sprintf("%d", *v.InclusionDelay)
+	}
+
+	return json.Marshal(validatorAttestationRewardsJSON)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorAttestationRewards) UnmarshalJSON(input []byte) error {
+	var validatorAttestationRewardsJSON validatorAttestationRewardsJSON
+	if err := json.Unmarshal(input, &validatorAttestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if validatorAttestationRewardsJSON.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(validatorAttestationRewardsJSON.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	v.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+	if validatorAttestationRewardsJSON.Head == "" {
+		return errors.New("head missing")
+	}
+	head, err := strconv.ParseInt(validatorAttestationRewardsJSON.Head, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for head")
+	}
+	v.Head = head
+	if validatorAttestationRewardsJSON.Target == "" {
+		return errors.New("target missing")
+	}
+	target, err := strconv.ParseInt(validatorAttestationRewardsJSON.Target, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for target")
+	}
+	v.Target = target
+	if validatorAttestationRewardsJSON.Source == "" {
+		return errors.New("source missing")
+	}
+	source, err := strconv.ParseInt(validatorAttestationRewardsJSON.Source, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for source")
+	}
+	v.Source = source
+	if validatorAttestationRewardsJSON.InclusionDelay != "" {
+		inclusionDelay, err := strconv.ParseInt(validatorAttestationRewardsJSON.InclusionDelay, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for inclusion delay")
+		}
+		v.InclusionDelay = &inclusionDelay
+	}
+	if validatorAttestationRewardsJSON.Inactivity == "" {
+		return errors.New("inactivity missing")
+	}
+	inactivity, err := strconv.ParseInt(validatorAttestationRewardsJSON.Inactivity, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for inactivity")
+	}
+	v.Inactivity = inactivity
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorAttestationRewards) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestBlockRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.blockRewardsJSON",
+		},
+		{
+			name:  "ProposerIndexMissing",
+			input: []byte(`{"total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "proposer index missing",
+		},
+		{
+			name:  "ProposerIndexInvalid",
+			input: []byte(`{"proposer_index":"invalid","total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "invalid value for proposer index: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "TotalMissing",
+			input: []byte(`{"proposer_index":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "total missing",
+		},
+		{
+			name:  "TotalInvalid",
+			input: []byte(`{"proposer_index":"123","total":"invalid","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "invalid value for total: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "AttestationsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "attestations missing",
+		},
+		{
+			name:  "AttestationsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"invalid","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "invalid value for attestations: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "SyncAggregateMissing",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "sync aggregate missing",
+		},
+		{
+			name:  "SyncAggregateInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"invalid","proposer_slashings":"2","attester_slashings":"1"}`),
+			err:   "invalid value for sync aggregate: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "ProposerSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"20","attester_slashings":"1"}`),
+			err:   "proposer slashings missing",
+		},
+		{
+			name:  "ProposerSlashingsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"invalid","attester_slashings":"1"}`),
+			err:   "invalid value for proposer slashings: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "AttesterSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2"}`),
+			err:   "attester slashings missing",
+		},
+		{
+			name:  "AttesterSlashingsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"invalid"}`),
+			err:   "invalid value for attester slashings: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"proposer_index":"123","total":"123","attestations":"100","sync_aggregate":"20","proposer_slashings":"2","attester_slashings":"1"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BlockRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainHead is the head of a chain known to a beacon node.
+type ChainHead struct {
+	// Root is the root of the head block.
+	Root phase0.Root
+	// Slot is the slot of the head block.
+	Slot phase0.Slot
+	// ExecutionOptimistic is true if the head block has not had its execution
+	// payload verified.
+	ExecutionOptimistic bool
+}
+
+// chainHeadJSON is the spec representation of the struct.
+type chainHeadJSON struct {
+	Root                string `json:"root"`
+	Slot                string `json:"slot"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ChainHead) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&chainHeadJSON{
+		Root:                fmt.Sprintf("%#x", c.Root),
+		Slot:                fmt.Sprintf("%d", c.Slot),
+		ExecutionOptimistic: c.ExecutionOptimistic,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChainHead) UnmarshalJSON(input []byte) error {
+	var chainHeadJSON chainHeadJSON
+	if err := json.Unmarshal(input, &chainHeadJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if chainHeadJSON.Root == "" {
+		return errors.New("root missing")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(chainHeadJSON.Root, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for root")
+	}
+	if len(root) != phase0.RootLength {
+		return errors.New("incorrect length for root")
+	}
+	copy(c.Root[:], root)
+	if chainHeadJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(chainHeadJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	c.Slot = phase0.Slot(slot)
+	c.ExecutionOptimistic = chainHeadJSON.ExecutionOptimistic
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (c *ChainHead) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainHeadJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.chainHeadJSON",
+		},
+		{
+			name:  "RootMissing",
+			input: []byte(`{"slot":"1","execution_optimistic":false}`),
+			err:   "root missing",
+		},
+		{
+			name:  "RootInvalid",
+			input: []byte(`{"root":"invalid","slot":"1","execution_optimistic":false}`),
+			err:   "invalid value for root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "RootShort",
+			input: []byte(`{"root":"0x0101","slot":"1","execution_optimistic":false}`),
+			err:   "incorrect length for root",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"root":"0x0101010101010101010101010101010101010101010101010101010101010101","slot":"-1","execution_optimistic":false}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"root":"0x0101010101010101010101010101010101010101010101010101010101010101","slot":"1","execution_optimistic":false}`),
+		},
+		{
+			name:  "GoodOptimistic",
+			input: []byte(`{"root":"0x0101010101010101010101010101010101010101010101010101010101010101","slot":"1","execution_optimistic":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ChainHead
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
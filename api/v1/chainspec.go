@@ -0,0 +1,450 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainSpec is the specification of a chain, comprising its configuration,
+// preset and constant values.
+// Each field is tagged with the name of the key that provides it.
+type ChainSpec struct {
+	// Configuration.
+	PresetBase                       string                     `spec:"PRESET_BASE"`
+	ConfigName                       string                     `spec:"CONFIG_NAME"`
+	TerminalTotalDifficulty          *big.Int                   `spec:"TERMINAL_TOTAL_DIFFICULTY"`
+	TerminalBlockHash                phase0.Hash32              `spec:"TERMINAL_BLOCK_HASH"`
+	TerminalBlockHashActivationEpoch phase0.Epoch               `spec:"TERMINAL_BLOCK_HASH_ACTIVATION_EPOCH"`
+	MinGenesisActiveValidatorCount   uint64                     `spec:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"`
+	MinGenesisTime                   time.Time                  `spec:"MIN_GENESIS_TIME"`
+	GenesisForkVersion               phase0.Version             `spec:"GENESIS_FORK_VERSION"`
+	GenesisDelay                     time.Duration              `spec:"GENESIS_DELAY"`
+	AltairForkVersion                phase0.Version             `spec:"ALTAIR_FORK_VERSION"`
+	AltairForkEpoch                  phase0.Epoch               `spec:"ALTAIR_FORK_EPOCH"`
+	BellatrixForkVersion             phase0.Version             `spec:"BELLATRIX_FORK_VERSION"`
+	BellatrixForkEpoch               phase0.Epoch               `spec:"BELLATRIX_FORK_EPOCH"`
+	CapellaForkVersion               phase0.Version             `spec:"CAPELLA_FORK_VERSION"`
+	CapellaForkEpoch                 phase0.Epoch               `spec:"CAPELLA_FORK_EPOCH"`
+	DenebForkVersion                 phase0.Version             `spec:"DENEB_FORK_VERSION"`
+	DenebForkEpoch                   phase0.Epoch               `spec:"DENEB_FORK_EPOCH"`
+	SecondsPerSlot                   time.Duration              `spec:"SECONDS_PER_SLOT"`
+	SecondsPerETH1Block              time.Duration              `spec:"SECONDS_PER_ETH1_BLOCK"`
+	MinValidatorWithdrawabilityDelay uint64                     `spec:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	ShardCommitteePeriod             uint64                     `spec:"SHARD_COMMITTEE_PERIOD"`
+	ETH1FollowDistance               uint64                     `spec:"ETH1_FOLLOW_DISTANCE"`
+	InactivityScoreBias              uint64                     `spec:"INACTIVITY_SCORE_BIAS"`
+	InactivityScoreRecoveryRate      uint64                     `spec:"INACTIVITY_SCORE_RECOVERY_RATE"`
+	EjectionBalance                  phase0.Gwei                `spec:"EJECTION_BALANCE"`
+	MinPerEpochChurnLimit            uint64                     `spec:"MIN_PER_EPOCH_CHURN_LIMIT"`
+	ChurnLimitQuotient               uint64                     `spec:"CHURN_LIMIT_QUOTIENT"`
+	MaxPerEpochActivationChurnLimit  uint64                     `spec:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+	ProposerScoreBoost               uint64                     `spec:"PROPOSER_SCORE_BOOST"`
+	DepositChainID                   uint64                     `spec:"DEPOSIT_CHAIN_ID"`
+	DepositNetworkID                 uint64                     `spec:"DEPOSIT_NETWORK_ID"`
+	DepositContractAddress           bellatrix.ExecutionAddress `spec:"DEPOSIT_CONTRACT_ADDRESS"`
+
+	// Phase 0 preset.
+	MaxCommitteesPerSlot           uint64      `spec:"MAX_COMMITTEES_PER_SLOT"`
+	TargetCommitteeSize            uint64      `spec:"TARGET_COMMITTEE_SIZE"`
+	MaxValidatorsPerCommittee      uint64      `spec:"MAX_VALIDATORS_PER_COMMITTEE"`
+	ShuffleRoundCount              uint64      `spec:"SHUFFLE_ROUND_COUNT"`
+	HysteresisQuotient             uint64      `spec:"HYSTERESIS_QUOTIENT"`
+	HysteresisDownwardMultiplier   uint64      `spec:"HYSTERESIS_DOWNWARD_MULTIPLIER"`
+	HysteresisUpwardMultiplier     uint64      `spec:"HYSTERESIS_UPWARD_MULTIPLIER"`
+	MinDepositAmount               phase0.Gwei `spec:"MIN_DEPOSIT_AMOUNT"`
+	MaxEffectiveBalance            phase0.Gwei `spec:"MAX_EFFECTIVE_BALANCE"`
+	EffectiveBalanceIncrement      phase0.Gwei `spec:"EFFECTIVE_BALANCE_INCREMENT"`
+	MinAttestationInclusionDelay   uint64      `spec:"MIN_ATTESTATION_INCLUSION_DELAY"`
+	SlotsPerEpoch                  uint64      `spec:"SLOTS_PER_EPOCH"`
+	MinSeedLookahead               uint64      `spec:"MIN_SEED_LOOKAHEAD"`
+	MaxSeedLookahead               uint64      `spec:"MAX_SEED_LOOKAHEAD"`
+	EpochsPerETH1VotingPeriod      uint64      `spec:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
+	SlotsPerHistoricalRoot         uint64      `spec:"SLOTS_PER_HISTORICAL_ROOT"`
+	MinEpochsToInactivityPenalty   uint64      `spec:"MIN_EPOCHS_TO_INACTIVITY_PENALTY"`
+	EpochsPerHistoricalVector      uint64      `spec:"EPOCHS_PER_HISTORICAL_VECTOR"`
+	EpochsPerSlashingsVector       uint64      `spec:"EPOCHS_PER_SLASHINGS_VECTOR"`
+	HistoricalRootsLimit           uint64      `spec:"HISTORICAL_ROOTS_LIMIT"`
+	ValidatorRegistryLimit         uint64      `spec:"VALIDATOR_REGISTRY_LIMIT"`
+	BaseRewardFactor               uint64      `spec:"BASE_REWARD_FACTOR"`
+	WhistleblowerRewardQuotient    uint64      `spec:"WHISTLEBLOWER_REWARD_QUOTIENT"`
+	ProposerRewardQuotient         uint64      `spec:"PROPOSER_REWARD_QUOTIENT"`
+	InactivityPenaltyQuotient      uint64      `spec:"INACTIVITY_PENALTY_QUOTIENT"`
+	MinSlashingPenaltyQuotient     uint64      `spec:"MIN_SLASHING_PENALTY_QUOTIENT"`
+	ProportionalSlashingMultiplier uint64      `spec:"PROPORTIONAL_SLASHING_MULTIPLIER"`
+	MaxProposerSlashings           uint64      `spec:"MAX_PROPOSER_SLASHINGS"`
+	MaxAttesterSlashings           uint64      `spec:"MAX_ATTESTER_SLASHINGS"`
+	MaxAttestations                uint64      `spec:"MAX_ATTESTATIONS"`
+	MaxDeposits                    uint64      `spec:"MAX_DEPOSITS"`
+	MaxVoluntaryExits              uint64      `spec:"MAX_VOLUNTARY_EXITS"`
+
+	// Altair preset.
+	InactivityPenaltyQuotientAltair      uint64 `spec:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
+	MinSlashingPenaltyQuotientAltair     uint64 `spec:"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR"`
+	ProportionalSlashingMultiplierAltair uint64 `spec:"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR"`
+	SyncCommitteeSize                    uint64 `spec:"SYNC_COMMITTEE_SIZE"`
+	EpochsPerSyncCommitteePeriod         uint64 `spec:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	MinSyncCommitteeParticipants         uint64 `spec:"MIN_SYNC_COMMITTEE_PARTICIPANTS"`
+	UpdateTimeout                        uint64 `spec:"UPDATE_TIMEOUT"`
+
+	// Bellatrix preset.
+	InactivityPenaltyQuotientBellatrix      uint64 `spec:"INACTIVITY_PENALTY_QUOTIENT_BELLATRIX"`
+	MinSlashingPenaltyQuotientBellatrix     uint64 `spec:"MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX"`
+	ProportionalSlashingMultiplierBellatrix uint64 `spec:"PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX"`
+	MaxBytesPerTransaction                  uint64 `spec:"MAX_BYTES_PER_TRANSACTION"`
+	MaxTransactionsPerPayload               uint64 `spec:"MAX_TRANSACTIONS_PER_PAYLOAD"`
+	BytesPerLogsBloom                       uint64 `spec:"BYTES_PER_LOGS_BLOOM"`
+	MaxExtraDataBytes                       uint64 `spec:"MAX_EXTRA_DATA_BYTES"`
+
+	// Capella preset.
+	MaxBLSToExecutionChanges         uint64 `spec:"MAX_BLS_TO_EXECUTION_CHANGES"`
+	MaxWithdrawalsPerPayload         uint64 `spec:"MAX_WITHDRAWALS_PER_PAYLOAD"`
+	MaxValidatorsPerWithdrawalsSweep uint64 `spec:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"`
+
+	// Deneb preset.
+	FieldElementsPerBlob       uint64 `spec:"FIELD_ELEMENTS_PER_BLOB"`
+	MaxBlobCommitmentsPerBlock uint64 `spec:"MAX_BLOB_COMMITMENTS_PER_BLOCK"`
+	MaxBlobsPerBlock           uint64 `spec:"MAX_BLOBS_PER_BLOCK"`
+
+	// Constants.
+	TargetAggregatorsPerCommittee     uint64            `spec:"TARGET_AGGREGATORS_PER_COMMITTEE"`
+	DomainBeaconProposer              phase0.DomainType `spec:"DOMAIN_BEACON_PROPOSER"`
+	DomainBeaconAttester              phase0.DomainType `spec:"DOMAIN_BEACON_ATTESTER"`
+	DomainRANDAO                      phase0.DomainType `spec:"DOMAIN_RANDAO"`
+	DomainDeposit                     phase0.DomainType `spec:"DOMAIN_DEPOSIT"`
+	DomainVoluntaryExit               phase0.DomainType `spec:"DOMAIN_VOLUNTARY_EXIT"`
+	DomainSelectionProof              phase0.DomainType `spec:"DOMAIN_SELECTION_PROOF"`
+	DomainAggregateAndProof           phase0.DomainType `spec:"DOMAIN_AGGREGATE_AND_PROOF"`
+	DomainSyncCommittee               phase0.DomainType `spec:"DOMAIN_SYNC_COMMITTEE"`
+	DomainSyncCommitteeSelectionProof phase0.DomainType `spec:"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF"`
+	DomainContributionAndProof        phase0.DomainType `spec:"DOMAIN_CONTRIBUTION_AND_PROOF"`
+	DomainBLSToExecutionChange        phase0.DomainType `spec:"DOMAIN_BLS_TO_EXECUTION_CHANGE"`
+	DomainBlobSidecar                 phase0.DomainType `spec:"DOMAIN_BLOB_SIDECAR"`
+	DomainApplicationMask             phase0.DomainType `spec:"DOMAIN_APPLICATION_MASK"`
+	DomainApplicationBuilder          phase0.DomainType `spec:"DOMAIN_APPLICATION_BUILDER"`
+
+	// Extra contains the values of keys that are not otherwise part of the
+	// chain spec, or whose values could not be parsed, as supplied.
+	Extra map[string]string
+}
+
+// chainSpecDefaults are the values of keys that are not always supplied.
+// Configuration files do not contain constants, and fork epochs for forks
+// that are not scheduled may be omitted.
+var chainSpecDefaults = map[string]string{
+	"TERMINAL_BLOCK_HASH_ACTIVATION_EPOCH":  "18446744073709551615",
+	"ALTAIR_FORK_EPOCH":                     "18446744073709551615",
+	"BELLATRIX_FORK_EPOCH":                  "18446744073709551615",
+	"CAPELLA_FORK_EPOCH":                    "18446744073709551615",
+	"DENEB_FORK_EPOCH":                      "18446744073709551615",
+	"TARGET_AGGREGATORS_PER_COMMITTEE":      "16",
+	"DOMAIN_BEACON_PROPOSER":                "0x00000000",
+	"DOMAIN_BEACON_ATTESTER":                "0x01000000",
+	"DOMAIN_RANDAO":                         "0x02000000",
+	"DOMAIN_DEPOSIT":                        "0x03000000",
+	"DOMAIN_VOLUNTARY_EXIT":                 "0x04000000",
+	"DOMAIN_SELECTION_PROOF":                "0x05000000",
+	"DOMAIN_AGGREGATE_AND_PROOF":            "0x06000000",
+	"DOMAIN_SYNC_COMMITTEE":                 "0x07000000",
+	"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF": "0x08000000",
+	"DOMAIN_CONTRIBUTION_AND_PROOF":         "0x09000000",
+	"DOMAIN_BLS_TO_EXECUTION_CHANGE":        "0x0a000000",
+	"DOMAIN_BLOB_SIDECAR":                   "0x0b000000",
+	"DOMAIN_APPLICATION_MASK":               "0x00000001",
+	"DOMAIN_APPLICATION_BUILDER":            "0x00000001",
+}
+
+// chainSpecRequiredKeys are the keys whose values must be supplied and parsed
+// for the chain spec to be usable.
+var chainSpecRequiredKeys = []string{
+	"GENESIS_FORK_VERSION",
+	"ALTAIR_FORK_EPOCH",
+	"BELLATRIX_FORK_EPOCH",
+	"CAPELLA_FORK_EPOCH",
+	"DENEB_FORK_EPOCH",
+	"SECONDS_PER_SLOT",
+	"SLOTS_PER_EPOCH",
+}
+
+var (
+	bigIntPtrType = reflect.TypeOf(&big.Int{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// chainSpecFields maps keys to the index of the chain spec field that holds their value.
+var chainSpecFields = func() map[string]int {
+	res := make(map[string]int)
+	chainSpecType := reflect.TypeOf(ChainSpec{})
+	for i := 0; i < chainSpecType.NumField(); i++ {
+		if key, exists := chainSpecType.Field(i).Tag.Lookup("spec"); exists {
+			res[key] = i
+		}
+	}
+	return res
+}()
+
+// ChainSpecFromMap creates a chain spec from a map of keys to values, as
+// returned by the beacon node API.
+// Keys that are not part of the chain spec, or whose values cannot be parsed,
+// are retained in Extra.  Keys that are required for the chain spec to be usable
+// must be present, and their values must parse.
+func ChainSpecFromMap(data map[string]string) (*ChainSpec, error) {
+	values := make(map[string]string, len(chainSpecDefaults)+len(data))
+	for k, v := range chainSpecDefaults {
+		values[k] = v
+	}
+	for k, v := range data {
+		values[k] = v
+	}
+	for _, k := range chainSpecRequiredKeys {
+		if _, exists := values[k]; !exists {
+			return nil, fmt.Errorf("no value for %s", k)
+		}
+	}
+
+	c := &ChainSpec{
+		Extra: make(map[string]string),
+	}
+	chainSpecValue := reflect.ValueOf(c).Elem()
+	for k, v := range values {
+		index, exists := chainSpecFields[k]
+		if !exists {
+			c.Extra[k] = v
+			continue
+		}
+		if err := setChainSpecValue(chainSpecValue.Field(index), v); err != nil {
+			// Retain the value as supplied, and leave Validate to decide if it is required.
+			c.Extra[k] = v
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// ChainSpecFromYAML creates a chain spec from the contents of one or more
+// YAML files in the format used by the consensus specifications, for example
+// a configuration file and its preset files.
+// If a key is present in more than one file the value in the later file is used.
+func ChainSpecFromYAML(data ...[]byte) (*ChainSpec, error) {
+	values := make(map[string]string)
+	for i := range data {
+		file, err := parser.ParseBytes(data[i], 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid YAML")
+		}
+		for _, doc := range file.Docs {
+			var entries []*ast.MappingValueNode
+			switch body := doc.Body.(type) {
+			case nil:
+				// Empty document.
+			case *ast.MappingNode:
+				entries = body.Values
+			case *ast.MappingValueNode:
+				entries = []*ast.MappingValueNode{body}
+			default:
+				return nil, errors.New("YAML is not a map")
+			}
+			for _, entry := range entries {
+				values[entry.Key.GetToken().Value] = yamlValue(entry.Value)
+			}
+		}
+	}
+
+	return ChainSpecFromMap(values)
+}
+
+// yamlValue returns the value of a YAML node as written.
+// This avoids interpreting values such as fork versions as numbers, which
+// would lose their leading zeros.
+func yamlValue(node ast.Node) string {
+	switch node.(type) {
+	case *ast.MappingNode, *ast.MappingValueNode, *ast.SequenceNode:
+		return node.String()
+	default:
+		return node.GetToken().Value
+	}
+}
+
+// setChainSpecValue sets a chain spec field from its string value.
+func setChainSpecValue(field reflect.Value, value string) error {
+	switch {
+	case field.Type() == bigIntPtrType:
+		res, success := new(big.Int).SetString(value, 10)
+		if !success {
+			return errors.New("not a number")
+		}
+		field.Set(reflect.ValueOf(res))
+	case field.Type() == durationType:
+		seconds, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(time.Duration(seconds) * time.Second))
+	case field.Type() == timeType:
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if seconds != 0 {
+			field.Set(reflect.ValueOf(time.Unix(seconds, 0)))
+		}
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Uint64:
+		res, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(res)
+	case field.Kind() == reflect.Array && field.Type().Elem().Kind() == reflect.Uint8:
+		res, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil {
+			return err
+		}
+		if len(res) != field.Len() {
+			return fmt.Errorf("incorrect length %d", len(res))
+		}
+		reflect.Copy(field, reflect.ValueOf(res))
+	default:
+		return fmt.Errorf("unhandled type %v", field.Type())
+	}
+
+	return nil
+}
+
+// chainSpecValueString returns the string value of a chain spec field.
+// An empty string is returned if the field has no value.
+func chainSpecValueString(field reflect.Value) string {
+	switch {
+	case field.Type() == bigIntPtrType:
+		if field.IsNil() {
+			return ""
+		}
+		return field.Interface().(*big.Int).String()
+	case field.Type() == durationType:
+		return fmt.Sprintf("%d", uint64(time.Duration(field.Int())/time.Second))
+	case field.Type() == timeType:
+		genesisTime := field.Interface().(time.Time)
+		if genesisTime.IsZero() {
+			return "0"
+		}
+		return fmt.Sprintf("%d", genesisTime.Unix())
+	case field.Kind() == reflect.String:
+		return field.String()
+	case field.Kind() == reflect.Uint64:
+		return fmt.Sprintf("%d", field.Uint())
+	default:
+		return fmt.Sprintf("%#x", field.Slice(0, field.Len()).Bytes())
+	}
+}
+
+// Map returns the chain spec as a map of keys to values, in the format used by
+// the beacon node API.
+func (c *ChainSpec) Map() map[string]string {
+	res := make(map[string]string, len(chainSpecFields)+len(c.Extra))
+	for k, v := range c.Extra {
+		res[k] = v
+	}
+	chainSpecValue := reflect.ValueOf(c).Elem()
+	for k, index := range chainSpecFields {
+		if _, exists := c.Extra[k]; exists {
+			// Value could not be parsed; keep it as supplied.
+			continue
+		}
+		if value := chainSpecValueString(chainSpecValue.Field(index)); value != "" {
+			res[k] = value
+		}
+	}
+
+	return res
+}
+
+// Validate checks that the chain spec is usable.
+func (c *ChainSpec) Validate() error {
+	for _, k := range chainSpecRequiredKeys {
+		if v, exists := c.Extra[k]; exists {
+			return fmt.Errorf("invalid value for %s: %q", k, v)
+		}
+	}
+	if c.SecondsPerSlot == 0 {
+		return errors.New("SECONDS_PER_SLOT must be greater than 0")
+	}
+	if c.SlotsPerEpoch == 0 {
+		return errors.New("SLOTS_PER_EPOCH must be greater than 0")
+	}
+	if c.SlotsPerHistoricalRoot&(c.SlotsPerHistoricalRoot-1) != 0 {
+		return errors.New("SLOTS_PER_HISTORICAL_ROOT must be a power of 2")
+	}
+	if c.EffectiveBalanceIncrement != 0 && c.MaxEffectiveBalance%c.EffectiveBalanceIncrement != 0 {
+		return errors.New("MAX_EFFECTIVE_BALANCE must be a multiple of EFFECTIVE_BALANCE_INCREMENT")
+	}
+	if c.BellatrixForkEpoch < c.AltairForkEpoch {
+		return errors.New("BELLATRIX_FORK_EPOCH must not be before ALTAIR_FORK_EPOCH")
+	}
+	if c.CapellaForkEpoch < c.BellatrixForkEpoch {
+		return errors.New("CAPELLA_FORK_EPOCH must not be before BELLATRIX_FORK_EPOCH")
+	}
+	if c.DenebForkEpoch < c.CapellaForkEpoch {
+		return errors.New("DENEB_FORK_EPOCH must not be before CAPELLA_FORK_EPOCH")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ChainSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Map())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChainSpec) UnmarshalJSON(input []byte) error {
+	var data map[string]string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	res, err := ChainSpecFromMap(data)
+	if err != nil {
+		return err
+	}
+	*c = *res
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (c *ChainSpec) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,242 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestChainSpecJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]string",
+		},
+		{
+			name:  "GenesisForkVersionMissing",
+			input: []byte(`{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}`),
+			err:   "no value for GENESIS_FORK_VERSION",
+		},
+		{
+			name:  "SecondsPerSlotMissing",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SLOTS_PER_EPOCH":"32"}`),
+			err:   "no value for SECONDS_PER_SLOT",
+		},
+		{
+			name:  "SecondsPerSlotZero",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"0","SLOTS_PER_EPOCH":"32"}`),
+			err:   "SECONDS_PER_SLOT must be greater than 0",
+		},
+		{
+			name:  "SlotsPerEpochInvalid",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"-1"}`),
+			err:   `invalid value for SLOTS_PER_EPOCH: "-1"`,
+		},
+		{
+			name:  "GenesisForkVersionInvalid",
+			input: []byte(`{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","GENESIS_FORK_VERSION":"0xinvalid"}`),
+			err:   `invalid value for GENESIS_FORK_VERSION: "0xinvalid"`,
+		},
+		{
+			name:  "GenesisForkVersionShort",
+			input: []byte(`{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","GENESIS_FORK_VERSION":"0x000000"}`),
+			err:   `invalid value for GENESIS_FORK_VERSION: "0x000000"`,
+		},
+		{
+			name:  "TerminalTotalDifficultyInvalid",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","TERMINAL_TOTAL_DIFFICULTY":"0x01"}`),
+		},
+		{
+			name:  "SlotsPerHistoricalRootInvalid",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","SLOTS_PER_HISTORICAL_ROOT":"8000"}`),
+			err:   "SLOTS_PER_HISTORICAL_ROOT must be a power of 2",
+		},
+		{
+			name:  "MaxEffectiveBalanceInvalid",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","MAX_EFFECTIVE_BALANCE":"32500000000","EFFECTIVE_BALANCE_INCREMENT":"1000000000"}`),
+			err:   "MAX_EFFECTIVE_BALANCE must be a multiple of EFFECTIVE_BALANCE_INCREMENT",
+		},
+		{
+			name:  "ForkEpochsOutOfOrder",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"10","BELLATRIX_FORK_EPOCH":"5"}`),
+			err:   "BELLATRIX_FORK_EPOCH must not be before ALTAIR_FORK_EPOCH",
+		},
+		{
+			name:  "Minimal",
+			input: []byte(`{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"CONFIG_NAME":"mainnet","PRESET_BASE":"mainnet","TERMINAL_TOTAL_DIFFICULTY":"58750000000000000000000","MIN_GENESIS_TIME":"1606824000","GENESIS_FORK_VERSION":"0x00000000","GENESIS_DELAY":"604800","ALTAIR_FORK_VERSION":"0x01000000","ALTAIR_FORK_EPOCH":"74240","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","DEPOSIT_CONTRACT_ADDRESS":"0x00000000219ab540356cbb839cbe05303d7705fa","MAX_EFFECTIVE_BALANCE":"32000000000","EFFECTIVE_BALANCE_INCREMENT":"1000000000","GOSSIP_MAX_SIZE":"10485760"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ChainSpec
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				var res2 api.ChainSpec
+				require.NoError(t, json.Unmarshal(rt, &res2))
+				require.Equal(t, res, res2)
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestChainSpecFromMap(t *testing.T) {
+	chainSpec, err := api.ChainSpecFromMap(map[string]string{
+		"CONFIG_NAME":               "mainnet",
+		"TERMINAL_TOTAL_DIFFICULTY": "58750000000000000000000",
+		"MIN_GENESIS_TIME":          "1606824000",
+		"GENESIS_FORK_VERSION":      "0x00000000",
+		"GENESIS_DELAY":             "604800",
+		"ALTAIR_FORK_EPOCH":         "74240",
+		"SECONDS_PER_SLOT":          "12",
+		"SLOTS_PER_EPOCH":           "32",
+		"DEPOSIT_CONTRACT_ADDRESS":  "0x00000000219ab540356cBB839Cbe05303d7705Fa",
+		"MAX_EFFECTIVE_BALANCE":     "32000000000",
+		"DOMAIN_BEACON_ATTESTER":    "0x01000000",
+		"GOSSIP_MAX_SIZE":           "10485760",
+	})
+	require.NoError(t, err)
+
+	ttd, _ := new(big.Int).SetString("58750000000000000000000", 10)
+	require.Equal(t, "mainnet", chainSpec.ConfigName)
+	require.Equal(t, ttd, chainSpec.TerminalTotalDifficulty)
+	require.Equal(t, time.Unix(1606824000, 0), chainSpec.MinGenesisTime)
+	require.Equal(t, phase0.Version{}, chainSpec.GenesisForkVersion)
+	require.Equal(t, 7*24*time.Hour, chainSpec.GenesisDelay)
+	require.Equal(t, phase0.Epoch(74240), chainSpec.AltairForkEpoch)
+	require.Equal(t, 12*time.Second, chainSpec.SecondsPerSlot)
+	require.Equal(t, uint64(32), chainSpec.SlotsPerEpoch)
+	require.Equal(t, bellatrix.ExecutionAddress{0x00, 0x00, 0x00, 0x00, 0x21, 0x9a, 0xb5, 0x40, 0x35, 0x6c, 0xbb, 0x83, 0x9c, 0xbe, 0x05, 0x30, 0x3d, 0x77, 0x05, 0xfa}, chainSpec.DepositContractAddress)
+	require.Equal(t, phase0.Gwei(32000000000), chainSpec.MaxEffectiveBalance)
+	require.Equal(t, phase0.DomainType{0x01, 0x00, 0x00, 0x00}, chainSpec.DomainBeaconAttester)
+	require.Equal(t, map[string]string{"GOSSIP_MAX_SIZE": "10485760"}, chainSpec.Extra)
+
+	// Values not supplied take their defaults.
+	require.Equal(t, phase0.Epoch(0xffffffffffffffff), chainSpec.DenebForkEpoch)
+	require.Equal(t, uint64(16), chainSpec.TargetAggregatorsPerCommittee)
+	require.Equal(t, phase0.DomainType{0x0a, 0x00, 0x00, 0x00}, chainSpec.DomainBLSToExecutionChange)
+
+	// Map returns the supplied values, including extra values.
+	data := chainSpec.Map()
+	require.Equal(t, "58750000000000000000000", data["TERMINAL_TOTAL_DIFFICULTY"])
+	require.Equal(t, "1606824000", data["MIN_GENESIS_TIME"])
+	require.Equal(t, "604800", data["GENESIS_DELAY"])
+	require.Equal(t, "0x00000000219ab540356cbb839cbe05303d7705fa", data["DEPOSIT_CONTRACT_ADDRESS"])
+	require.Equal(t, "10485760", data["GOSSIP_MAX_SIZE"])
+	require.NotContains(t, data, "PRESET_BASE")
+}
+
+func TestChainSpecFromMapInvalidValue(t *testing.T) {
+	// A value that cannot be parsed is retained, and does not stop the chain spec being created.
+	chainSpec, err := api.ChainSpecFromMap(map[string]string{
+		"GENESIS_FORK_VERSION":      "0x00000000",
+		"SECONDS_PER_SLOT":          "12",
+		"SLOTS_PER_EPOCH":           "32",
+		"MAX_EFFECTIVE_BALANCE":     "32000000000",
+		"TERMINAL_TOTAL_DIFFICULTY": "0x01",
+		"MAX_BLOBS_PER_BLOCK":       "six",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, chainSpec.SecondsPerSlot)
+	require.Equal(t, phase0.Gwei(32000000000), chainSpec.MaxEffectiveBalance)
+	require.Nil(t, chainSpec.TerminalTotalDifficulty)
+	require.Equal(t, uint64(0), chainSpec.MaxBlobsPerBlock)
+	require.Equal(t, map[string]string{"TERMINAL_TOTAL_DIFFICULTY": "0x01", "MAX_BLOBS_PER_BLOCK": "six"}, chainSpec.Extra)
+
+	// Map returns the value as supplied.
+	data := chainSpec.Map()
+	require.Equal(t, "0x01", data["TERMINAL_TOTAL_DIFFICULTY"])
+	require.Equal(t, "six", data["MAX_BLOBS_PER_BLOCK"])
+
+	// A value that is required must be parsed.
+	_, err = api.ChainSpecFromMap(map[string]string{
+		"GENESIS_FORK_VERSION": "0x00000000",
+		"SECONDS_PER_SLOT":     "12",
+		"SLOTS_PER_EPOCH":      "32",
+		"DENEB_FORK_EPOCH":     "soon",
+		"MAX_BLOBS_PER_BLOCK":  "6",
+	})
+	require.EqualError(t, err, `invalid value for DENEB_FORK_EPOCH: "soon"`)
+}
+
+func TestChainSpecFromYAML(t *testing.T) {
+	config := []byte(`# Mainnet config
+
+# Extends the mainnet preset
+PRESET_BASE: 'mainnet'
+CONFIG_NAME: 'mainnet'
+
+TERMINAL_TOTAL_DIFFICULTY: 58750000000000000000000
+TERMINAL_BLOCK_HASH: 0x0000000000000000000000000000000000000000000000000000000000000000
+MIN_GENESIS_TIME: 1606824000
+GENESIS_FORK_VERSION: 0x00000000
+ALTAIR_FORK_VERSION: 0x01000000
+ALTAIR_FORK_EPOCH: 74240
+SECONDS_PER_SLOT: 12
+DEPOSIT_CONTRACT_ADDRESS: 0x00000000219ab540356cBB839Cbe05303d7705Fa
+`)
+	preset := []byte(`# Mainnet preset - Phase0
+SLOTS_PER_EPOCH: 32
+SLOTS_PER_HISTORICAL_ROOT: 8192
+`)
+
+	chainSpec, err := api.ChainSpecFromYAML(config, preset)
+	require.NoError(t, err)
+	require.Equal(t, "mainnet", chainSpec.PresetBase)
+	require.Equal(t, phase0.Version{}, chainSpec.GenesisForkVersion)
+	require.Equal(t, phase0.Version{0x01, 0x00, 0x00, 0x00}, chainSpec.AltairForkVersion)
+	require.Equal(t, "58750000000000000000000", chainSpec.TerminalTotalDifficulty.String())
+	require.Equal(t, uint64(32), chainSpec.SlotsPerEpoch)
+	require.Equal(t, uint64(8192), chainSpec.SlotsPerHistoricalRoot)
+	require.Empty(t, chainSpec.Extra)
+
+	// Later files take precedence.
+	chainSpec, err = api.ChainSpecFromYAML(config, preset, []byte("SLOTS_PER_EPOCH: 8\n"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), chainSpec.SlotsPerEpoch)
+
+	_, err = api.ChainSpecFromYAML(config)
+	require.EqualError(t, err, "no value for SLOTS_PER_EPOCH")
+
+	_, err = api.ChainSpecFromYAML([]byte("- a\n- b\n"))
+	require.EqualError(t, err, "YAML is not a map")
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// blobsBundleJSON is the spec representation of the struct.
+type blobsBundleJSON struct {
+	Commitments []string `json:"commitments"`
+	Proofs      []string `json:"proofs"`
+	Blobs       []string `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobsBundle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.pack())
+}
+
+func (b *BlobsBundle) pack() *blobsBundleJSON {
+	commitments := make([]string, len(b.Commitments))
+	for i := range b.Commitments {
+		commitments[i] = b.Commitments[i].String()
+	}
+	proofs := make([]string, len(b.Proofs))
+	for i := range b.Proofs {
+		proofs[i] = b.Proofs[i].String()
+	}
+	blobs := make([]string, len(b.Blobs))
+	for i := range b.Blobs {
+		blobs[i] = fmt.Sprintf("%#x", b.Blobs[i][:])
+	}
+
+	return &blobsBundleJSON{
+		Commitments: commitments,
+		Proofs:      proofs,
+		Blobs:       blobs,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobsBundle) UnmarshalJSON(input []byte) error {
+	var data blobsBundleJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return b.unpack(&data)
+}
+
+func (b *BlobsBundle) unpack(data *blobsBundleJSON) error {
+	if data.Commitments == nil {
+		return errors.New("commitments missing")
+	}
+	b.Commitments = make([]deneb.KzgCommitment, len(data.Commitments))
+	for i := range data.Commitments {
+		commitment, err := hex.DecodeString(strings.TrimPrefix(data.Commitments[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for commitment")
+		}
+		if len(commitment) != deneb.KzgCommitmentLength {
+			return fmt.Errorf("incorrect length %d for commitment", len(commitment))
+		}
+		copy(b.Commitments[i][:], commitment)
+	}
+
+	if data.Proofs == nil {
+		return errors.New("proofs missing")
+	}
+	b.Proofs = make([]deneb.KzgProof, len(data.Proofs))
+	for i := range data.Proofs {
+		proof, err := hex.DecodeString(strings.TrimPrefix(data.Proofs[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for proof")
+		}
+		if len(proof) != deneb.KzgProofLength {
+			return fmt.Errorf("incorrect length %d for proof", len(proof))
+		}
+		copy(b.Proofs[i][:], proof)
+	}
+
+	if data.Blobs == nil {
+		return errors.New("blobs missing")
+	}
+	b.Blobs = make([]deneb.Blob, len(data.Blobs))
+	for i := range data.Blobs {
+		blob, err := hex.DecodeString(strings.TrimPrefix(data.Blobs[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for blob")
+		}
+		if len(blob) != deneb.BlobLength {
+			return fmt.Errorf("incorrect length %d for blob", len(blob))
+		}
+		copy(b.Blobs[i][:], blob)
+	}
+
+	if len(b.Commitments) != len(b.Blobs) || len(b.Proofs) != len(b.Blobs) {
+		return errors.New("mismatched numbers of commitments, proofs and blobs")
+	}
+
+	return nil
+}
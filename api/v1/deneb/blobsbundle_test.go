@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestBlobsBundleJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type deneb.blobsBundleJSON",
+		},
+		{
+			name:  "CommitmentsMissing",
+			input: []byte(`{"proofs":[],"blobs":[]}`),
+			err:   "commitments missing",
+		},
+		{
+			name:  "CommitmentInvalid",
+			input: []byte(`{"commitments":["invalid"],"proofs":[],"blobs":[]}`),
+			err:   "invalid value for commitment: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "CommitmentShort",
+			input: []byte(`{"commitments":["0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e"],"proofs":[],"blobs":[]}`),
+			err:   "incorrect length 47 for commitment",
+		},
+		{
+			name:  "ProofsMissing",
+			input: []byte(`{"commitments":[],"blobs":[]}`),
+			err:   "proofs missing",
+		},
+		{
+			name:  "ProofInvalid",
+			input: []byte(`{"commitments":[],"proofs":["invalid"],"blobs":[]}`),
+			err:   "invalid value for proof: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ProofShort",
+			input: []byte(`{"commitments":[],"proofs":["0x707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e"],"blobs":[]}`),
+			err:   "incorrect length 47 for proof",
+		},
+		{
+			name:  "BlobsMissing",
+			input: []byte(`{"commitments":[],"proofs":[]}`),
+			err:   "blobs missing",
+		},
+		{
+			name:  "BlobInvalid",
+			input: []byte(`{"commitments":[],"proofs":[],"blobs":["invalid"]}`),
+			err:   "invalid value for blob: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlobShort",
+			input: []byte(`{"commitments":[],"proofs":[],"blobs":["0x0001"]}`),
+			err:   "incorrect length 2 for blob",
+		},
+		{
+			name:  "Mismatched",
+			input: []byte(`{"commitments":["0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f"],"proofs":["0x707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f"],"blobs":[]}`),
+			err:   "mismatched numbers of commitments, proofs and blobs",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"commitments":[],"proofs":[],"blobs":[]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res deneb.BlobsBundle
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
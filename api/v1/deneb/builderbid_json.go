@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/holiman/uint256"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// builderBidJSON is the spec representation of the struct.
+type builderBidJSON struct {
+	Header             *deneb.ExecutionPayloadHeader `json:"header"`
+	BlobKzgCommitments []string                      `json:"blob_kzg_commitments"`
+	Value              string                        `json:"value"`
+	Pubkey             string                        `json:"pubkey"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuilderBid) MarshalJSON() ([]byte, error) {
+	blobKzgCommitments := make([]string, len(b.BlobKzgCommitments))
+	for i := range b.BlobKzgCommitments {
+		blobKzgCommitments[i] = b.BlobKzgCommitments[i].String()
+	}
+
+	return json.Marshal(&builderBidJSON{
+		Header:             b.Header,
+		BlobKzgCommitments: blobKzgCommitments,
+		Value:              b.Value.Dec(),
+		Pubkey:             fmt.Sprintf("%#x", b.Pubkey),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BuilderBid) UnmarshalJSON(input []byte) error {
+	var data builderBidJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return b.unpack(&data)
+}
+
+func (b *BuilderBid) unpack(data *builderBidJSON) error {
+	if data.Header == nil {
+		return errors.New("header missing")
+	}
+	b.Header = data.Header
+	if data.BlobKzgCommitments == nil {
+		return errors.New("blob KZG commitments missing")
+	}
+	b.BlobKzgCommitments = make([]deneb.KzgCommitment, len(data.BlobKzgCommitments))
+	for i := range data.BlobKzgCommitments {
+		commitment, err := hex.DecodeString(strings.TrimPrefix(data.BlobKzgCommitments[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for blob KZG commitment")
+		}
+		if len(commitment) != deneb.KzgCommitmentLength {
+			return fmt.Errorf("incorrect length %d for blob KZG commitment", len(commitment))
+		}
+		copy(b.BlobKzgCommitments[i][:], commitment)
+	}
+	if data.Value == "" {
+		return errors.New("value missing")
+	}
+	value, err := uint256.FromDecimal(data.Value)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for value")
+	}
+	b.Value = value
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(data.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return fmt.Errorf("incorrect length %d for public key", len(pubKey))
+	}
+	copy(b.Pubkey[:], pubKey)
+
+	return nil
+}
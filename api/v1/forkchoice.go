@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoice is a dump of the fork choice store of a beacon node.
+type ForkChoice struct {
+	// JustifiedCheckpoint is the justified checkpoint of the store.
+	JustifiedCheckpoint *phase0.Checkpoint
+	// FinalizedCheckpoint is the finalized checkpoint of the store.
+	FinalizedCheckpoint *phase0.Checkpoint
+	// Nodes are the nodes in the store.
+	Nodes []*ForkChoiceNode
+	// ExtraData contains client-specific data about the store.
+	ExtraData map[string]interface{}
+}
+
+// forkChoiceJSON is the spec representation of the struct.
+type forkChoiceJSON struct {
+	JustifiedCheckpoint *phase0.Checkpoint     `json:"justified_checkpoint"`
+	FinalizedCheckpoint *phase0.Checkpoint     `json:"finalized_checkpoint"`
+	Nodes               []*ForkChoiceNode      `json:"fork_choice_nodes"`
+	ExtraData           map[string]interface{} `json:"extra_data,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkChoiceJSON{
+		JustifiedCheckpoint: f.JustifiedCheckpoint,
+		FinalizedCheckpoint: f.FinalizedCheckpoint,
+		Nodes:               f.Nodes,
+		ExtraData:           f.ExtraData,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoice) UnmarshalJSON(input []byte) error {
+	var forkChoiceJSON forkChoiceJSON
+	if err := json.Unmarshal(input, &forkChoiceJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if forkChoiceJSON.JustifiedCheckpoint == nil {
+		return errors.New("justified checkpoint missing")
+	}
+	f.JustifiedCheckpoint = forkChoiceJSON.JustifiedCheckpoint
+	if forkChoiceJSON.FinalizedCheckpoint == nil {
+		return errors.New("finalized checkpoint missing")
+	}
+	f.FinalizedCheckpoint = forkChoiceJSON.FinalizedCheckpoint
+	if forkChoiceJSON.Nodes == nil {
+		return errors.New("fork choice nodes missing")
+	}
+	f.Nodes = forkChoiceJSON.Nodes
+	f.ExtraData = forkChoiceJSON.ExtraData
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkChoice) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkChoiceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.forkChoiceJSON",
+		},
+		{
+			name:  "JustifiedCheckpointMissing",
+			input: []byte(`{"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}`),
+			err:   "justified checkpoint missing",
+		},
+		{
+			name:  "JustifiedCheckpointInvalid",
+			input: []byte(`{"justified_checkpoint":{},"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}`),
+			err:   "invalid JSON: epoch missing",
+		},
+		{
+			name:  "FinalizedCheckpointMissing",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}`),
+			err:   "finalized checkpoint missing",
+		},
+		{
+			name:  "FinalizedCheckpointInvalid",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"finalized_checkpoint":{},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}`),
+			err:   "invalid JSON: epoch missing",
+		},
+		{
+			name:  "NodesMissing",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"}}`),
+			err:   "fork choice nodes missing",
+		},
+		{
+			name:  "NodesInvalid",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{}]}`),
+			err:   "invalid JSON: slot missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]}`),
+		},
+		{
+			name:  "GoodExtraData",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"finalized_checkpoint":{"epoch":"1","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"fork_choice_nodes":[{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}],"extra_data":{"proposer_boost_root":"0x0101010101010101010101010101010101010101010101010101010101010101"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ForkChoice
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
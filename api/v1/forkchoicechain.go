@@ -0,0 +1,183 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// Node returns the node with the given block root, or nil if it is not present.
+func (f *ForkChoice) Node(root phase0.Root) *ForkChoiceNode {
+	for _, node := range f.Nodes {
+		if node.BlockRoot == root {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Head returns the head of the fork choice, or nil if there are no nodes or the
+// nodes' parent links contain a cycle.
+//
+// The head is found by starting at the justified block, or the oldest node if the
+// justified block is not present, and repeatedly moving to the child with the highest
+// weight.  Ties are broken in favour of the child with the higher root, and nodes
+// with invalid execution payloads are ignored.
+func (f *ForkChoice) Head() *ForkChoiceNode {
+	children := f.children()
+
+	var node *ForkChoiceNode
+	if f.JustifiedCheckpoint != nil {
+		node = f.index()[f.JustifiedCheckpoint.Root]
+	}
+	if node == nil {
+		node = f.oldest()
+	}
+	if node == nil {
+		return nil
+	}
+
+	visited := map[phase0.Root]bool{node.BlockRoot: true}
+	for {
+		var best *ForkChoiceNode
+		for _, child := range children[node.BlockRoot] {
+			if child.Validity == ForkChoiceNodeValidityInvalid {
+				continue
+			}
+			if best == nil ||
+				child.Weight > best.Weight ||
+				(child.Weight == best.Weight && bytes.Compare(child.BlockRoot[:], best.BlockRoot[:]) > 0) {
+				best = child
+			}
+		}
+		if best == nil {
+			return node
+		}
+		if visited[best.BlockRoot] {
+			// Malformed data; there is no head.
+			return nil
+		}
+		visited[best.BlockRoot] = true
+		node = best
+	}
+}
+
+// CanonicalChain returns the nodes on the path from the oldest known ancestor of the
+// head to the head, in slot order.
+func (f *ForkChoice) CanonicalChain() []*ForkChoiceNode {
+	head := f.Head()
+	if head == nil {
+		return []*ForkChoiceNode{}
+	}
+
+	return ancestry(f.index(), head, nil)
+}
+
+// Branches returns the branches that compete with the canonical chain.
+//
+// Each branch runs from the first node after its divergence from the canonical chain
+// to a leaf, in slot order.  Branches that share nodes are returned separately, one per
+// leaf.  Branches are ordered by the slot of their leaf, most recent first.
+func (f *ForkChoice) Branches() [][]*ForkChoiceNode {
+	canonical := make(map[phase0.Root]bool)
+	for _, node := range f.CanonicalChain() {
+		canonical[node.BlockRoot] = true
+	}
+
+	nodes := f.index()
+	children := f.children()
+	branches := make([][]*ForkChoiceNode, 0)
+	for _, node := range f.Nodes {
+		if canonical[node.BlockRoot] || len(children[node.BlockRoot]) > 0 {
+			continue
+		}
+		branches = append(branches, ancestry(nodes, node, canonical))
+	}
+
+	sort.SliceStable(branches, func(i, j int) bool {
+		leafI := branches[i][len(branches[i])-1]
+		leafJ := branches[j][len(branches[j])-1]
+		if leafI.Slot != leafJ.Slot {
+			return leafI.Slot > leafJ.Slot
+		}
+		return bytes.Compare(leafI.BlockRoot[:], leafJ.BlockRoot[:]) > 0
+	})
+
+	return branches
+}
+
+// ancestry returns the node and its ancestors in slot order, stopping when an
+// ancestor is in the supplied set, is not in the index, or has already been seen.
+func ancestry(nodes map[phase0.Root]*ForkChoiceNode,
+	node *ForkChoiceNode,
+	stop map[phase0.Root]bool,
+) []*ForkChoiceNode {
+	chain := []*ForkChoiceNode{node}
+	seen := map[phase0.Root]bool{node.BlockRoot: true}
+	for {
+		parent, exists := nodes[node.ParentRoot]
+		if !exists || stop[parent.BlockRoot] || seen[parent.BlockRoot] {
+			break
+		}
+		chain = append(chain, parent)
+		seen[parent.BlockRoot] = true
+		node = parent
+	}
+
+	// Reverse to obtain slot order.
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain
+}
+
+// index returns a map of block roots to their nodes.
+func (f *ForkChoice) index() map[phase0.Root]*ForkChoiceNode {
+	nodes := make(map[phase0.Root]*ForkChoiceNode, len(f.Nodes))
+	for _, node := range f.Nodes {
+		nodes[node.BlockRoot] = node
+	}
+
+	return nodes
+}
+
+// children returns a map of block roots to the nodes that are their children.
+func (f *ForkChoice) children() map[phase0.Root][]*ForkChoiceNode {
+	children := make(map[phase0.Root][]*ForkChoiceNode, len(f.Nodes))
+	for _, node := range f.Nodes {
+		if node.ParentRoot == node.BlockRoot {
+			continue
+		}
+		children[node.ParentRoot] = append(children[node.ParentRoot], node)
+	}
+
+	return children
+}
+
+// oldest returns the node with the lowest slot.
+func (f *ForkChoice) oldest() *ForkChoiceNode {
+	var oldest *ForkChoiceNode
+	for _, node := range f.Nodes {
+		if oldest == nil || node.Slot < oldest.Slot {
+			oldest = node
+		}
+	}
+
+	return oldest
+}
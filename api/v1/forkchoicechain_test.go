@@ -0,0 +1,190 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func forkChoiceTestRoot(b byte) phase0.Root {
+	var root phase0.Root
+	root[0] = b
+	return root
+}
+
+func forkChoiceTestNode(root byte, parent byte, slot phase0.Slot, weight uint64, validity api.ForkChoiceNodeValidity) *api.ForkChoiceNode {
+	return &api.ForkChoiceNode{
+		Slot:       slot,
+		BlockRoot:  forkChoiceTestRoot(root),
+		ParentRoot: forkChoiceTestRoot(parent),
+		Weight:     weight,
+		Validity:   validity,
+	}
+}
+
+func forkChoiceTestRoots(nodes []*api.ForkChoiceNode) []phase0.Root {
+	roots := make([]phase0.Root, len(nodes))
+	for i := range nodes {
+		roots[i] = nodes[i].BlockRoot
+	}
+	return roots
+}
+
+func TestForkChoiceChain(t *testing.T) {
+	// 0x0a - 0x0b - 0x0c - 0x0d
+	//           \ - 0x0e - 0x0f
+	//           \ - 0x10 (invalid)
+	nodes := []*api.ForkChoiceNode{
+		forkChoiceTestNode(0x0a, 0x00, 0, 30, api.ForkChoiceNodeValidityValid),
+		forkChoiceTestNode(0x0b, 0x0a, 1, 30, api.ForkChoiceNodeValidityValid),
+		forkChoiceTestNode(0x0c, 0x0b, 2, 20, api.ForkChoiceNodeValidityValid),
+		forkChoiceTestNode(0x0d, 0x0c, 3, 20, api.ForkChoiceNodeValidityOptimistic),
+		forkChoiceTestNode(0x0e, 0x0b, 2, 10, api.ForkChoiceNodeValidityValid),
+		forkChoiceTestNode(0x0f, 0x0e, 3, 10, api.ForkChoiceNodeValidityValid),
+		forkChoiceTestNode(0x10, 0x0b, 2, 100, api.ForkChoiceNodeValidityInvalid),
+	}
+
+	tests := []struct {
+		name       string
+		forkChoice *api.ForkChoice
+		head       *phase0.Root
+		canonical  []phase0.Root
+		branches   [][]phase0.Root
+	}{
+		{
+			name:       "Empty",
+			forkChoice: &api.ForkChoice{},
+			canonical:  []phase0.Root{},
+			branches:   [][]phase0.Root{},
+		},
+		{
+			name: "Single",
+			forkChoice: &api.ForkChoice{
+				Nodes: nodes[:1],
+			},
+			head:      &nodes[0].BlockRoot,
+			canonical: []phase0.Root{nodes[0].BlockRoot},
+			branches:  [][]phase0.Root{},
+		},
+		{
+			name: "Forked",
+			forkChoice: &api.ForkChoice{
+				JustifiedCheckpoint: &phase0.Checkpoint{Root: nodes[1].BlockRoot},
+				Nodes:               nodes,
+			},
+			head: &nodes[3].BlockRoot,
+			canonical: []phase0.Root{
+				nodes[0].BlockRoot,
+				nodes[1].BlockRoot,
+				nodes[2].BlockRoot,
+				nodes[3].BlockRoot,
+			},
+			branches: [][]phase0.Root{
+				{nodes[4].BlockRoot, nodes[5].BlockRoot},
+				{nodes[6].BlockRoot},
+			},
+		},
+		{
+			name: "JustifiedUnknown",
+			forkChoice: &api.ForkChoice{
+				JustifiedCheckpoint: &phase0.Checkpoint{Root: forkChoiceTestRoot(0xff)},
+				Nodes:               nodes,
+			},
+			head: &nodes[3].BlockRoot,
+			canonical: []phase0.Root{
+				nodes[0].BlockRoot,
+				nodes[1].BlockRoot,
+				nodes[2].BlockRoot,
+				nodes[3].BlockRoot,
+			},
+			branches: [][]phase0.Root{
+				{nodes[4].BlockRoot, nodes[5].BlockRoot},
+				{nodes[6].BlockRoot},
+			},
+		},
+		{
+			name: "Tie",
+			forkChoice: &api.ForkChoice{
+				Nodes: []*api.ForkChoiceNode{
+					forkChoiceTestNode(0x0a, 0x00, 0, 20, api.ForkChoiceNodeValidityValid),
+					forkChoiceTestNode(0x0b, 0x0a, 1, 10, api.ForkChoiceNodeValidityValid),
+					forkChoiceTestNode(0x0c, 0x0a, 1, 10, api.ForkChoiceNodeValidityValid),
+				},
+			},
+			head:      forkChoiceTestRootPtr(forkChoiceTestRoot(0x0c)),
+			canonical: []phase0.Root{forkChoiceTestRoot(0x0a), forkChoiceTestRoot(0x0c)},
+			branches:  [][]phase0.Root{{forkChoiceTestRoot(0x0b)}},
+		},
+		{
+			name: "Cycle",
+			forkChoice: &api.ForkChoice{
+				Nodes: []*api.ForkChoiceNode{
+					forkChoiceTestNode(0x0a, 0x0b, 0, 20, api.ForkChoiceNodeValidityValid),
+					forkChoiceTestNode(0x0b, 0x0a, 1, 10, api.ForkChoiceNodeValidityValid),
+				},
+			},
+			canonical: []phase0.Root{},
+			branches:  [][]phase0.Root{},
+		},
+		{
+			name: "CycleWithLeaf",
+			forkChoice: &api.ForkChoice{
+				Nodes: []*api.ForkChoiceNode{
+					forkChoiceTestNode(0x0a, 0x0b, 0, 20, api.ForkChoiceNodeValidityValid),
+					forkChoiceTestNode(0x0b, 0x0a, 1, 10, api.ForkChoiceNodeValidityValid),
+					forkChoiceTestNode(0x0c, 0x0b, 2, 30, api.ForkChoiceNodeValidityValid),
+				},
+			},
+			head:      forkChoiceTestRootPtr(forkChoiceTestRoot(0x0c)),
+			canonical: []phase0.Root{forkChoiceTestRoot(0x0a), forkChoiceTestRoot(0x0b), forkChoiceTestRoot(0x0c)},
+			branches:  [][]phase0.Root{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			head := test.forkChoice.Head()
+			if test.head == nil {
+				require.Nil(t, head)
+			} else {
+				require.NotNil(t, head)
+				require.Equal(t, *test.head, head.BlockRoot)
+			}
+			require.Equal(t, test.canonical, forkChoiceTestRoots(test.forkChoice.CanonicalChain()))
+			branches := test.forkChoice.Branches()
+			require.Len(t, branches, len(test.branches))
+			for i := range branches {
+				require.Equal(t, test.branches[i], forkChoiceTestRoots(branches[i]))
+			}
+		})
+	}
+}
+
+func TestForkChoiceNode(t *testing.T) {
+	forkChoice := &api.ForkChoice{
+		Nodes: []*api.ForkChoiceNode{
+			forkChoiceTestNode(0x0a, 0x00, 0, 20, api.ForkChoiceNodeValidityValid),
+		},
+	}
+	require.NotNil(t, forkChoice.Node(forkChoiceTestRoot(0x0a)))
+	require.Nil(t, forkChoice.Node(forkChoiceTestRoot(0x0b)))
+}
+
+func forkChoiceTestRootPtr(root phase0.Root) *phase0.Root {
+	return &root
+}
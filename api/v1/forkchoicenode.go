@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoiceNode is a node in the fork choice store of a beacon node.
+type ForkChoiceNode struct {
+	// Slot is the slot of the block.
+	Slot phase0.Slot
+	// BlockRoot is the root of the block.
+	BlockRoot phase0.Root
+	// ParentRoot is the root of the parent of the block.
+	// This is zero if the node's parent is not known, as is the case for the
+	// oldest node in the store.
+	ParentRoot phase0.Root
+	// JustifiedEpoch is the justified epoch as seen by the block.
+	JustifiedEpoch phase0.Epoch
+	// FinalizedEpoch is the finalized epoch as seen by the block.
+	FinalizedEpoch phase0.Epoch
+	// Weight is the attestation weight of the block and its descendants.
+	Weight uint64
+	// Validity is the validity of the block's execution payload.
+	Validity ForkChoiceNodeValidity
+	// ExecutionBlockHash is the hash of the block's execution payload.
+	// This is zero if the block does not have an execution payload.
+	ExecutionBlockHash phase0.Hash32
+	// ExtraData contains client-specific data about the node.
+	ExtraData map[string]interface{}
+}
+
+// forkChoiceNodeJSON is the spec representation of the struct.
+type forkChoiceNodeJSON struct {
+	Slot               string                  `json:"slot"`
+	BlockRoot          string                  `json:"block_root"`
+	ParentRoot         string                  `json:"parent_root"`
+	JustifiedEpoch     string                  `json:"justified_epoch"`
+	FinalizedEpoch     string                  `json:"finalized_epoch"`
+	Weight             string                  `json:"weight"`
+	Validity           *ForkChoiceNodeValidity `json:"validity"`
+	ExecutionBlockHash string                  `json:"execution_block_hash"`
+	ExtraData          map[string]interface{}  `json:"extra_data,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoiceNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkChoiceNodeJSON{
+		Slot:               fmt.Sprintf("%d", f.Slot),
+		BlockRoot:          fmt.Sprintf("%#x", f.BlockRoot),
+		ParentRoot:         fmt.Sprintf("%#x", f.ParentRoot),
+		JustifiedEpoch:     fmt.Sprintf("%d", f.JustifiedEpoch),
+		FinalizedEpoch:     fmt.Sprintf("%d", f.FinalizedEpoch),
+		Weight:             fmt.Sprintf("%d", f.Weight),
+		Validity:           &f.Validity,
+		ExecutionBlockHash: fmt.Sprintf("%#x", f.ExecutionBlockHash),
+		ExtraData:          f.ExtraData,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoiceNode) UnmarshalJSON(input []byte) error {
+	var forkChoiceNodeJSON forkChoiceNodeJSON
+	if err := json.Unmarshal(input, &forkChoiceNodeJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if forkChoiceNodeJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(forkChoiceNodeJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	f.Slot = phase0.Slot(slot)
+	if forkChoiceNodeJSON.BlockRoot == "" {
+		return errors.New("block root missing")
+	}
+	blockRoot, err := hex.DecodeString(strings.TrimPrefix(forkChoiceNodeJSON.BlockRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block root")
+	}
+	if len(blockRoot) != phase0.RootLength {
+		return errors.New("incorrect length for block root")
+	}
+	copy(f.BlockRoot[:], blockRoot)
+	// Some beacon nodes do not supply a parent root for the oldest node.
+	if forkChoiceNodeJSON.ParentRoot != "" {
+		parentRoot, err := hex.DecodeString(strings.TrimPrefix(forkChoiceNodeJSON.ParentRoot, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for parent root")
+		}
+		if len(parentRoot) != phase0.RootLength {
+			return errors.New("incorrect length for parent root")
+		}
+		copy(f.ParentRoot[:], parentRoot)
+	}
+	if forkChoiceNodeJSON.JustifiedEpoch == "" {
+		return errors.New("justified epoch missing")
+	}
+	justifiedEpoch, err := strconv.ParseUint(forkChoiceNodeJSON.JustifiedEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for justified epoch")
+	}
+	f.JustifiedEpoch = phase0.Epoch(justifiedEpoch)
+	if forkChoiceNodeJSON.FinalizedEpoch == "" {
+		return errors.New("finalized epoch missing")
+	}
+	finalizedEpoch, err := strconv.ParseUint(forkChoiceNodeJSON.FinalizedEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for finalized epoch")
+	}
+	f.FinalizedEpoch = phase0.Epoch(finalizedEpoch)
+	if forkChoiceNodeJSON.Weight == "" {
+		return errors.New("weight missing")
+	}
+	weight, err := strconv.ParseUint(forkChoiceNodeJSON.Weight, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for weight")
+	}
+	f.Weight = weight
+	if forkChoiceNodeJSON.Validity == nil {
+		return errors.New("validity missing")
+	}
+	f.Validity = *forkChoiceNodeJSON.Validity
+	// Blocks without execution payloads do not have an execution block hash.
+	if forkChoiceNodeJSON.ExecutionBlockHash != "" {
+		executionBlockHash, err := hex.DecodeString(strings.TrimPrefix(forkChoiceNodeJSON.ExecutionBlockHash, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for execution block hash")
+		}
+		if len(executionBlockHash) != phase0.Hash32Length {
+			return errors.New("incorrect length for execution block hash")
+		}
+		copy(f.ExecutionBlockHash[:], executionBlockHash)
+	}
+	f.ExtraData = forkChoiceNodeJSON.ExtraData
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkChoiceNode) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,169 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkChoiceNodeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.forkChoiceNodeJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockRootMissing",
+			input: []byte(`{"slot":"1","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "block root missing",
+		},
+		{
+			name:  "BlockRootInvalid",
+			input: []byte(`{"slot":"1","block_root":"invalid","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for block root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlockRootShort",
+			input: []byte(`{"slot":"1","block_root":"0x0101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "incorrect length for block root",
+		},
+		{
+			name:  "ParentRootInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"invalid","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for parent root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ParentRootShort",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0101","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "incorrect length for parent root",
+		},
+		{
+			name:  "JustifiedEpochMissing",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "justified epoch missing",
+		},
+		{
+			name:  "JustifiedEpochInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"-1","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for justified epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "FinalizedEpochMissing",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "finalized epoch missing",
+		},
+		{
+			name:  "FinalizedEpochInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"-1","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for finalized epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "WeightMissing",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "weight missing",
+		},
+		{
+			name:  "WeightInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"-1","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid value for weight: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ValidityMissing",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "validity missing",
+		},
+		{
+			name:  "ValidityInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"bad","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+			err:   "invalid JSON: unrecognised fork choice node validity \"bad\"",
+		},
+		{
+			name:  "ExecutionBlockHashInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"invalid"}`),
+			err:   "invalid value for execution block hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ExecutionBlockHashShort",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0x0101"}`),
+			err:   "incorrect length for execution block hash",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+		},
+		{
+			name:  "GoodOptimistic",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"optimistic","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}`),
+		},
+		{
+			name:  "GoodExtraData",
+			input: []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","extra_data":{"execution_optimistic":false}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ForkChoiceNode
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestForkChoiceNodeOptionalFields(t *testing.T) {
+	input := []byte(`{"slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","justified_epoch":"0","finalized_epoch":"0","weight":"10","validity":"valid","execution_block_hash":""}`)
+
+	var res api.ForkChoiceNode
+	require.NoError(t, json.Unmarshal(input, &res))
+	require.Equal(t, phase0.Root{}, res.ParentRoot)
+	require.Equal(t, phase0.Hash32{}, res.ExecutionBlockHash)
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ForkChoiceNodeValidity defines the validity of a fork choice node's execution payload.
+type ForkChoiceNodeValidity int
+
+const (
+	// ForkChoiceNodeValidityUnknown means the validity of the node is not known.
+	ForkChoiceNodeValidityUnknown ForkChoiceNodeValidity = iota
+	// ForkChoiceNodeValidityValid means the execution payload of the node is valid.
+	ForkChoiceNodeValidityValid
+	// ForkChoiceNodeValidityInvalid means the execution payload of the node is invalid.
+	ForkChoiceNodeValidityInvalid
+	// ForkChoiceNodeValidityOptimistic means the execution payload of the node has
+	// not yet been verified.
+	ForkChoiceNodeValidityOptimistic
+)
+
+var forkChoiceNodeValidityStrings = [...]string{
+	"unknown",
+	"valid",
+	"invalid",
+	"optimistic",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoiceNodeValidity) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", forkChoiceNodeValidityStrings[*f])), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoiceNodeValidity) UnmarshalJSON(input []byte) error {
+	var err error
+	switch strings.ToLower(string(input)) {
+	case `"unknown"`:
+		*f = ForkChoiceNodeValidityUnknown
+	case `"valid"`:
+		*f = ForkChoiceNodeValidityValid
+	case `"invalid"`:
+		*f = ForkChoiceNodeValidityInvalid
+	case `"optimistic"`:
+		*f = ForkChoiceNodeValidityOptimistic
+	default:
+		err = fmt.Errorf("unrecognised fork choice node validity %s", string(input))
+	}
+	return err
+}
+
+func (f ForkChoiceNodeValidity) String() string {
+	return forkChoiceNodeValidityStrings[f]
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NodeHealth defines the health of a node, as reported by its health endpoint.
+type NodeHealth int
+
+const (
+	// NodeHealthUnknown means the health of the node is not known.
+	NodeHealthUnknown NodeHealth = iota
+	// NodeHealthReady means the node is synced and ready to serve requests.
+	NodeHealthReady
+	// NodeHealthSyncing means the node is syncing but can serve incomplete data.
+	NodeHealthSyncing
+	// NodeHealthNotInitialized means the node is not initialized or has issues.
+	NodeHealthNotInitialized
+)
+
+var nodeHealthStrings = [...]string{
+	"unknown",
+	"ready",
+	"syncing",
+	"not_initialized",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeHealth) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", nodeHealthStrings[*n])), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeHealth) UnmarshalJSON(input []byte) error {
+	var err error
+	switch strings.ToLower(string(input)) {
+	case `"unknown"`:
+		*n = NodeHealthUnknown
+	case `"ready"`:
+		*n = NodeHealthReady
+	case `"syncing"`:
+		*n = NodeHealthSyncing
+	case `"not_initialized"`:
+		*n = NodeHealthNotInitialized
+	default:
+		err = fmt.Errorf("unrecognised node health %s", string(input))
+	}
+	return err
+}
+
+func (n NodeHealth) String() string {
+	return nodeHealthStrings[n]
+}
+
+// IsReady returns true if the node is ready to serve requests.
+func (n NodeHealth) IsReady() bool {
+	return n == NodeHealthReady
+}
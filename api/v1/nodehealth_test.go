@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeHealthJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		isReady bool
+		err     string
+	}{
+		{
+			name:  "Unknown",
+			input: []byte(`"unknown"`),
+		},
+		{
+			name:    "Ready",
+			input:   []byte(`"ready"`),
+			isReady: true,
+		},
+		{
+			name:  "Syncing",
+			input: []byte(`"syncing"`),
+		},
+		{
+			name:  "NotInitialized",
+			input: []byte(`"not_initialized"`),
+		},
+		{
+			name:  "Invalid",
+			input: []byte(`"invalid"`),
+			err:   "unrecognised node health \"invalid\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeHealth
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, strings.Trim(string(rt), `"`), res.String())
+				assert.Equal(t, test.isReady, res.IsReady())
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// PeerStates are the valid states of a peer.
+var PeerStates = map[string]bool{
+	"disconnected":  true,
+	"connecting":    true,
+	"connected":     true,
+	"disconnecting": true,
+}
+
+// PeerDirections are the valid directions of a peer's connection.
+var PeerDirections = map[string]bool{
+	"inbound":  true,
+	"outbound": true,
+}
+
+// Peer contains information about a peer of the node.
+type Peer struct {
+	// PeerID is the libp2p peer ID of the peer.
+	PeerID string
+	// ENR is the Ethereum node record of the peer, if known.
+	ENR string
+	// LastSeenP2PAddress is the multiaddr at which the peer was last seen, if known.
+	LastSeenP2PAddress string
+	// State is the connection state of the peer.
+	State string
+	// Direction is the direction of the connection to the peer.
+	Direction string
+}
+
+// peerJSON is the spec representation of the struct.
+type peerJSON struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr,omitempty"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address,omitempty"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *Peer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&peerJSON{
+		PeerID:             p.PeerID,
+		ENR:                p.ENR,
+		LastSeenP2PAddress: p.LastSeenP2PAddress,
+		State:              p.State,
+		Direction:          p.Direction,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Peer) UnmarshalJSON(input []byte) error {
+	var peerJSON peerJSON
+	if err := json.Unmarshal(input, &peerJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if peerJSON.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+	p.PeerID = peerJSON.PeerID
+	// ENR is optional.
+	p.ENR = peerJSON.ENR
+	// Last seen p2p address is optional, as some nodes do not report it for disconnected peers.
+	p.LastSeenP2PAddress = peerJSON.LastSeenP2PAddress
+	if peerJSON.State == "" {
+		return errors.New("state missing")
+	}
+	if !PeerStates[peerJSON.State] {
+		return fmt.Errorf("invalid value for state: %s", peerJSON.State)
+	}
+	p.State = peerJSON.State
+	if peerJSON.Direction == "" {
+		return errors.New("direction missing")
+	}
+	if !PeerDirections[peerJSON.Direction] {
+		return fmt.Errorf("invalid value for direction: %s", peerJSON.Direction)
+	}
+	p.Direction = peerJSON.Direction
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *Peer) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
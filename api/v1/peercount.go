@@ -0,0 +1,98 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// PeerCount contains the number of peers of the node in each state.
+type PeerCount struct {
+	Disconnected  uint64
+	Connecting    uint64
+	Connected     uint64
+	Disconnecting uint64
+}
+
+// peerCountJSON is the spec representation of the struct.
+type peerCountJSON struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PeerCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&peerCountJSON{
+		Disconnected:  fmt.Sprintf("%d", p.Disconnected),
+		Connecting:    fmt.Sprintf("%d", p.Connecting),
+		Connected:     fmt.Sprintf("%d", p.Connected),
+		Disconnecting: fmt.Sprintf("%d", p.Disconnecting),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PeerCount) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var peerCountJSON peerCountJSON
+	if err = json.Unmarshal(input, &peerCountJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if peerCountJSON.Disconnected == "" {
+		return errors.New("disconnected missing")
+	}
+	p.Disconnected, err = strconv.ParseUint(peerCountJSON.Disconnected, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for disconnected")
+	}
+	if peerCountJSON.Connecting == "" {
+		return errors.New("connecting missing")
+	}
+	p.Connecting, err = strconv.ParseUint(peerCountJSON.Connecting, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for connecting")
+	}
+	if peerCountJSON.Connected == "" {
+		return errors.New("connected missing")
+	}
+	p.Connected, err = strconv.ParseUint(peerCountJSON.Connected, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for connected")
+	}
+	if peerCountJSON.Disconnecting == "" {
+		return errors.New("disconnecting missing")
+	}
+	p.Disconnecting, err = strconv.ParseUint(peerCountJSON.Disconnecting, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for disconnecting")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PeerCount) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
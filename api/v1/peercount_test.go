@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestPeerCountJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.peerCountJSON",
+		},
+		{
+			name:  "DisconnectedMissing",
+			input: []byte(`{"connecting":"2","connected":"3","disconnecting":"4"}`),
+			err:   "disconnected missing",
+		},
+		{
+			name:  "DisconnectedInvalid",
+			input: []byte(`{"disconnected":"-1","connecting":"2","connected":"3","disconnecting":"4"}`),
+			err:   "invalid value for disconnected: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ConnectingMissing",
+			input: []byte(`{"disconnected":"1","connected":"3","disconnecting":"4"}`),
+			err:   "connecting missing",
+		},
+		{
+			name:  "ConnectingInvalid",
+			input: []byte(`{"disconnected":"1","connecting":"-1","connected":"3","disconnecting":"4"}`),
+			err:   "invalid value for connecting: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ConnectedMissing",
+			input: []byte(`{"disconnected":"1","connecting":"2","disconnecting":"4"}`),
+			err:   "connected missing",
+		},
+		{
+			name:  "ConnectedInvalid",
+			input: []byte(`{"disconnected":"1","connecting":"2","connected":"-1","disconnecting":"4"}`),
+			err:   "invalid value for connected: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "DisconnectingMissing",
+			input: []byte(`{"disconnected":"1","connecting":"2","connected":"3"}`),
+			err:   "disconnecting missing",
+		},
+		{
+			name:  "DisconnectingInvalid",
+			input: []byte(`{"disconnected":"1","connecting":"2","connected":"3","disconnecting":"-1"}`),
+			err:   "invalid value for disconnecting: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"disconnected":"1","connecting":"2","connected":"3","disconnecting":"4"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.PeerCount
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ParseSpec converts the values of a chain spec, as returned by the beacon
+// node API, to typed values based on their keys.
+// Values that cannot be converted are returned as strings.
+func ParseSpec(data map[string]string) map[string]interface{} {
+	config := make(map[string]interface{})
+	for k, v := range data {
+		// Handle domains.
+		if strings.HasPrefix(k, "DOMAIN_") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				var domainType phase0.DomainType
+				copy(domainType[:], byteVal)
+				config[k] = domainType
+				continue
+			}
+		}
+
+		// Handle fork versions.
+		if strings.HasSuffix(k, "_FORK_VERSION") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				var version phase0.Version
+				copy(version[:], byteVal)
+				config[k] = version
+				continue
+			}
+		}
+
+		// Handle hex strings.
+		if strings.HasPrefix(v, "0x") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				config[k] = byteVal
+				continue
+			}
+		}
+
+		// Handle times.
+		if strings.HasSuffix(k, "_TIME") {
+			intVal, err := strconv.ParseInt(v, 10, 64)
+			if err == nil && intVal != 0 {
+				config[k] = time.Unix(intVal, 0)
+				continue
+			}
+		}
+
+		// Handle durations.
+		if strings.HasPrefix(k, "SECONDS_PER_") || k == "GENESIS_DELAY" {
+			intVal, err := strconv.ParseUint(v, 10, 64)
+			if err == nil && intVal != 0 {
+				config[k] = time.Duration(intVal) * time.Second
+				continue
+			}
+		}
+
+		// Handle integers.
+		if v == "0" {
+			config[k] = uint64(0)
+			continue
+		}
+		intVal, err := strconv.ParseUint(v, 10, 64)
+		if err == nil && intVal != 0 {
+			config[k] = intVal
+			continue
+		}
+
+		// Assume string.
+		config[k] = v
+	}
+
+	// The application mask domain type is not provided by all nodes, so add it here if not present.
+	if _, exists := config["DOMAIN_APPLICATION_MASK"]; !exists {
+		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	}
+	// The BLS to execution change domain type is not provided by all nodes, so add it here if not present.
+	if _, exists := config["DOMAIN_BLS_TO_EXECUTION_CHANGE"]; !exists {
+		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+	}
+	// The builder application domain type is not officially part of the spec, so add it here if not present.
+	if _, exists := config["DOMAIN_APPLICATION_BUILDER"]; !exists {
+		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+	}
+	// The blob sidecar domain type is not provided by all nodes, so add it here if not present.
+	if _, exists := config["DOMAIN_BLOB_SIDECAR"]; !exists {
+		config["DOMAIN_BLOB_SIDECAR"] = phase0.DomainType{0x0b, 0x00, 0x00, 0x00}
+	}
+
+	return config
+}
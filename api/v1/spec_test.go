@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec := api.ParseSpec(map[string]string{
+		"CONFIG_NAME":              "mainnet",
+		"DOMAIN_DEPOSIT":           "0x03000000",
+		"GENESIS_FORK_VERSION":     "0x00000000",
+		"DEPOSIT_CONTRACT_ADDRESS": "0x00000000219ab540356cbb839cbe05303d7705fa",
+		"MIN_GENESIS_TIME":         "1606824000",
+		"SECONDS_PER_SLOT":         "12",
+		"SLOTS_PER_EPOCH":          "32",
+		"GENESIS_SLOT":             "0",
+	})
+
+	require.Equal(t, "mainnet", spec["CONFIG_NAME"])
+	require.Equal(t, phase0.DomainType{0x03, 0x00, 0x00, 0x00}, spec["DOMAIN_DEPOSIT"])
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x00}, spec["GENESIS_FORK_VERSION"])
+	require.Len(t, spec["DEPOSIT_CONTRACT_ADDRESS"], 20)
+	require.Equal(t, time.Unix(1606824000, 0), spec["MIN_GENESIS_TIME"])
+	require.Equal(t, 12*time.Second, spec["SECONDS_PER_SLOT"])
+	require.Equal(t, uint64(32), spec["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(0), spec["GENESIS_SLOT"])
+	require.Equal(t, phase0.DomainType{0x00, 0x00, 0x00, 0x01}, spec["DOMAIN_APPLICATION_MASK"])
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestValidatorAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.validatorAttestationRewardsJSON",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"head":"2000","target":"-2000","source":"2000","inactivity":"0"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "ValidatorIndexInvalid",
+			input: []byte(`{"validator_index":"invalid","head":"2000","target":"-2000","source":"2000","inactivity":"0"}`),
+			err:   "invalid value for validator index: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "HeadMissing",
+			input: []byte(`{"validator_index":"1","target":"-2000","source":"2000","inactivity":"0"}`),
+			err:   "head missing",
+		},
+		{
+			name:  "HeadInvalid",
+			input: []byte(`{"validator_index":"1","head":"invalid","target":"-2000","source":"2000","inactivity":"0"}`),
+			err:   "invalid value for head: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "TargetMissing",
+			input: []byte(`{"validator_index":"1","head":"2000","source":"2000","inactivity":"0"}`),
+			err:   "target missing",
+		},
+		{
+			name:  "TargetInvalid",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"invalid","source":"2000","inactivity":"0"}`),
+			err:   "invalid value for target: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","inactivity":"0"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "SourceInvalid",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","source":"invalid","inactivity":"0"}`),
+			err:   "invalid value for source: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "InclusionDelayInvalid",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","source":"2000","inactivity":"0","inclusion_delay":"invalid"}`),
+			err:   "invalid value for inclusion delay: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "InactivityMissing",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","source":"2000"}`),
+			err:   "inactivity missing",
+		},
+		{
+			name:  "InactivityInvalid",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","source":"2000","inactivity":"invalid"}`),
+			err:   "invalid value for inactivity: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"1","head":"2000","target":"-2000","source":"2000","inactivity":"0"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ValidatorAttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -23,6 +23,10 @@ import (
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 )
 
+// ErrNotBlinded is returned when the blinded form of a block prior to bellatrix
+// is requested.  Such blocks do not contain an execution payload, so have no blinded form.
+var ErrNotBlinded = errors.New("blocks prior to bellatrix are not blinded")
+
 // VersionedSignedBlindedBeaconBlock contains a versioned signed blinded beacon block.
 type VersionedSignedBlindedBeaconBlock struct {
 	Version   spec.DataVersion
@@ -214,3 +218,26 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.Propo
 		return nil, errors.New("unknown version")
 	}
 }
+
+// ExecutionBlockHash returns the block hash of the execution payload header of the beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no capella block")
+		}
+		return v.Capella.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no deneb block")
+		}
+		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unknown version")
+	}
+}
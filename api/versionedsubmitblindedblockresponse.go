@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// VersionedSubmitBlindedBlockResponse contains a versioned response to submitting a blinded block to a builder.
+// Prior to deneb this is the execution payload; from deneb onwards it also contains the blobs bundle.
+type VersionedSubmitBlindedBlockResponse struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *apiv1deneb.ExecutionPayloadAndBlobsBundle
+}
+
+// IsEmpty returns true if there is no response.
+func (v *VersionedSubmitBlindedBlockResponse) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// BlockHash returns the block hash of the execution payload.
+func (v *VersionedSubmitBlindedBlockResponse) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix execution payload")
+		}
+		return v.Bellatrix.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella execution payload")
+		}
+		return v.Capella.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no deneb execution payload")
+		}
+		return v.Deneb.ExecutionPayload.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// Transactions returns the transactions of the execution payload.
+func (v *VersionedSubmitBlindedBlockResponse) Transactions() ([]bellatrix.Transaction, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix execution payload")
+		}
+		return v.Bellatrix.Transactions, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella execution payload")
+		}
+		return v.Capella.Transactions, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.ExecutionPayload == nil {
+			return nil, errors.New("no deneb execution payload")
+		}
+		return v.Deneb.ExecutionPayload.Transactions, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedSubmitBlindedBlockResponse) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	default:
+		return "unknown version"
+	}
+}
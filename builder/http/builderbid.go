@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1bellatrix "github.com/jefmcl/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSignedBuilderBidJSON struct {
+	Data *apiv1bellatrix.SignedBuilderBid `json:"data"`
+}
+
+type capellaSignedBuilderBidJSON struct {
+	Data *apiv1capella.SignedBuilderBid `json:"data"`
+}
+
+type denebSignedBuilderBidJSON struct {
+	Data *apiv1deneb.SignedBuilderBid `json:"data"`
+}
+
+// BuilderBid obtains a builder bid for the given slot, parent hash and proposer.
+// If the builder has no bid to offer this returns nil for both the bid and the error.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	accept := contentTypeJSON
+	if s.ssz {
+		accept = acceptSSZ
+	}
+
+	url := fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", slot, parentHash, pubKey)
+	resp, err := s.get(ctx, url, accept)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request builder bid")
+	}
+	if resp.statusCode == http.StatusNoContent || len(resp.body) == 0 {
+		// No bid available.
+		return nil, nil
+	}
+
+	var res *api.VersionedSignedBuilderBid
+	switch resp.contentType {
+	case contentTypeSSZ:
+		res, err = builderBidFromSSZ(resp)
+	case contentTypeJSON:
+		res, err = builderBidFromJSON(resp)
+	default:
+		err = fmt.Errorf("unsupported content type %s", resp.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	bidParentHash, err := res.ParentHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain builder bid parent hash")
+	}
+	if !bytes.Equal(bidParentHash[:], parentHash[:]) {
+		return nil, fmt.Errorf("builder bid has parent hash %#x; expected %#x", bidParentHash, parentHash)
+	}
+
+	return res, nil
+}
+
+func builderBidFromSSZ(resp *response) (*api.VersionedSignedBuilderBid, error) {
+	if resp.consensusVersion == nil {
+		return nil, errors.New("no consensus version supplied with builder bid")
+	}
+
+	res := &api.VersionedSignedBuilderBid{
+		Version: *resp.consensusVersion,
+	}
+	var err error
+	switch res.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		err = res.Bellatrix.UnmarshalSSZ(resp.body)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.SignedBuilderBid{}
+		err = res.Capella.UnmarshalSSZ(resp.body)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.SignedBuilderBid{}
+		err = res.Deneb.UnmarshalSSZ(resp.body)
+	default:
+		return nil, fmt.Errorf("unsupported builder bid version %s", res.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode %s builder bid", res.Version))
+	}
+
+	return res, nil
+}
+
+func builderBidFromJSON(resp *response) (*api.VersionedSignedBuilderBid, error) {
+	var metadata versionedResponseJSON
+	if err := json.Unmarshal(resp.body, &metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+
+	res := &api.VersionedSignedBuilderBid{
+		Version: metadata.Version,
+	}
+	switch res.Version {
+	case spec.DataVersionBellatrix:
+		var data bellatrixSignedBuilderBidJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix builder bid")
+		}
+		res.Bellatrix = data.Data
+	case spec.DataVersionCapella:
+		var data capellaSignedBuilderBidJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella builder bid")
+		}
+		res.Capella = data.Data
+	case spec.DataVersionDeneb:
+		var data denebSignedBuilderBidJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb builder bid")
+		}
+		res.Deneb = data.Data
+	default:
+		return nil, fmt.Errorf("unsupported builder bid version %s", res.Version)
+	}
+	if res.IsEmpty() {
+		return nil, errors.New("builder bid not returned")
+	}
+
+	return res, nil
+}
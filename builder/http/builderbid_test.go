@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderBid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentHash := phase0.Hash32{0x01}
+	bid, payload, _ := testBid(parentHash, phase0.Hash32{0x02})
+
+	tests := []struct {
+		name       string
+		ssz        bool
+		relaySSZ   bool
+		parentHash phase0.Hash32
+		noBid      bool
+	}{
+		{
+			name:       "NoBid",
+			parentHash: phase0.Hash32{0xff},
+			noBid:      true,
+		},
+		{
+			name:       "JSON",
+			parentHash: parentHash,
+		},
+		{
+			name:       "SSZ",
+			ssz:        true,
+			relaySSZ:   true,
+			parentHash: parentHash,
+		},
+		{
+			name:       "SSZUnsupported",
+			ssz:        true,
+			parentHash: parentHash,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, relay := newTestService(ctx, t, test.ssz, test.relaySSZ)
+			require.NoError(t, relay.SetBid(bid, payload))
+
+			res, err := s.BuilderBid(ctx, 1, test.parentHash, phase0.BLSPubKey{0x03})
+			require.NoError(t, err)
+			if test.noBid {
+				require.Nil(t, res)
+				return
+			}
+			require.NotNil(t, res)
+			require.Equal(t, spec.DataVersionCapella, res.Version)
+			value, err := res.Value()
+			require.NoError(t, err)
+			require.Equal(t, "123456789", value.Dec())
+			builder, err := res.Builder()
+			require.NoError(t, err)
+			require.Equal(t, phase0.BLSPubKey{0x01}, builder)
+			blockHash, err := res.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Hash32{0x02}, blockHash)
+			require.Equal(t, bid.String(), res.String())
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+const (
+	contentTypeJSON = "application/json"
+	contentTypeSSZ  = "application/octet-stream"
+	// acceptSSZ prefers SSZ responses but allows JSON.
+	acceptSSZ = "application/octet-stream;q=1.0,application/json;q=0.9"
+	// consensusVersionHeader is the header containing the fork of the data.
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// Error represents an http error.
+type Error struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Data       []byte
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// response is a response from the builder.
+type response struct {
+	statusCode  int
+	contentType string
+	// consensusVersion is the version supplied in the response headers, if any.
+	consensusVersion *spec.DataVersion
+	body             []byte
+}
+
+// get sends an HTTP get request and returns the response.
+func (s *Service) get(ctx context.Context, endpoint string, accept string) (*response, error) {
+	return s.do(ctx, http.MethodGet, endpoint, accept, "", nil, nil)
+}
+
+// post sends an HTTP post request and returns the response.
+func (s *Service) post(ctx context.Context,
+	endpoint string,
+	accept string,
+	contentType string,
+	headers map[string]string,
+	body []byte,
+) (
+	*response,
+	error,
+) {
+	return s.do(ctx, http.MethodPost, endpoint, accept, contentType, headers, body)
+}
+
+// do sends an HTTP request and returns the response.
+// Responses with a status code outside of the 2xx family are returned as an Error.
+func (s *Service) do(ctx context.Context,
+	method string,
+	endpoint string,
+	accept string,
+	contentType string,
+	headers map[string]string,
+	body []byte,
+) (
+	*response,
+	error,
+) {
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	if e := log.Trace(); e.Enabled() {
+		if contentType == contentTypeSSZ {
+			e.Int("body_len", len(body)).Msgf("%s request", method)
+		} else {
+			e.Str("body", string(body)).Msgf("%s request", method)
+		}
+	}
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create %s request", method))
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to call %s endpoint", method))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to read %s response", method))
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msgf("%s failed", method)
+		return nil, Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+
+	res := &response{
+		statusCode:  resp.StatusCode,
+		contentType: contentTypeJSON,
+		body:        data,
+	}
+	if header := resp.Header.Get("Content-Type"); header != "" {
+		mediaType, _, err := mime.ParseMediaType(header)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid content type")
+		}
+		res.contentType = mediaType
+	}
+	if header := resp.Header.Get(consensusVersionHeader); header != "" {
+		var version spec.DataVersion
+		if err := version.UnmarshalJSON([]byte(fmt.Sprintf("%q", header))); err != nil {
+			return nil, errors.Wrap(err, "invalid consensus version header")
+		}
+		res.consensusVersion = &version
+	}
+
+	if res.contentType == contentTypeSSZ {
+		log.Trace().Int("status_code", resp.StatusCode).Int("response_len", len(data)).Msgf("%s response", method)
+	} else {
+		log.Trace().Int("status_code", resp.StatusCode).Str("response", string(data)).Msgf("%s response", method)
+	}
+
+	return res, nil
+}
+
+// unsupportedMediaType returns true if the error states that the builder
+// does not accept the content type of the request.
+func unsupportedMediaType(err error) bool {
+	var httpErr Error
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	return httpErr.StatusCode == http.StatusUnsupportedMediaType
+}
+
+// versionedResponseJSON is the JSON response for versioned data.
+type versionedResponseJSON struct {
+	Version spec.DataVersion `json:"version"`
+}
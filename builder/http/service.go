@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/builder"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a builder client service.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	extraHeaders map[string]string
+	ssz          bool
+}
+
+// New creates a new builder client service, connecting with a standard HTTP.
+func New(ctx context.Context, params ...Parameter) (builder.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "builder").Str("impl", "http").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	var transport http.RoundTripper = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   parameters.timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:        64,
+		MaxConnsPerHost:     64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     600 * time.Second,
+	}
+	if parameters.transport != nil {
+		transport = parameters.transport
+	}
+	client := &http.Client{
+		Timeout:   parameters.timeout,
+		Transport: transport,
+	}
+
+	address := parameters.address
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	if !strings.HasSuffix(address, "/") {
+		address = fmt.Sprintf("%s/", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL")
+	}
+
+	s := &Service{
+		log:          log,
+		base:         base,
+		address:      parameters.address,
+		client:       client,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+		ssz:          parameters.ssz,
+	}
+
+	// Check the status to confirm the connection is good.
+	if err := s.Status(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to confirm builder connection")
+	}
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Builder (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
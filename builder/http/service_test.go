@@ -0,0 +1,192 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/holiman/uint256"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	builderhttp "github.com/jefmcl/go-eth2-client/builder/http"
+	"github.com/jefmcl/go-eth2-client/builder/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relay, err := server.New(server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	srv := httptest.NewServer(relay)
+	defer srv.Close()
+
+	tests := []struct {
+		name       string
+		parameters []builderhttp.Parameter
+		statusCode int
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []builderhttp.Parameter{
+				builderhttp.WithAddress(srv.URL),
+				builderhttp.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "AddressInvalid",
+			parameters: []builderhttp.Parameter{
+				builderhttp.WithAddress(string([]byte{0x01})),
+			},
+			err: `invalid URL: parse "http://\x01/": net/url: invalid control character in URL`,
+		},
+		{
+			name: "Unavailable",
+			parameters: []builderhttp.Parameter{
+				builderhttp.WithAddress(srv.URL),
+			},
+			statusCode: http.StatusServiceUnavailable,
+			err:        `failed to confirm builder connection: failed to obtain builder status: GET failed with status 503: {"code":503,"message":"Service Unavailable"}`,
+		},
+		{
+			name: "Good",
+			parameters: []builderhttp.Parameter{
+				builderhttp.WithLogLevel(zerolog.Disabled),
+				builderhttp.WithAddress(srv.URL),
+				builderhttp.WithTimeout(5 * time.Second),
+				builderhttp.WithExtraHeaders(map[string]string{"User-Agent": "test"}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statusCode := test.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			relay.SetStatusCode(statusCode)
+
+			s, err := builderhttp.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Builder (HTTP)", s.Name())
+				require.Equal(t, srv.URL, s.Address())
+			}
+		})
+	}
+}
+
+// newTestService creates a builder client connected to a new mock relay.
+func newTestService(ctx context.Context, t *testing.T, ssz bool, relaySSZ bool) (*builderhttp.Service, *server.Server) {
+	t.Helper()
+
+	relay, err := server.New(
+		server.WithLogLevel(zerolog.Disabled),
+		server.WithSSZ(relaySSZ),
+	)
+	require.NoError(t, err)
+	srv := httptest.NewServer(relay)
+	t.Cleanup(srv.Close)
+
+	s, err := builderhttp.New(ctx,
+		builderhttp.WithLogLevel(zerolog.Disabled),
+		builderhttp.WithAddress(srv.URL),
+		builderhttp.WithTimeout(5*time.Second),
+		builderhttp.WithSSZ(ssz),
+	)
+	require.NoError(t, err)
+
+	return s.(*builderhttp.Service), relay
+}
+
+// testBid creates a capella bid, along with the matching unblinded payload
+// and signed blinded proposal.
+func testBid(parentHash phase0.Hash32,
+	blockHash phase0.Hash32,
+) (
+	*api.VersionedSignedBuilderBid,
+	*api.VersionedSubmitBlindedBlockResponse,
+	*api.VersionedSignedBlindedBeaconBlock,
+) {
+	header := &capella.ExecutionPayloadHeader{
+		ParentHash: parentHash,
+		BlockHash:  blockHash,
+		ExtraData:  []byte{},
+	}
+	bid := &api.VersionedSignedBuilderBid{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBuilderBid{
+			Message: &apiv1capella.BuilderBid{
+				Header: header,
+				Value:  uint256.NewInt(123456789),
+				Pubkey: phase0.BLSPubKey{0x01},
+			},
+			Signature: phase0.BLSSignature{0x02},
+		},
+	}
+	payload := &api.VersionedSubmitBlindedBlockResponse{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.ExecutionPayload{
+			ParentHash:   parentHash,
+			BlockHash:    blockHash,
+			ExtraData:    []byte{},
+			Transactions: []bellatrix.Transaction{{0x03, 0x04}},
+			Withdrawals:  []*capella.Withdrawal{},
+		},
+	}
+	proposal := &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{
+			Message: &apiv1capella.BlindedBeaconBlock{
+				Slot: 1,
+				Body: &apiv1capella.BlindedBeaconBlockBody{
+					ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      []*phase0.Attestation{},
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: bitfield.NewBitvector512(),
+					},
+					ExecutionPayloadHeader: header,
+					BLSToExecutionChanges:  []*capella.SignedBLSToExecutionChange{},
+				},
+			},
+		},
+	}
+
+	return bid, payload, proposal
+}
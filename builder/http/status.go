@@ -0,0 +1,29 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Status checks the status of the builder.
+func (s *Service) Status(ctx context.Context) error {
+	if _, err := s.get(ctx, "/eth/v1/builder/status", contentTypeJSON); err != nil {
+		return errors.Wrap(err, "failed to obtain builder status")
+	}
+
+	return nil
+}
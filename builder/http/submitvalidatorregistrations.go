@@ -0,0 +1,57 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitValidatorRegistrations submits validator registrations.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	if len(registrations) == 0 {
+		return errors.New("no registrations supplied")
+	}
+
+	// Unwrap versioned registrations.
+	unversionedRegistrations := make([]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		if registration == nil {
+			return errors.New("nil registration supplied")
+		}
+		switch registration.Version {
+		case spec.BuilderVersionV1:
+			if registration.V1 == nil {
+				return errors.New("no V1 registration supplied")
+			}
+			unversionedRegistrations = append(unversionedRegistrations, registration.V1)
+		default:
+			return errors.New("unknown validator registration version")
+		}
+	}
+
+	specJSON, err := json.Marshal(unversionedRegistrations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	if _, err := s.post(ctx, "/eth/v1/builder/validators", contentTypeJSON, contentTypeJSON, nil, specJSON); err != nil {
+		return errors.Wrap(err, "failed to submit validator registrations")
+	}
+
+	return nil
+}
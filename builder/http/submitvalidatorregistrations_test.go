@@ -0,0 +1,89 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitValidatorRegistrations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, relay := newTestService(ctx, t, false, true)
+
+	registration := &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1: &apiv1.SignedValidatorRegistration{
+			Message: &apiv1.ValidatorRegistration{
+				FeeRecipient: bellatrix.ExecutionAddress{0x01},
+				GasLimit:     30000000,
+				Timestamp:    time.Unix(1700000000, 0),
+				Pubkey:       phase0.BLSPubKey{0x02},
+			},
+			Signature: phase0.BLSSignature{0x03},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		registrations []*api.VersionedSignedValidatorRegistration
+		err           string
+	}{
+		{
+			name: "Nil",
+			err:  "no registrations supplied",
+		},
+		{
+			name:          "NilRegistration",
+			registrations: []*api.VersionedSignedValidatorRegistration{nil},
+			err:           "nil registration supplied",
+		},
+		{
+			name: "UnknownVersion",
+			registrations: []*api.VersionedSignedValidatorRegistration{
+				{Version: spec.BuilderVersion(99)},
+			},
+			err: "unknown validator registration version",
+		},
+		{
+			name:          "Good",
+			registrations: []*api.VersionedSignedValidatorRegistration{registration},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := s.SubmitValidatorRegistrations(ctx, test.registrations)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	registrations := relay.Registrations()
+	require.Len(t, registrations, 1)
+	require.Equal(t, registration.V1.Message.GasLimit, registrations[phase0.BLSPubKey{0x02}].Message.GasLimit)
+}
@@ -0,0 +1,229 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSubmitBlindedBlockResponseJSON struct {
+	Data *bellatrix.ExecutionPayload `json:"data"`
+}
+
+type capellaSubmitBlindedBlockResponseJSON struct {
+	Data *capella.ExecutionPayload `json:"data"`
+}
+
+type denebSubmitBlindedBlockResponseJSON struct {
+	Data *apiv1deneb.ExecutionPayloadAndBlobsBundle `json:"data"`
+}
+
+// UnblindedProposal submits a signed blinded proposal and obtains the execution
+// payload, and blobs bundle if applicable, that it commits to.
+func (s *Service) UnblindedProposal(ctx context.Context,
+	proposal *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	if proposal == nil {
+		return nil, errors.New("no proposal supplied")
+	}
+	expectedBlockHash, err := proposal.ExecutionBlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposal")
+	}
+
+	var res *api.VersionedSubmitBlindedBlockResponse
+	if s.ssz {
+		res, err = s.unblindedProposalSSZ(ctx, proposal)
+		if err != nil && unsupportedMediaType(err) {
+			s.log.Debug().Msg("Builder does not accept SSZ; resubmitting as JSON")
+			res, err = s.unblindedProposalJSON(ctx, proposal)
+		}
+	} else {
+		res, err = s.unblindedProposalJSON(ctx, proposal)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if res.Version != proposal.Version {
+		return nil, fmt.Errorf("unblinded proposal has version %s; expected %s", res.Version, proposal.Version)
+	}
+	blockHash, err := res.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain unblinded proposal execution block hash")
+	}
+	if !bytes.Equal(blockHash[:], expectedBlockHash[:]) {
+		return nil, fmt.Errorf("unblinded proposal has execution block hash %#x; expected %#x", blockHash, expectedBlockHash)
+	}
+
+	return res, nil
+}
+
+func (s *Service) unblindedProposalSSZ(ctx context.Context,
+	proposal *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	var body []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		body, err = proposal.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		body, err = proposal.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		body, err = proposal.Deneb.MarshalSSZ()
+	default:
+		err = fmt.Errorf("unsupported proposal version %s", proposal.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	return s.submitBlindedBlock(ctx, proposal.Version, acceptSSZ, contentTypeSSZ, body)
+}
+
+func (s *Service) unblindedProposalJSON(ctx context.Context,
+	proposal *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	var body []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		body, err = json.Marshal(proposal.Bellatrix)
+	case spec.DataVersionCapella:
+		body, err = json.Marshal(proposal.Capella)
+	case spec.DataVersionDeneb:
+		body, err = json.Marshal(proposal.Deneb)
+	default:
+		err = fmt.Errorf("unsupported proposal version %s", proposal.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return s.submitBlindedBlock(ctx, proposal.Version, contentTypeJSON, contentTypeJSON, body)
+}
+
+func (s *Service) submitBlindedBlock(ctx context.Context,
+	version spec.DataVersion,
+	accept string,
+	contentType string,
+	body []byte,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	headers := map[string]string{
+		consensusVersionHeader: version.String(),
+	}
+	resp, err := s.post(ctx, "/eth/v1/builder/blinded_blocks", accept, contentType, headers, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded block")
+	}
+
+	switch resp.contentType {
+	case contentTypeSSZ:
+		return submitBlindedBlockResponseFromSSZ(resp, version)
+	case contentTypeJSON:
+		return submitBlindedBlockResponseFromJSON(resp)
+	default:
+		return nil, fmt.Errorf("unsupported content type %s", resp.contentType)
+	}
+}
+
+func submitBlindedBlockResponseFromSSZ(resp *response, requestVersion spec.DataVersion) (*api.VersionedSubmitBlindedBlockResponse, error) {
+	res := &api.VersionedSubmitBlindedBlockResponse{
+		Version: requestVersion,
+	}
+	if resp.consensusVersion != nil {
+		res.Version = *resp.consensusVersion
+	}
+
+	var err error
+	switch res.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = res.Bellatrix.UnmarshalSSZ(resp.body)
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{}
+		err = res.Capella.UnmarshalSSZ(resp.body)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = res.Deneb.UnmarshalSSZ(resp.body)
+	default:
+		return nil, fmt.Errorf("unsupported unblinded proposal version %s", res.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode %s unblinded proposal", res.Version))
+	}
+
+	return res, nil
+}
+
+func submitBlindedBlockResponseFromJSON(resp *response) (*api.VersionedSubmitBlindedBlockResponse, error) {
+	var metadata versionedResponseJSON
+	if err := json.Unmarshal(resp.body, &metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+
+	res := &api.VersionedSubmitBlindedBlockResponse{
+		Version: metadata.Version,
+	}
+	switch res.Version {
+	case spec.DataVersionBellatrix:
+		var data bellatrixSubmitBlindedBlockResponseJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix unblinded proposal")
+		}
+		res.Bellatrix = data.Data
+	case spec.DataVersionCapella:
+		var data capellaSubmitBlindedBlockResponseJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella unblinded proposal")
+		}
+		res.Capella = data.Data
+	case spec.DataVersionDeneb:
+		var data denebSubmitBlindedBlockResponseJSON
+		if err := json.Unmarshal(resp.body, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb unblinded proposal")
+		}
+		res.Deneb = data.Data
+	default:
+		return nil, fmt.Errorf("unsupported unblinded proposal version %s", res.Version)
+	}
+	if res.IsEmpty() {
+		return nil, errors.New("unblinded proposal not returned")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnblindedProposal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bid, payload, proposal := testBid(phase0.Hash32{0x01}, phase0.Hash32{0x02})
+	_, _, unknownProposal := testBid(phase0.Hash32{0x01}, phase0.Hash32{0x03})
+
+	tests := []struct {
+		name     string
+		ssz      bool
+		relaySSZ bool
+		proposal *api.VersionedSignedBlindedBeaconBlock
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no proposal supplied",
+		},
+		{
+			name: "Empty",
+			proposal: &api.VersionedSignedBlindedBeaconBlock{
+				Version: spec.DataVersionCapella,
+			},
+			err: "invalid proposal: no capella block",
+		},
+		{
+			name:     "UnknownPayload",
+			proposal: unknownProposal,
+			err:      `failed to submit blinded block: POST failed with status 400: {"code":400,"message":"unknown payload"}`,
+		},
+		{
+			name:     "JSON",
+			proposal: proposal,
+		},
+		{
+			name:     "SSZ",
+			ssz:      true,
+			relaySSZ: true,
+			proposal: proposal,
+		},
+		{
+			name:     "SSZUnsupported",
+			ssz:      true,
+			proposal: proposal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, relay := newTestService(ctx, t, test.ssz, test.relaySSZ)
+			require.NoError(t, relay.SetBid(bid, payload))
+
+			res, err := s.UnblindedProposal(ctx, test.proposal)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, spec.DataVersionCapella, res.Version)
+				transactions, err := res.Transactions()
+				require.NoError(t, err)
+				require.Len(t, transactions, 1)
+				require.Equal(t, payload.String(), res.String())
+			}
+		})
+	}
+}
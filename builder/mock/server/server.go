@@ -0,0 +1,408 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server provides a mock MEV relay that serves the builder API over
+// HTTP, returning bids and unblinded payloads that have been set on it.  It
+// implements http.Handler so can be used directly with httptest.NewServer().
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	apiv1bellatrix "github.com/jefmcl/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeSSZ         = "application/octet-stream"
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// Server is a mock MEV relay HTTP server.
+type Server struct {
+	log    zerolog.Logger
+	ssz    bool
+	routes []*route
+
+	mu            sync.RWMutex
+	statusCode    int
+	registrations map[phase0.BLSPubKey]*apiv1.SignedValidatorRegistration
+	// bids are the bids to serve, keyed by parent hash.
+	bids map[phase0.Hash32]*api.VersionedSignedBuilderBid
+	// payloads are the payloads to serve, keyed by block hash.
+	payloads map[phase0.Hash32]*api.VersionedSubmitBlindedBlockResponse
+}
+
+// handlerFunc is the function that handles a route.
+// params contains the values of the route pattern's capture groups.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// New creates a new mock relay server.
+func New(params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "relay").Str("impl", "mock").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Server{
+		log:           log,
+		ssz:           parameters.ssz,
+		statusCode:    http.StatusOK,
+		registrations: make(map[phase0.BLSPubKey]*apiv1.SignedValidatorRegistration),
+		bids:          make(map[phase0.Hash32]*api.VersionedSignedBuilderBid),
+		payloads:      make(map[phase0.Hash32]*api.VersionedSubmitBlindedBlockResponse),
+	}
+	s.handle(http.MethodGet, `/eth/v1/builder/status`, s.handleStatus)
+	s.handle(http.MethodPost, `/eth/v1/builder/validators`, s.handleRegisterValidators)
+	s.handle(http.MethodGet, `/eth/v1/builder/header/(\d+)/(0x[0-9a-fA-F]{64})/(0x[0-9a-fA-F]{96})`, s.handleHeader)
+	s.handle(http.MethodPost, `/eth/v1/builder/blinded_blocks`, s.handleBlindedBlocks)
+
+	return s, nil
+}
+
+// SetStatusCode sets the status code returned by the status endpoint.
+func (s *Server) SetStatusCode(statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.statusCode = statusCode
+}
+
+// SetBid sets the bid served for the parent hash of its header, along with
+// the payload returned when a blinded block committing to the bid is submitted.
+func (s *Server) SetBid(bid *api.VersionedSignedBuilderBid, payload *api.VersionedSubmitBlindedBlockResponse) error {
+	if bid == nil {
+		return errors.New("no bid supplied")
+	}
+	if payload == nil {
+		return errors.New("no payload supplied")
+	}
+	parentHash, err := bid.ParentHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain parent hash")
+	}
+	blockHash, err := bid.BlockHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block hash")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bids[parentHash] = bid
+	s.payloads[blockHash] = payload
+
+	return nil
+}
+
+// Registrations provides the validator registrations received by the relay.
+func (s *Server) Registrations() map[phase0.BLSPubKey]*apiv1.SignedValidatorRegistration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make(map[phase0.BLSPubKey]*apiv1.SignedValidatorRegistration, len(s.registrations))
+	for k, v := range s.registrations {
+		res[k] = v
+	}
+
+	return res
+}
+
+// handle registers a handler for the given method and path pattern.
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, &route{
+		method:  method,
+		pattern: regexp.MustCompile(fmt.Sprintf("^%s$", pattern)),
+		handler: handler,
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := s.log.With().Str("method", r.Method).Str("path", r.URL.Path).Logger()
+	log.Trace().Msg("Request received")
+
+	pathMatched := false
+	for _, route := range s.routes {
+		matches := route.pattern.FindStringSubmatch(r.URL.Path)
+		if matches == nil {
+			continue
+		}
+		pathMatched = true
+		if route.method != r.Method {
+			continue
+		}
+		route.handler(w, r, matches[1:])
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "endpoint not found")
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, _ *http.Request, _ []string) {
+	s.mu.RLock()
+	statusCode := s.statusCode
+	s.mu.RUnlock()
+
+	if statusCode/100 != 2 {
+		writeError(w, statusCode, http.StatusText(statusCode))
+		return
+	}
+	w.WriteHeader(statusCode)
+}
+
+func (s *Server) handleRegisterValidators(w http.ResponseWriter, r *http.Request, _ []string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read body")
+		return
+	}
+	var registrations []*apiv1.SignedValidatorRegistration
+	if err := json.Unmarshal(body, &registrations); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	for _, registration := range registrations {
+		s.registrations[registration.Message.Pubkey] = registration
+	}
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleHeader(w http.ResponseWriter, r *http.Request, params []string) {
+	var parentHash phase0.Hash32
+	data, err := hex.DecodeString(strings.TrimPrefix(params[1], "0x"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid parent hash")
+		return
+	}
+	copy(parentHash[:], data)
+
+	s.mu.RLock()
+	bid, exists := s.bids[parentHash]
+	s.mu.RUnlock()
+	if !exists {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var versioned interface{}
+	var ssz []byte
+	switch bid.Version {
+	case spec.DataVersionBellatrix:
+		versioned = bid.Bellatrix
+		ssz, err = bid.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		versioned = bid.Capella
+		ssz, err = bid.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		versioned = bid.Deneb
+		ssz, err = bid.Deneb.MarshalSSZ()
+	default:
+		err = errors.New("unsupported bid version")
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if s.wantsSSZ(r) {
+		writeSSZ(w, bid.Version.String(), ssz)
+		return
+	}
+	writeVersionedData(w, bid.Version.String(), versioned)
+}
+
+func (s *Server) handleBlindedBlocks(w http.ResponseWriter, r *http.Request, _ []string) {
+	block, statusCode, err := s.readBlindedBlock(r)
+	if err != nil {
+		writeError(w, statusCode, err.Error())
+		return
+	}
+	blockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.RLock()
+	payload, exists := s.payloads[blockHash]
+	s.mu.RUnlock()
+	if !exists {
+		writeError(w, http.StatusBadRequest, "unknown payload")
+		return
+	}
+
+	var versioned interface{}
+	var ssz []byte
+	switch payload.Version {
+	case spec.DataVersionBellatrix:
+		versioned = payload.Bellatrix
+		ssz, err = payload.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		versioned = payload.Capella
+		ssz, err = payload.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		versioned = payload.Deneb
+		ssz, err = payload.Deneb.MarshalSSZ()
+	default:
+		err = errors.New("unsupported payload version")
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if s.wantsSSZ(r) {
+		writeSSZ(w, payload.Version.String(), ssz)
+		return
+	}
+	writeVersionedData(w, payload.Version.String(), versioned)
+}
+
+// readBlindedBlock reads a signed blinded block from the request, returning
+// a suitable status code on error.
+func (s *Server) readBlindedBlock(r *http.Request) (*api.VersionedSignedBlindedBeaconBlock, int, error) {
+	sszRequest := strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeSSZ)
+	if sszRequest && !s.ssz {
+		return nil, http.StatusUnsupportedMediaType, errors.New("SSZ not supported")
+	}
+
+	var version spec.DataVersion
+	if err := version.UnmarshalJSON([]byte(fmt.Sprintf("%q", r.Header.Get(consensusVersionHeader)))); err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "invalid consensus version")
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "failed to read body")
+	}
+
+	block := &api.VersionedSignedBlindedBeaconBlock{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionBellatrix:
+		block.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		err = decode(sszRequest, body, block.Bellatrix)
+	case spec.DataVersionCapella:
+		block.Capella = &apiv1capella.SignedBlindedBeaconBlock{}
+		err = decode(sszRequest, body, block.Capella)
+	case spec.DataVersionDeneb:
+		block.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{}
+		err = decode(sszRequest, body, block.Deneb)
+	default:
+		err = errors.New("unsupported version")
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "invalid blinded block")
+	}
+
+	return block, http.StatusOK, nil
+}
+
+// sszUnmarshaler is the interface for objects that can be decoded from SSZ.
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// decode decodes the body in to the given object.
+func decode(ssz bool, body []byte, obj sszUnmarshaler) error {
+	if ssz {
+		return obj.UnmarshalSSZ(body)
+	}
+
+	return json.Unmarshal(body, obj)
+}
+
+// wantsSSZ returns true if the relay supports SSZ and the request prefers it.
+func (s *Server) wantsSSZ(r *http.Request) bool {
+	return s.ssz && strings.HasPrefix(r.Header.Get("Accept"), contentTypeSSZ)
+}
+
+// dataResponse is the standard wrapper for responses.
+type dataResponse struct {
+	Version string      `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+// errorResponse is the standard error response.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeVersionedData writes a standard data response with a version.
+func writeVersionedData(w http.ResponseWriter, version string, data interface{}) {
+	w.Header().Set(consensusVersionHeader, version)
+	writeJSON(w, http.StatusOK, &dataResponse{Version: version, Data: data})
+}
+
+// writeError writes a standard error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, &errorResponse{Code: statusCode, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(fmt.Sprintf(`{"code":500,"message":%q}`, err.Error()))
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// writeSSZ writes an SSZ response.
+func writeSSZ(w http.ResponseWriter, version string, data []byte) {
+	w.Header().Set("Content-Type", contentTypeSSZ)
+	w.Header().Set(consensusVersionHeader, version)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package builder provides interfaces for talking to a block builder, usually
+// via an MEV relay, using the builder API.
+package builder
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// Service is the service providing a connection to a builder.
+type Service interface {
+	// Name returns the name of the builder implementation.
+	Name() string
+
+	// Address returns the address of the builder.
+	Address() string
+}
+
+// ValidatorRegistrationsSubmitter is the interface for submitting validator registrations to a builder.
+type ValidatorRegistrationsSubmitter interface {
+	// SubmitValidatorRegistrations submits validator registrations.
+	SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error
+}
+
+// BuilderBidProvider is the interface for obtaining builder bids.
+type BuilderBidProvider interface {
+	// BuilderBid obtains a builder bid for the given slot, parent hash and proposer.
+	// If the builder has no bid to offer this returns nil for both the bid and the error.
+	BuilderBid(ctx context.Context, slot phase0.Slot, parentHash phase0.Hash32, pubKey phase0.BLSPubKey) (*api.VersionedSignedBuilderBid, error)
+}
+
+// UnblindedProposalProvider is the interface for unblinding proposals.
+type UnblindedProposalProvider interface {
+	// UnblindedProposal submits a signed blinded proposal and obtains the execution
+	// payload, and blobs bundle if applicable, that it commits to.
+	UnblindedProposal(ctx context.Context, proposal *api.VersionedSignedBlindedBeaconBlock) (*api.VersionedSubmitBlindedBlockResponse, error)
+}
+
+// StatusProvider is the interface for checking the status of a builder.
+type StatusProvider interface {
+	// Status returns an error if the builder is not in a position to serve requests.
+	Status(ctx context.Context) error
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// attesterDutiesEntry holds the attester duties obtained for an epoch.
+type attesterDutiesEntry struct {
+	mu sync.Mutex
+	// duties are the duties for validators, by validator index.
+	duties map[phase0.ValidatorIndex]*apiv1.AttesterDuty
+	// fetched are the validators for which duties have been fetched,
+	// including those without duties.
+	fetched map[phase0.ValidatorIndex]bool
+}
+
+// AttesterDuties obtains attester duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Service) AttesterDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.AttesterDuty,
+	error,
+) {
+	next, isNext := s.next.(consensusclient.AttesterDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	if validatorIndices == nil {
+		// Requests for all duties are not cached.
+		return next.AttesterDuties(ctx, epoch, validatorIndices)
+	}
+
+	dependentRoot, err := s.attesterDependentRoot(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("attester:%d:%#x", epoch, dependentRoot)
+	var entry *attesterDutiesEntry
+	if value, exists := s.duties.get(key); exists {
+		entry = value.(*attesterDutiesEntry)
+	} else {
+		entry = &attesterDutiesEntry{
+			duties:  make(map[phase0.ValidatorIndex]*apiv1.AttesterDuty),
+			fetched: make(map[phase0.ValidatorIndex]bool),
+		}
+		s.duties.set(key, entry, 0, false)
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	missing := make([]phase0.ValidatorIndex, 0)
+	for _, index := range validatorIndices {
+		if !entry.fetched[index] {
+			missing = append(missing, index)
+		}
+	}
+	if len(missing) > 0 {
+		duties, err := next.AttesterDuties(ctx, epoch, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, duty := range duties {
+			entry.duties[duty.ValidatorIndex] = duty
+		}
+		for _, index := range missing {
+			entry.fetched[index] = true
+		}
+	}
+
+	res := make([]*apiv1.AttesterDuty, 0, len(validatorIndices))
+	for _, index := range validatorIndices {
+		if duty, exists := entry.duties[index]; exists {
+			res = append(res, duty)
+		}
+	}
+
+	return res, nil
+}
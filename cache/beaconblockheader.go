@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeader provides the block header of a given block ID.
+func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	if value, exists := s.headers.get(blockID); exists {
+		return value.(*apiv1.BeaconBlockHeader), nil
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	header, err := next.BeaconBlockHeader(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return header, nil
+	}
+
+	// Headers contain the canonical flag, which can only be relied upon once
+	// the block is finalized.
+	if header.Canonical && s.isFinalized(header.Header.Message.Slot) {
+		s.headers.set(fmt.Sprintf("%#x", header.Root), header, 0, false)
+		s.headers.set(fmt.Sprintf("%d", header.Header.Message.Slot), header, 0, false)
+	}
+
+	return header, nil
+}
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+// Results are not cached, as they can include blocks that are not yet finalized.
+func (s *Service) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	next, isNext := s.next.(consensusclient.FilteredBeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconBlockHeaders(ctx, slot, parentRoot)
+}
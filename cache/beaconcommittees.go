@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// BeaconCommittees fetches all beacon committees for the epoch at the given state.
+func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*apiv1.BeaconCommittee, error) {
+	if slot, isSlot := idSlot(stateID); isSlot {
+		return s.beaconCommitteesAtEpoch(ctx, stateID, phase0.Epoch(uint64(slot)/s.slotsPerEpoch), false)
+	}
+
+	key := fmt.Sprintf("state:%s", stateID)
+	if isRootID(stateID) {
+		if value, exists := s.committees.get(key); exists {
+			return value.([]*apiv1.BeaconCommittee), nil
+		}
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	committees, err := next.BeaconCommittees(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isRootID(stateID) && committees != nil {
+		s.committees.set(key, committees, 0, false)
+	}
+
+	return committees, nil
+}
+
+// BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
+func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	return s.beaconCommitteesAtEpoch(ctx, stateID, epoch, true)
+}
+
+// BeaconCommitteesWithOpts fetches the beacon committees matching the given options.
+// Requests for all committees of an epoch are served from the cache; requests
+// filtered by slot or index are passed through.
+func (s *Service) BeaconCommitteesWithOpts(ctx context.Context, opts *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error) {
+	if opts != nil && opts.Slot == nil && opts.Index == nil {
+		if opts.Epoch != nil {
+			return s.BeaconCommitteesAtEpoch(ctx, opts.State, *opts.Epoch)
+		}
+		return s.BeaconCommittees(ctx, opts.State)
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconCommitteesWithOptsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconCommitteesWithOpts(ctx, opts)
+}
+
+// beaconCommitteesAtEpoch fetches beacon committees for an epoch, caching them
+// against the dependent root of the epoch's shuffling.
+func (s *Service) beaconCommitteesAtEpoch(ctx context.Context,
+	stateID string,
+	epoch phase0.Epoch,
+	atEpoch bool,
+) (
+	[]*apiv1.BeaconCommittee,
+	error,
+) {
+	dependentRoot, err := s.attesterDependentRoot(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("epoch:%d:%#x", epoch, dependentRoot)
+	if value, exists := s.committees.get(key); exists {
+		return value.([]*apiv1.BeaconCommittee), nil
+	}
+
+	next, isNext := s.next.(consensusclient.BeaconCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	var committees []*apiv1.BeaconCommittee
+	if atEpoch {
+		committees, err = next.BeaconCommitteesAtEpoch(ctx, stateID, epoch)
+	} else {
+		committees, err = next.BeaconCommittees(ctx, stateID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if committees != nil {
+		s.committees.set(key, committees, 0, false)
+	}
+
+	return committees, nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain provides headers with roots that can be altered to simulate reorgs.
+type testChain struct {
+	mu    sync.Mutex
+	roots map[phase0.Slot]phase0.Root
+}
+
+func newTestChain() *testChain {
+	return &testChain{
+		roots: make(map[phase0.Slot]phase0.Root),
+	}
+}
+
+func (c *testChain) setRoot(slot phase0.Slot, root phase0.Root) {
+	c.mu.Lock()
+	c.roots[slot] = root
+	c.mu.Unlock()
+}
+
+func (c *testChain) header(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	root, exists := c.roots[phase0.Slot(slot)]
+	c.mu.Unlock()
+	if !exists {
+		root = phase0.Root{byte(slot), byte(slot >> 8)}
+	}
+
+	return &apiv1.BeaconBlockHeader{
+		Root:      root,
+		Canonical: true,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot: phase0.Slot(slot),
+			},
+		},
+	}, nil
+}
+
+func TestBeaconCommitteesAtEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, handler := newTestService(ctx, t)
+	chain := newTestChain()
+	mockService.BeaconBlockHeaderFunc = chain.header
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data:  &apiv1.HeadEvent{Slot: 320},
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := service.(consensusclient.BeaconCommitteesProvider).BeaconCommitteesAtEpoch(ctx, "head", 10)
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("BeaconCommitteesAtEpoch"), 1)
+
+	// The same epoch requested by slot is also served from the cache.
+	_, err := service.(consensusclient.BeaconCommitteesProvider).BeaconCommittees(ctx, "330")
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("BeaconCommitteesAtEpoch"), 1)
+	require.Empty(t, mockService.Calls("BeaconCommittees"))
+
+	// A reorg that alters the dependent root results in a refetch.
+	chain.setRoot(287, phase0.Root{0xff})
+	handler(&apiv1.Event{
+		Topic: "chain_reorg",
+		Data:  &apiv1.ChainReorgEvent{Slot: 320},
+	})
+	for i := 0; i < 3; i++ {
+		_, err := service.(consensusclient.BeaconCommitteesProvider).BeaconCommitteesAtEpoch(ctx, "head", 10)
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("BeaconCommitteesAtEpoch"), 2)
+}
+
+func TestBeaconCommitteesHead(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, _ := newTestService(ctx, t)
+
+	// Committees for the head state are not cached, as the epoch is not known.
+	for i := 0; i < 3; i++ {
+		_, err := service.(consensusclient.BeaconCommitteesProvider).BeaconCommittees(ctx, "head")
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("BeaconCommittees"), 3)
+}
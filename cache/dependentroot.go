@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// dependentRoot returns the dependent root for data that is decided at the
+// start of the given epoch, that is the root of the last block before the
+// epoch starts, or the genesis block for epoch 0.
+func (s *Service) dependentRoot(ctx context.Context, epoch phase0.Epoch) (phase0.Root, error) {
+	if epoch == 0 {
+		return s.rootAtOrBefore(ctx, 0)
+	}
+
+	return s.rootAtOrBefore(ctx, s.epochStartSlot(epoch)-1)
+}
+
+// rootAtOrBefore returns the root of the canonical block at the given slot, or
+// the latest canonical block before it if the slot is empty.
+func (s *Service) rootAtOrBefore(ctx context.Context, slot phase0.Slot) (phase0.Root, error) {
+	s.dependentRootsMu.Lock()
+	root, exists := s.dependentRoots[slot]
+	s.dependentRootsMu.Unlock()
+	if exists {
+		return root, nil
+	}
+
+	// Only store the root if the slot is not in the future, as a block could
+	// yet arrive for it.
+	requestedSlot := slot
+	cacheable := s.isFinalized(requestedSlot) || (s.eventsActive && s.isPast(requestedSlot))
+	for i := uint64(0); i < s.slotsPerEpoch; i++ {
+		header, err := s.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+		if err != nil {
+			return phase0.Root{}, errors.Wrap(err, "failed to obtain block header")
+		}
+		if header != nil {
+			if cacheable {
+				s.dependentRootsMu.Lock()
+				s.dependentRoots[requestedSlot] = header.Root
+				s.dependentRootsMu.Unlock()
+			}
+
+			return header.Root, nil
+		}
+		if slot == 0 {
+			break
+		}
+		slot--
+	}
+
+	return phase0.Root{}, errors.New("failed to find block for dependent root")
+}
+
+// storeHeadDependentRoots stores the dependent roots supplied in a head event.
+func (s *Service) storeHeadDependentRoots(event *apiv1.HeadEvent) {
+	epoch := phase0.Epoch(uint64(event.Slot) / s.slotsPerEpoch)
+
+	s.dependentRootsMu.Lock()
+	defer s.dependentRootsMu.Unlock()
+
+	if event.CurrentDutyDependentRoot != (phase0.Root{}) {
+		if epoch == 0 {
+			s.dependentRoots[0] = event.CurrentDutyDependentRoot
+		} else {
+			s.dependentRoots[s.epochStartSlot(epoch)-1] = event.CurrentDutyDependentRoot
+		}
+	}
+	if event.PreviousDutyDependentRoot != (phase0.Root{}) {
+		if epoch <= 1 {
+			s.dependentRoots[0] = event.PreviousDutyDependentRoot
+		} else {
+			s.dependentRoots[s.epochStartSlot(epoch-1)-1] = event.PreviousDutyDependentRoot
+		}
+	}
+}
+
+// purgeDependentRoots removes dependent roots for non-finalized slots.
+func (s *Service) purgeDependentRoots() {
+	s.dependentRootsMu.Lock()
+	defer s.dependentRootsMu.Unlock()
+
+	for slot := range s.dependentRoots {
+		if !s.isFinalized(slot) {
+			delete(s.dependentRoots, slot)
+		}
+	}
+}
+
+// attesterDependentRoot returns the dependent root for committees and
+// attester duties in the given epoch.
+func (s *Service) attesterDependentRoot(ctx context.Context, epoch phase0.Epoch) (phase0.Root, error) {
+	if epoch == 0 {
+		return s.dependentRoot(ctx, 0)
+	}
+
+	return s.dependentRoot(ctx, epoch-1)
+}
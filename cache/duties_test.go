@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, handler := newTestService(ctx, t)
+	chain := newTestChain()
+	mockService.BeaconBlockHeaderFunc = chain.header
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data:  &apiv1.HeadEvent{Slot: 320},
+	})
+
+	duties, err := service.(consensusclient.AttesterDutiesProvider).AttesterDuties(ctx, 10, []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Len(t, duties, 2)
+
+	// Only validators not previously requested are fetched.
+	duties, err = service.(consensusclient.AttesterDutiesProvider).AttesterDuties(ctx, 10, []phase0.ValidatorIndex{2, 3})
+	require.NoError(t, err)
+	require.Len(t, duties, 2)
+	require.Equal(t, phase0.ValidatorIndex(2), duties[0].ValidatorIndex)
+	require.Equal(t, phase0.ValidatorIndex(3), duties[1].ValidatorIndex)
+
+	calls := mockService.Calls("AttesterDuties")
+	require.Len(t, calls, 2)
+	require.Equal(t, []phase0.ValidatorIndex{3}, calls[1].Args[1])
+
+	// Fully cached.
+	_, err = service.(consensusclient.AttesterDutiesProvider).AttesterDuties(ctx, 10, []phase0.ValidatorIndex{1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("AttesterDuties"), 2)
+}
+
+func TestProposerDuties(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, handler := newTestService(ctx, t)
+	chain := newTestChain()
+	mockService.BeaconBlockHeaderFunc = chain.header
+	mockService.ProposerDutiesFunc = func(_ context.Context, epoch phase0.Epoch, _ []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+		duties := make([]*apiv1.ProposerDuty, 32)
+		for i := range duties {
+			duties[i] = &apiv1.ProposerDuty{
+				Slot:           phase0.Slot(uint64(epoch)*32 + uint64(i)),
+				ValidatorIndex: phase0.ValidatorIndex(i),
+			}
+		}
+		return duties, nil
+	}
+
+	// Dependent root supplied by the head event.
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:                     320,
+			CurrentDutyDependentRoot: phase0.Root{0x01},
+		},
+	})
+
+	duties, err := service.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, duties, 32)
+	duties, err = service.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, 10, []phase0.ValidatorIndex{5})
+	require.NoError(t, err)
+	require.Len(t, duties, 1)
+	require.Equal(t, phase0.Slot(325), duties[0].Slot)
+	require.Len(t, mockService.Calls("ProposerDuties"), 1)
+	// The dependent root did not need to be looked up.
+	require.Empty(t, mockService.Calls("BeaconBlockHeader"))
+
+	// A new dependent root results in a refetch.
+	handler(&apiv1.Event{
+		Topic: "chain_reorg",
+		Data:  &apiv1.ChainReorgEvent{Slot: 320},
+	})
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data: &apiv1.HeadEvent{
+			Slot:                     320,
+			CurrentDutyDependentRoot: phase0.Root{0x02},
+		},
+	})
+	_, err = service.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("ProposerDuties"), 2)
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lru is a size-limited least-recently-used cache, with optional per-entry expiry.
+type lru struct {
+	name string
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type lruEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+	// mutable is true if the entry can change with the chain, and should be
+	// dropped on head and reorg events.
+	mutable bool
+}
+
+// newLRU creates a new LRU cache.
+func newLRU(name string, size int) *lru {
+	return &lru{
+		name:    name,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the value for the key, if present and not expired.
+func (c *lru) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, exists := c.entries[key]
+	if !exists {
+		cacheAccessed(c.name, false)
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		c.removeElement(element)
+		cacheAccessed(c.name, false)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	cacheAccessed(c.name, true)
+
+	return entry.value, true
+}
+
+// set sets the value for the key.  A zero TTL means that the entry does not expire.
+func (c *lru) set(key string, value interface{}, ttl time.Duration, mutable bool) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry{
+		key:     key,
+		value:   value,
+		mutable: mutable,
+	}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	if element, exists := c.entries[key]; exists {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// purgeMutable removes all mutable entries.
+func (c *lru) purgeMutable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if element.Value.(*lruEntry).mutable {
+			c.removeElement(element)
+		}
+		element = next
+	}
+}
+
+// len returns the number of entries in the cache.
+func (c *lru) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// removeElement removes an element.  Must be called with the lock held.
+func (c *lru) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,164 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	monitor            metrics.Service
+	service            consensusclient.Service
+	blockCacheSize     int
+	headerCacheSize    int
+	stateCacheSize     int
+	committeeCacheSize int
+	dutyCacheSize      int
+	validatorCacheSize int
+	ttl                time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the service.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithService sets the service for which to cache responses.
+func WithService(service consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithBlockCacheSize sets the maximum number of blocks to cache.
+func WithBlockCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blockCacheSize = size
+	})
+}
+
+// WithHeaderCacheSize sets the maximum number of block headers to cache.
+func WithHeaderCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.headerCacheSize = size
+	})
+}
+
+// WithStateCacheSize sets the maximum number of beacon states to cache.
+func WithStateCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stateCacheSize = size
+	})
+}
+
+// WithCommitteeCacheSize sets the maximum number of sets of beacon committees to cache.
+func WithCommitteeCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.committeeCacheSize = size
+	})
+}
+
+// WithDutyCacheSize sets the maximum number of epochs of duties to cache.
+func WithDutyCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutyCacheSize = size
+	})
+}
+
+// WithValidatorCacheSize sets the maximum number of validator lookups to cache.
+func WithValidatorCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorCacheSize = size
+	})
+}
+
+// WithTTL sets the time for which data that can change with the chain, such
+// as validator information for the head state, is cached.
+func WithTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.ttl = ttl
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:           zerolog.GlobalLevel(),
+		blockCacheSize:     256,
+		headerCacheSize:    1024,
+		stateCacheSize:     4,
+		committeeCacheSize: 16,
+		dutyCacheSize:      16,
+		validatorCacheSize: 16,
+		ttl:                12 * time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	if parameters.blockCacheSize < 0 {
+		return nil, errors.New("block cache size cannot be negative")
+	}
+	if parameters.headerCacheSize < 0 {
+		return nil, errors.New("header cache size cannot be negative")
+	}
+	if parameters.stateCacheSize < 0 {
+		return nil, errors.New("state cache size cannot be negative")
+	}
+	if parameters.committeeCacheSize < 0 {
+		return nil, errors.New("committee cache size cannot be negative")
+	}
+	if parameters.dutyCacheSize < 0 {
+		return nil, errors.New("duty cache size cannot be negative")
+	}
+	if parameters.validatorCacheSize < 0 {
+		return nil, errors.New("validator cache size cannot be negative")
+	}
+	if parameters.ttl < 0 {
+		return nil, errors.New("TTL cannot be negative")
+	}
+
+	return &parameters, nil
+}
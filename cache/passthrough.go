@@ -0,0 +1,749 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// The calls in this file are not cached, and are passed directly to the
+// underlying service.
+
+// EpochFromStateID converts a state ID to its epoch.
+func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.EpochFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.EpochFromStateID(ctx, stateID)
+}
+
+// SlotFromStateID converts a state ID to its slot.
+func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (phase0.Slot, error) {
+	next, isNext := s.next.(consensusclient.SlotFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SlotFromStateID(ctx, stateID)
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Service) NodeVersion(ctx context.Context) (string, error) {
+	next, isNext := s.next.(consensusclient.NodeVersionProvider)
+	if !isNext {
+		return "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeVersion(ctx)
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	next, isNext := s.next.(consensusclient.SlotDurationProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SlotDuration(ctx)
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.SlotsPerEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SlotsPerEpoch(ctx)
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Service) FarFutureEpoch(ctx context.Context) (phase0.Epoch, error) {
+	next, isNext := s.next.(consensusclient.FarFutureEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.FarFutureEpoch(ctx)
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Service) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	next, isNext := s.next.(consensusclient.GenesisValidatorsRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.GenesisValidatorsRoot(ctx)
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	next, isNext := s.next.(consensusclient.TargetAggregatorsPerCommitteeProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.TargetAggregatorsPerCommittee(ctx)
+}
+
+// AggregateAttestation fetches the aggregate attestation given an attestation.
+func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.AggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AggregateAttestation(ctx, slot, attestationDataRoot)
+}
+
+// SubmitAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
+	next, isNext := s.next.(consensusclient.AggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitAggregateAttestations(ctx, aggregateAndProofs)
+}
+
+// AttestationData fetches the attestation data for the given slot and committee index.
+func (s *Service) AttestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	next, isNext := s.next.(consensusclient.AttestationDataProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationData(ctx, slot, committeeIndex)
+}
+
+// AttestationPool fetches the attestation pool for the given slot.
+func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationPool(ctx, slot)
+}
+
+// AttestationPoolWithOpts fetches the attestations in the pool matching the given options.
+func (s *Service) AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.AttestationPoolWithOptsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationPoolWithOpts(ctx, opts)
+}
+
+// SubmitAttestations submits attestations.
+func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
+	next, isNext := s.next.(consensusclient.AttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitAttestations(ctx, attestations)
+}
+
+// SubmitProposalPreparations submits proposal preparations.
+func (s *Service) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
+	next, isNext := s.next.(consensusclient.ProposalPreparationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitProposalPreparations(ctx, preparations)
+}
+
+// SubmitSyncCommitteeContributions submits sync committee contributions.
+func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
+}
+
+// SubmitSyncCommitteeMessages submits sync committee messages.
+func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeMessagesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitSyncCommitteeMessages(ctx, messages)
+}
+
+// BeaconBlockRoot fetches a block's root given a block ID.
+func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockRoot(ctx, blockID)
+}
+
+// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+func (s *Service) BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockAttestationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockAttestations(ctx, blockID)
+}
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	next, isNext := s.next.(consensusclient.SignedBlindedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SignedBlindedBeaconBlock(ctx, blockID)
+}
+
+// BeaconBlockProposal fetches a proposed beacon block for signing.
+func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+	next, isNext := s.next.(consensusclient.BeaconBlockProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+}
+
+// SubmitBeaconBlock submits a beacon block.
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	next, isNext := s.next.(consensusclient.BeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBeaconBlock(ctx, block)
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// SubmitBlindedBeaconBlock submits a blinded beacon block.
+func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBlindedBeaconBlock(ctx, block)
+}
+
+// SubmitValidatorRegistrations submits a validator registration.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	next, isNext := s.next.(consensusclient.ValidatorRegistrationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitValidatorRegistrations(ctx, registrations)
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	next, isNext := s.next.(consensusclient.SyncCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitSyncCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+func (s *Service) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	next, isNext := s.next.(consensusclient.EventsProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Events(ctx, topics, handler)
+}
+
+// ExpectedWithdrawals provides the withdrawals that will be included in the next block built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error) {
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ExpectedWithdrawals(ctx, stateID, proposalSlot)
+}
+
+// FilteredValidators provides the validators, with their balance and status, for a given state.
+func (s *Service) FilteredValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	next, isNext := s.next.(consensusclient.FilteredValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.FilteredValidators(ctx, stateID, validatorIndices, validatorPubKeys, validatorStates)
+}
+
+// Finality provides the finality given a state ID.
+func (s *Service) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
+	next, isNext := s.next.(consensusclient.FinalityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Finality(ctx, stateID)
+}
+
+// Fork fetches fork information for the given state.
+func (s *Service) Fork(ctx context.Context, stateID string) (*phase0.Fork, error) {
+	next, isNext := s.next.(consensusclient.ForkProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Fork(ctx, stateID)
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
+	next, isNext := s.next.(consensusclient.ForkScheduleProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkSchedule(ctx)
+}
+
+// Genesis fetches genesis information for the chain.
+func (s *Service) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
+	next, isNext := s.next.(consensusclient.GenesisProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Genesis(ctx)
+}
+
+// HistoricalRoots fetches the historical roots of the given state.
+func (s *Service) HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.HistoricalRootsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.HistoricalRoots(ctx, stateID)
+}
+
+// HistoricalSummaries fetches the historical summaries of the given state.
+func (s *Service) HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error) {
+	next, isNext := s.next.(consensusclient.HistoricalSummariesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.HistoricalSummaries(ctx, stateID)
+}
+
+// AttesterSlashingPool provides the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttesterSlashingPool(ctx)
+}
+
+// BLSToExecutionChangePool provides the BLS to execution address change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BLSToExecutionChangePool(ctx)
+}
+
+// ProposerSlashingPool provides the proposer slashing pool.
+func (s *Service) ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error) {
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ProposerSlashingPool(ctx)
+}
+
+// VoluntaryExitPool provides the voluntary exit pool.
+func (s *Service) VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	next, isNext := s.next.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.VoluntaryExitPool(ctx)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, attesterSlashing *phase0.AttesterSlashing) error {
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitAttesterSlashing(ctx, attesterSlashing)
+}
+
+// SubmitProposerSlashing submits a proposer slashing.
+func (s *Service) SubmitProposerSlashing(ctx context.Context, proposerSlashing *phase0.ProposerSlashing) error {
+	next, isNext := s.next.(consensusclient.ProposerSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitProposerSlashing(ctx, proposerSlashing)
+}
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationRewards(ctx, epoch, validatorIndices)
+}
+
+// BlockRewards provides the rewards paid to the proposer of the given block.
+func (s *Service) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given block.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeRewards(ctx, blockID, validatorIndices)
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Service) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error) {
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ValidatorLiveness(ctx, epoch, validatorIndices)
+}
+
+// LightClientBootstrap fetches the light client bootstrap for the given block root.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error) {
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientBootstrap(ctx, blockRoot)
+}
+
+// LightClientUpdates fetches light client updates for count sync committee periods, starting with the given period.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error) {
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientUpdates(ctx, startPeriod, count)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// ChainHeads provides the heads of all chains known to the node.
+func (s *Service) ChainHeads(ctx context.Context) ([]*apiv1.ChainHead, error) {
+	next, isNext := s.next.(consensusclient.ChainHeadsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ChainHeads(ctx)
+}
+
+// ForkChoice provides a dump of the fork choice store of the node.
+func (s *Service) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
+	next, isNext := s.next.(consensusclient.ForkChoiceProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkChoice(ctx)
+}
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context) (apiv1.NodeHealth, error) {
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return apiv1.NodeHealthUnknown, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeHealth(ctx)
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Service) NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error) {
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeIdentity(ctx)
+}
+
+// NodePeers provides the peers of the node.
+func (s *Service) NodePeers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeers(ctx, states, directions)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Service) NodePeer(ctx context.Context, peerID string) (*apiv1.Peer, error) {
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeer(ctx, peerID)
+}
+
+// NodePeerCount provides the number of peers of the node in each state.
+func (s *Service) NodePeerCount(ctx context.Context) (*apiv1.PeerCount, error) {
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeerCount(ctx)
+}
+
+// BeaconCommitteeSelections submits partial beacon committee selection proofs and returns the aggregated selection proofs.
+func (s *Service) BeaconCommitteeSelections(ctx context.Context, selections []*apiv1.BeaconCommitteeSelection) ([]*apiv1.BeaconCommitteeSelection, error) {
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconCommitteeSelections(ctx, selections)
+}
+
+// NodeSyncing provides the state of the node's synchronization with the chain.
+func (s *Service) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
+	next, isNext := s.next.(consensusclient.NodeSyncingProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeSyncing(ctx)
+}
+
+// SyncCommitteeSelections submits partial sync committee selection proofs and returns the aggregated selection proofs.
+func (s *Service) SyncCommitteeSelections(ctx context.Context, selections []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeSelections(ctx, selections)
+}
+
+// SyncCommittee fetches the sync committee for the given state.
+func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommittee(ctx, stateID)
+}
+
+// SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
+func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeAtEpoch(ctx, stateID, epoch)
+}
+
+// SyncCommitteeContribution provides a sync committee contribution.
+func (s *Service) SyncCommitteeContribution(ctx context.Context, slot phase0.Slot, subcommitteeIndex uint64, beaconBlockRoot phase0.Root) (*altair.SyncCommitteeContribution, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeContributionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeContribution(ctx, slot, subcommitteeIndex, beaconBlockRoot)
+}
+
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Service) SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error) {
+	next, isNext := s.next.(consensusclient.SyncCommitteeDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeDuties(ctx, epoch, validatorIndices)
+}
+
+// Spec provides the spec information of the chain.
+func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
+	next, isNext := s.next.(consensusclient.SpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Spec(ctx)
+}
+
+// ChainSpec provides the spec information of the chain.
+func (s *Service) ChainSpec(ctx context.Context) (*apiv1.ChainSpec, error) {
+	next, isNext := s.next.(consensusclient.ChainSpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ChainSpec(ctx)
+}
+
+// will be applied.
+func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	next, isNext := s.next.(consensusclient.ValidatorBalancesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ValidatorBalances(ctx, stateID, validatorIndices)
+}
+
+// SubmitVoluntaryExit submits a voluntary exit.
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	next, isNext := s.next.(consensusclient.VoluntaryExitSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitVoluntaryExit(ctx, voluntaryExit)
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Domain(ctx, domainType, epoch)
+}
+
+// GenesisDomain provides a domain for a given domain type.
+func (s *Service) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	next, isNext := s.next.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.GenesisDomain(ctx, domainType)
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	next, isNext := s.next.(consensusclient.GenesisTimeProvider)
+	if !isNext {
+		return time.Time{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.GenesisTime(ctx)
+}
+
+// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+func (s *Service) DepositSnapshot(ctx context.Context) (*apiv1.DepositSnapshot, error) {
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DepositSnapshot(ctx)
+}
+
+// DepositContract provides details of the Ethereum 1 deposit contract for the chain.
+func (s *Service) DepositContract(ctx context.Context) (*apiv1.DepositContract, error) {
+	next, isNext := s.next.(consensusclient.DepositContractProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DepositContract(ctx)
+}
+
+// BeaconStateRoot fetches a beacon state root given a state ID.
+func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRoot(ctx, stateID)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRandao(ctx, stateID)
+}
+
+// BeaconStateRandaoWithOpts fetches a beacon state RANDAO matching the given options.
+func (s *Service) BeaconStateRandaoWithOpts(ctx context.Context, opts *api.BeaconStateRandaoOpts) (*phase0.Root, error) {
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoWithOptsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRandaoWithOpts(ctx, opts)
+}
+
+// BlindedBeaconBlockProposal fetches a blinded proposed beacon block for signing.
+func (s *Service) BlindedBeaconBlockProposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*api.VersionedBlindedBeaconBlock,
+	error,
+) {
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
+// NodeClient provides the client for the node.
+func (s *Service) NodeClient(ctx context.Context) (string, error) {
+	next, isNext := s.next.(consensusclient.NodeClientProvider)
+	if !isNext {
+		return "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeClient(ctx)
+}
+
+// SyncState provides the state of the node's synchronization with the chain.
+func (s *Service) SyncState(ctx context.Context) (*apiv1.SyncState, error) {
+	next, isNext := s.next.(consensusclient.SyncStateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncState(ctx)
+}
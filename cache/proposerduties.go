@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ProposerDuties obtains proposer duties for the given epoch.
+// If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
+func (s *Service) ProposerDuties(ctx context.Context,
+	epoch phase0.Epoch,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.ProposerDuty,
+	error,
+) {
+	dependentRoot, err := s.dependentRoot(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("proposer:%d:%#x", epoch, dependentRoot)
+
+	var duties []*apiv1.ProposerDuty
+	if value, exists := s.duties.get(key); exists {
+		duties = value.([]*apiv1.ProposerDuty)
+	} else {
+		next, isNext := s.next.(consensusclient.ProposerDutiesProvider)
+		if !isNext {
+			return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+		}
+		// Fetch all duties for the epoch, so that they can serve any future request.
+		duties, err = next.ProposerDuties(ctx, epoch, nil)
+		if err != nil {
+			return nil, err
+		}
+		s.duties.set(key, duties, 0, false)
+	}
+
+	if len(validatorIndices) == 0 {
+		return duties, nil
+	}
+	indices := make(map[phase0.ValidatorIndex]bool, len(validatorIndices))
+	for _, index := range validatorIndices {
+		indices[index] = true
+	}
+	res := make([]*apiv1.ProposerDuty, 0)
+	for _, duty := range duties {
+		if indices[duty.ValidatorIndex] {
+			res = append(res, duty)
+		}
+	}
+
+	return res, nil
+}
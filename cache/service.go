@@ -0,0 +1,236 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a consensus client service that caches responses
+// from an underlying service.
+//
+// Data that cannot change, such as finalized blocks and headers, or blocks and
+// states requested by root, is cached until evicted by newer entries.  Beacon
+// committees and duties are cached against the dependent root of their epoch,
+// so are automatically refreshed when a reorg alters the dependent root.  Data
+// that can change with the chain, such as validator information for the head
+// state, is cached for a limited time and dropped on head and reorg events.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a caching consensus client service.
+type Service struct {
+	log           zerolog.Logger
+	next          consensusclient.Service
+	ttl           time.Duration
+	slotsPerEpoch uint64
+
+	blocks     *lru
+	headers    *lru
+	states     *lru
+	committees *lru
+	duties     *lru
+	validators *lru
+
+	// eventsActive is true if the service is receiving events, and hence can
+	// safely cache data for non-finalized slots.
+	eventsActive bool
+
+	slotsMu       sync.RWMutex
+	finalizedSlot phase0.Slot
+	headSlot      phase0.Slot
+
+	dependentRootsMu sync.Mutex
+	dependentRoots   map[phase0.Slot]phase0.Root
+}
+
+// New creates a new caching consensus client service.
+func New(ctx context.Context, params ...Parameter) (consensusclient.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Wrap(err, "failed to register metrics")
+		}
+	}
+
+	slotsPerEpochProvider, isProvider := parameters.service.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide slots per epoch")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	s := &Service{
+		log:            log,
+		next:           parameters.service,
+		ttl:            parameters.ttl,
+		slotsPerEpoch:  slotsPerEpoch,
+		blocks:         newLRU("blocks", parameters.blockCacheSize),
+		headers:        newLRU("headers", parameters.headerCacheSize),
+		states:         newLRU("states", parameters.stateCacheSize),
+		committees:     newLRU("committees", parameters.committeeCacheSize),
+		duties:         newLRU("duties", parameters.dutyCacheSize),
+		validators:     newLRU("validators", parameters.validatorCacheSize),
+		dependentRoots: make(map[phase0.Slot]phase0.Root),
+	}
+
+	if finalityProvider, isProvider := s.next.(consensusclient.FinalityProvider); isProvider {
+		finality, err := finalityProvider.Finality(ctx, "head")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain finality")
+		}
+		if finality != nil && finality.Finalized != nil {
+			s.setFinalizedEpoch(finality.Finalized.Epoch)
+		}
+	}
+
+	if eventsProvider, isProvider := s.next.(consensusclient.EventsProvider); isProvider {
+		if err := eventsProvider.Events(ctx, []string{"head", "chain_reorg", "finalized_checkpoint"}, s.handleEvent); err != nil {
+			return nil, errors.Wrap(err, "failed to subscribe to events")
+		}
+		s.eventsActive = true
+	} else {
+		log.Warn().Msg("Service does not provide events; only finalized data will be cached")
+	}
+
+	return s, nil
+}
+
+// Name returns the name of the client implementation.
+func (s *Service) Name() string {
+	return fmt.Sprintf("cache(%s)", s.next.Name())
+}
+
+// Address returns the address of the client.
+func (s *Service) Address() string {
+	return s.next.Address()
+}
+
+// handleEvent handles events from the underlying service, keeping the cache
+// consistent with the chain.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	switch event.Topic {
+	case "head":
+		data, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+		if !isHeadEvent {
+			return
+		}
+		s.validators.purgeMutable()
+		s.setHeadSlot(data.Slot)
+		s.storeHeadDependentRoots(data)
+	case "chain_reorg":
+		s.log.Trace().Msg("Chain reorg; purging non-finalized data")
+		s.validators.purgeMutable()
+		s.purgeDependentRoots()
+	case "finalized_checkpoint":
+		data, isFinalizedCheckpointEvent := event.Data.(*apiv1.FinalizedCheckpointEvent)
+		if !isFinalizedCheckpointEvent {
+			return
+		}
+		s.setFinalizedEpoch(data.Epoch)
+	}
+}
+
+// setFinalizedEpoch sets the finalized epoch, if it is later than that currently known.
+func (s *Service) setFinalizedEpoch(epoch phase0.Epoch) {
+	slot := phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+
+	s.slotsMu.Lock()
+	defer s.slotsMu.Unlock()
+	if slot > s.finalizedSlot {
+		s.finalizedSlot = slot
+	}
+}
+
+// setHeadSlot sets the slot of the current head.
+func (s *Service) setHeadSlot(slot phase0.Slot) {
+	s.slotsMu.Lock()
+	s.headSlot = slot
+	s.slotsMu.Unlock()
+}
+
+// isPast returns true if the given slot is at or before the current head.
+func (s *Service) isPast(slot phase0.Slot) bool {
+	s.slotsMu.RLock()
+	defer s.slotsMu.RUnlock()
+
+	return slot <= s.headSlot || slot <= s.finalizedSlot
+}
+
+// isFinalized returns true if the given slot is finalized.
+func (s *Service) isFinalized(slot phase0.Slot) bool {
+	s.slotsMu.RLock()
+	defer s.slotsMu.RUnlock()
+
+	return slot <= s.finalizedSlot
+}
+
+// isRootID returns true if the block or state ID is a root.
+func isRootID(id string) bool {
+	return strings.HasPrefix(id, "0x")
+}
+
+// idSlot returns the slot of a block or state ID, if the ID refers to a
+// specific slot.
+func idSlot(id string) (phase0.Slot, bool) {
+	if id == "genesis" {
+		return 0, true
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return phase0.Slot(slot), true
+}
+
+// isImmutableID returns true if the data referenced by a block or state ID
+// cannot change.
+func (s *Service) isImmutableID(id string) bool {
+	if isRootID(id) {
+		return true
+	}
+	slot, isSlot := idSlot(id)
+
+	return isSlot && s.isFinalized(slot)
+}
+
+// epochStartSlot returns the first slot of the given epoch.
+func (s *Service) epochStartSlot(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
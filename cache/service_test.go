@@ -0,0 +1,150 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/cache"
+	"github.com/jefmcl/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService creates a cache in front of a mock service, returning the
+// mock service and the handler for events from the mock service.
+func newTestService(ctx context.Context, t *testing.T, params ...cache.Parameter) (*mock.Service, consensusclient.Service, consensusclient.EventHandlerFunc) {
+	t.Helper()
+
+	mockService, err := mock.New(ctx)
+	require.NoError(t, err)
+	var handler consensusclient.EventHandlerFunc
+	mockService.EventsFunc = func(_ context.Context, _ []string, eventHandler consensusclient.EventHandlerFunc) error {
+		handler = eventHandler
+		return nil
+	}
+
+	params = append([]cache.Parameter{
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithService(mockService),
+	}, params...)
+	service, err := cache.New(ctx, params...)
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+	mockService.ResetCalls()
+
+	return mockService, service, handler
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no service specified",
+		},
+		{
+			name: "BlockCacheSizeNegative",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(mockService),
+				cache.WithBlockCacheSize(-1),
+			},
+			err: "problem with parameters: block cache size cannot be negative",
+		},
+		{
+			name: "TTLNegative",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(mockService),
+				cache.WithTTL(-1),
+			},
+			err: "problem with parameters: TTL cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithService(mockService),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	_, service, _ := newTestService(ctx, t)
+
+	// Ensure the cache provides the same interfaces as the underlying mock.
+	require.Implements(t, (*consensusclient.SignedBeaconBlockProvider)(nil), service)
+	require.Implements(t, (*consensusclient.BeaconCommitteesProvider)(nil), service)
+	require.Implements(t, (*consensusclient.AttesterDutiesProvider)(nil), service)
+	require.Implements(t, (*consensusclient.ProposerDutiesProvider)(nil), service)
+	require.Implements(t, (*consensusclient.ValidatorsProvider)(nil), service)
+	require.Implements(t, (*consensusclient.EventsProvider)(nil), service)
+	require.Implements(t, (*consensusclient.NodeSyncingProvider)(nil), service)
+	require.Implements(t, (*consensusclient.AttestationsSubmitter)(nil), service)
+}
+
+func TestFinalizedCheckpointEvent(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, handler := newTestService(ctx, t)
+	mockService.SignedBeaconBlockFunc = blockForID
+
+	// Slot 300 is not finalized, so is not cached.
+	for i := 0; i < 2; i++ {
+		_, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "300")
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("SignedBeaconBlock"), 2)
+
+	// Finalize epoch 10, after which slot 300 is cached.
+	handler(&apiv1.Event{
+		Topic: "finalized_checkpoint",
+		Data:  &apiv1.FinalizedCheckpointEvent{Epoch: 10},
+	})
+	mockService.ResetCalls()
+	for i := 0; i < 2; i++ {
+		_, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "300")
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("SignedBeaconBlock"), 1)
+}
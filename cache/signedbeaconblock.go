@@ -0,0 +1,66 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/spec"
+)
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) SignedBeaconBlock(ctx context.Context,
+	blockID string,
+) (
+	*spec.VersionedSignedBeaconBlock,
+	error,
+) {
+	if value, exists := s.blocks.get(blockID); exists {
+		return value.(*spec.VersionedSignedBeaconBlock), nil
+	}
+
+	next, isNext := s.next.(consensusclient.SignedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	block, err := next.SignedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, nil
+	}
+
+	// Blocks are immutable, so can always be cached by root.  They can only
+	// be cached by slot once finalized.
+	slot, err := block.Slot()
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain block slot; not caching")
+		return block, nil
+	}
+	root, err := block.Root()
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain block root; not caching")
+		return block, nil
+	}
+	s.blocks.set(fmt.Sprintf("%#x", root), block, 0, false)
+	if s.isFinalized(slot) {
+		s.blocks.set(fmt.Sprintf("%d", slot), block, 0, false)
+	}
+
+	return block, nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/cache"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// blockForID returns a block whose slot is given by the block ID.
+func blockForID(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		// Not a slot; use a fixed slot.
+		slot = 1000
+	}
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: phase0.Slot(slot),
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestSignedBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, _ := newTestService(ctx, t)
+	mockService.SignedBeaconBlockFunc = blockForID
+
+	tests := []struct {
+		name    string
+		blockID string
+		calls   int
+	}{
+		{
+			name:    "Finalized",
+			blockID: "100",
+			calls:   1,
+		},
+		{
+			name:    "NotFinalized",
+			blockID: "500",
+			calls:   3,
+		},
+		{
+			name:    "Head",
+			blockID: "head",
+			calls:   3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockService.ResetCalls()
+			for i := 0; i < 3; i++ {
+				block, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, test.blockID)
+				require.NoError(t, err)
+				require.NotNil(t, block)
+			}
+			require.Len(t, mockService.Calls("SignedBeaconBlock"), test.calls)
+		})
+	}
+
+	// Blocks are cached by root, regardless of finality.
+	block, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	root, err := block.Root()
+	require.NoError(t, err)
+	mockService.ResetCalls()
+	res, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, root.String())
+	require.NoError(t, err)
+	require.Equal(t, block, res)
+	require.Empty(t, mockService.Calls("SignedBeaconBlock"))
+}
+
+func TestSignedBeaconBlockCacheSize(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, _ := newTestService(ctx, t, cache.WithBlockCacheSize(0))
+	mockService.SignedBeaconBlockFunc = blockForID
+
+	for i := 0; i < 3; i++ {
+		_, err := service.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "100")
+		require.NoError(t, err)
+	}
+	require.Len(t, mockService.Calls("SignedBeaconBlock"), 3)
+}
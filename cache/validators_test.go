@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/cache"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, handler := newTestService(ctx, t)
+
+	// Order of indices does not matter.
+	_, err := service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{2, 1})
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("Validators"), 1)
+
+	// Head events invalidate the head state.
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data:  &apiv1.HeadEvent{Slot: 320},
+	})
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("Validators"), 2)
+
+	// Finalized states are unaffected by head events.
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "64", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	handler(&apiv1.Event{
+		Topic: "head",
+		Data:  &apiv1.HeadEvent{Slot: 321},
+	})
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "64", []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("Validators"), 3)
+
+	// Public key lookups are cached separately.
+	_, err = service.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, "head", []phase0.BLSPubKey{{0x01}})
+	require.NoError(t, err)
+	_, err = service.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, "head", []phase0.BLSPubKey{{0x01}})
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("ValidatorsByPubKey"), 1)
+}
+
+func TestValidatorsTTL(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, service, _ := newTestService(ctx, t, cache.WithTTL(50*time.Millisecond))
+
+	_, err := service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", nil)
+	require.NoError(t, err)
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", nil)
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("Validators"), 1)
+
+	time.Sleep(100 * time.Millisecond)
+	_, err = service.(consensusclient.ValidatorsProvider).Validators(ctx, "head", nil)
+	require.NoError(t, err)
+	require.Len(t, mockService.Calls("Validators"), 2)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ValidatorsByPubKey provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+// supplied no filter will be applied.
+func (s *Service) ValidatorsByPubKey(ctx context.Context,
+	stateID string,
+	validatorPubKeys []phase0.BLSPubKey,
+) (
+	map[phase0.ValidatorIndex]*apiv1.Validator,
+	error,
+) {
+	pubKeys := make([]string, len(validatorPubKeys))
+	for i := range validatorPubKeys {
+		pubKeys[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
+	}
+	sort.Strings(pubKeys)
+	key := fmt.Sprintf("pubkeys:%s:%s", stateID, strings.Join(pubKeys, ","))
+	if value, exists := s.validators.get(key); exists {
+		return value.(map[phase0.ValidatorIndex]*apiv1.Validator), nil
+	}
+
+	next, isNext := s.next.(consensusclient.ValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	validators, err := next.ValidatorsByPubKey(ctx, stateID, validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	s.setValidators(stateID, key, validators)
+
+	return validators, nil
+}
@@ -0,0 +1,270 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit
+
+import (
+	"crypto/sha256"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// zeroHashes are the roots of empty subtrees at each level of the tree.
+var zeroHashes = func() []phase0.Root {
+	hashes := make([]phase0.Root, treeDepth+1)
+	for i := 1; i <= treeDepth; i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+// merkleTree is a node in a sparse Merkle tree, as defined in EIP-4881.
+type merkleTree interface {
+	// root returns the root of the tree.
+	root() phase0.Root
+	// isFull returns true if no more leaves can be added to the tree.
+	isFull() bool
+	// pushLeaf adds a leaf to the tree, returning the updated tree.
+	pushLeaf(leaf phase0.Root, level int) (merkleTree, error)
+	// finalize finalizes the given number of deposits in the tree, returning the updated tree.
+	finalize(depositsToFinalize uint64, level int) merkleTree
+	// finalized appends the roots of finalized subtrees to the supplied list,
+	// returning the updated list and the number of deposits they contain.
+	finalized(result []phase0.Root) ([]phase0.Root, uint64)
+}
+
+// finalizedNode is a subtree that has been finalized and is represented only by its root.
+type finalizedNode struct {
+	depositCount uint64
+	hash         phase0.Root
+}
+
+func (n *finalizedNode) root() phase0.Root {
+	return n.hash
+}
+
+func (n *finalizedNode) isFull() bool {
+	return true
+}
+
+func (n *finalizedNode) pushLeaf(_ phase0.Root, _ int) (merkleTree, error) {
+	return nil, errors.New("cannot add a leaf to a finalized subtree")
+}
+
+func (n *finalizedNode) finalize(_ uint64, _ int) merkleTree {
+	return n
+}
+
+func (n *finalizedNode) finalized(result []phase0.Root) ([]phase0.Root, uint64) {
+	return append(result, n.hash), n.depositCount
+}
+
+// leafNode is a single deposit.
+type leafNode struct {
+	hash phase0.Root
+}
+
+func (n *leafNode) root() phase0.Root {
+	return n.hash
+}
+
+func (n *leafNode) isFull() bool {
+	return true
+}
+
+func (n *leafNode) pushLeaf(_ phase0.Root, _ int) (merkleTree, error) {
+	return nil, errors.New("cannot add a leaf to a leaf")
+}
+
+func (n *leafNode) finalize(_ uint64, _ int) merkleTree {
+	return &finalizedNode{
+		depositCount: 1,
+		hash:         n.hash,
+	}
+}
+
+func (n *leafNode) finalized(result []phase0.Root) ([]phase0.Root, uint64) {
+	return result, 0
+}
+
+// innerNode is a node with two children.
+type innerNode struct {
+	left  merkleTree
+	right merkleTree
+}
+
+func (n *innerNode) root() phase0.Root {
+	return hashPair(n.left.root(), n.right.root())
+}
+
+func (n *innerNode) isFull() bool {
+	return n.right.isFull()
+}
+
+func (n *innerNode) pushLeaf(leaf phase0.Root, level int) (merkleTree, error) {
+	var err error
+	if n.left.isFull() {
+		n.right, err = n.right.pushLeaf(leaf, level-1)
+	} else {
+		n.left, err = n.left.pushLeaf(leaf, level-1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (n *innerNode) finalize(depositsToFinalize uint64, level int) merkleTree {
+	deposits := uint64(1) << uint(level)
+	if deposits <= depositsToFinalize {
+		return &finalizedNode{
+			depositCount: deposits,
+			hash:         n.root(),
+		}
+	}
+	n.left = n.left.finalize(depositsToFinalize, level-1)
+	if depositsToFinalize > deposits/2 {
+		n.right = n.right.finalize(depositsToFinalize-deposits/2, level-1)
+	}
+
+	return n
+}
+
+func (n *innerNode) finalized(result []phase0.Root) ([]phase0.Root, uint64) {
+	result, leftCount := n.left.finalized(result)
+	result, rightCount := n.right.finalized(result)
+
+	return result, leftCount + rightCount
+}
+
+// zeroNode is an empty subtree.
+type zeroNode struct {
+	depth int
+}
+
+func (n *zeroNode) root() phase0.Root {
+	return zeroHashes[n.depth]
+}
+
+func (n *zeroNode) isFull() bool {
+	return false
+}
+
+func (n *zeroNode) pushLeaf(leaf phase0.Root, level int) (merkleTree, error) {
+	return create([]phase0.Root{leaf}, level), nil
+}
+
+func (n *zeroNode) finalize(_ uint64, _ int) merkleTree {
+	return n
+}
+
+func (n *zeroNode) finalized(result []phase0.Root) ([]phase0.Root, uint64) {
+	return result, 0
+}
+
+// create creates a tree of the given depth containing the supplied leaves.
+func create(leaves []phase0.Root, depth int) merkleTree {
+	if len(leaves) == 0 {
+		return &zeroNode{depth: depth}
+	}
+	if depth == 0 {
+		return &leafNode{hash: leaves[0]}
+	}
+
+	split := uint64(1) << uint(depth-1)
+	if split > uint64(len(leaves)) {
+		split = uint64(len(leaves))
+	}
+
+	return &innerNode{
+		left:  create(leaves[:split], depth-1),
+		right: create(leaves[split:], depth-1),
+	}
+}
+
+// fromSnapshotParts creates a tree of the given depth from the finalized roots of a snapshot.
+func fromSnapshotParts(finalized []phase0.Root, depositCount uint64, level int) (merkleTree, error) {
+	if len(finalized) == 0 || depositCount == 0 {
+		return &zeroNode{depth: level}, nil
+	}
+	if depositCount == uint64(1)<<uint(level) {
+		return &finalizedNode{
+			depositCount: depositCount,
+			hash:         finalized[0],
+		}, nil
+	}
+	if level == 0 {
+		return nil, errors.New("deposit count too large for tree")
+	}
+
+	leftSubtree := uint64(1) << uint(level-1)
+	if depositCount <= leftSubtree {
+		left, err := fromSnapshotParts(finalized, depositCount, level-1)
+		if err != nil {
+			return nil, err
+		}
+		return &innerNode{
+			left:  left,
+			right: &zeroNode{depth: level - 1},
+		}, nil
+	}
+
+	right, err := fromSnapshotParts(finalized[1:], depositCount-leftSubtree, level-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &innerNode{
+		left: &finalizedNode{
+			depositCount: leftSubtree,
+			hash:         finalized[0],
+		},
+		right: right,
+	}, nil
+}
+
+// generateProof generates the root of the leaf at the given index and the
+// proof of its inclusion in the tree, ordered from the leaf upwards.
+func generateProof(tree merkleTree, index uint64, depth int) (phase0.Root, []phase0.Root, error) {
+	proof := make([]phase0.Root, depth)
+	node := tree
+	for level := depth; level > 0; level-- {
+		inner, isInner := node.(*innerNode)
+		if !isInner {
+			return phase0.Root{}, nil, errors.New("deposit is not available in the tree")
+		}
+		if (index>>uint(level-1))&1 == 1 {
+			proof[level-1] = inner.left.root()
+			node = inner.right
+		} else {
+			proof[level-1] = inner.right.root()
+			node = inner.left
+		}
+	}
+	if _, isLeaf := node.(*leafNode); !isLeaf {
+		return phase0.Root{}, nil, errors.New("deposit is not available in the tree")
+	}
+
+	return node.root(), proof, nil
+}
+
+// hashPair hashes two roots together.
+func hashPair(left phase0.Root, right phase0.Root) phase0.Root {
+	data := make([]byte, 64)
+	copy(data[:32], left[:])
+	copy(data[32:], right[:])
+
+	return sha256.Sum256(data)
+}
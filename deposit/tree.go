@@ -0,0 +1,251 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deposit provides a deposit tree, as defined in EIP-4881.
+//
+// The deposit tree tracks the deposits made to the deposit contract, and can
+// generate the proofs required to include deposits in blocks.  Deposits that
+// have been finalized are pruned from the tree, so the tree can be initialised
+// from a snapshot provided by a beacon node rather than replaying every deposit.
+package deposit
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// treeDepth is the depth of the deposit contract's Merkle tree.
+const treeDepth = 32
+
+// Tree is a deposit tree.
+// It is not safe for concurrent use.
+type Tree struct {
+	tree                    merkleTree
+	depositCount            uint64
+	finalizedDepositCount   uint64
+	finalizedExecutionBlock *executionBlock
+}
+
+// executionBlock identifies the execution block at which the tree was last finalized.
+type executionBlock struct {
+	hash   phase0.Hash32
+	height uint64
+}
+
+// NewTree creates a new empty deposit tree.
+func NewTree() *Tree {
+	return &Tree{
+		tree: &zeroNode{depth: treeDepth},
+	}
+}
+
+// NewTreeFromSnapshot creates a deposit tree from a snapshot.
+// The root of the resultant tree is checked against the root in the snapshot.
+func NewTreeFromSnapshot(snapshot *apiv1.DepositSnapshot) (*Tree, error) {
+	if snapshot == nil {
+		return nil, errors.New("no snapshot supplied")
+	}
+
+	tree, err := fromSnapshotParts(snapshot.Finalized, snapshot.DepositCount, treeDepth)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tree from snapshot")
+	}
+	res := &Tree{
+		tree:                  tree,
+		depositCount:          snapshot.DepositCount,
+		finalizedDepositCount: snapshot.DepositCount,
+		finalizedExecutionBlock: &executionBlock{
+			hash:   snapshot.ExecutionBlockHash,
+			height: snapshot.ExecutionBlockHeight,
+		},
+	}
+
+	if root := res.Root(); root != snapshot.DepositRoot {
+		return nil, fmt.Errorf("snapshot deposit root %#x does not match calculated root %#x", snapshot.DepositRoot, root)
+	}
+
+	return res, nil
+}
+
+// DepositCount returns the number of deposits in the tree.
+func (t *Tree) DepositCount() uint64 {
+	return t.depositCount
+}
+
+// Root returns the root of the tree, including the deposit count mix-in.
+// This is the value that is present in the deposit root of ETH1 data.
+func (t *Tree) Root() phase0.Root {
+	return hashPair(t.tree.root(), lengthRoot(t.depositCount))
+}
+
+// PushLeaf adds the root of a deposit to the tree.
+func (t *Tree) PushLeaf(leaf phase0.Root) error {
+	if t.depositCount >= uint64(1)<<treeDepth {
+		return errors.New("deposit tree is full")
+	}
+
+	tree, err := t.tree.pushLeaf(leaf, treeDepth)
+	if err != nil {
+		return err
+	}
+	t.tree = tree
+	t.depositCount++
+
+	return nil
+}
+
+// PushDeposit adds a deposit to the tree.
+func (t *Tree) PushDeposit(data *phase0.DepositData) error {
+	if data == nil {
+		return errors.New("no deposit data supplied")
+	}
+
+	leaf, err := data.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate deposit data root")
+	}
+
+	return t.PushLeaf(leaf)
+}
+
+// Finalize finalizes the deposits in the tree up to the deposit count of the
+// supplied ETH1 data, which should be from a finalized beacon state, pruning
+// the finalized portion of the tree.
+func (t *Tree) Finalize(eth1Data *phase0.ETH1Data, executionBlockHeight uint64) error {
+	if eth1Data == nil {
+		return errors.New("no ETH1 data supplied")
+	}
+	if len(eth1Data.BlockHash) != phase0.Hash32Length {
+		return errors.New("incorrect length for block hash")
+	}
+	if eth1Data.DepositCount > t.depositCount {
+		return fmt.Errorf("cannot finalize %d deposits when tree contains %d", eth1Data.DepositCount, t.depositCount)
+	}
+	if eth1Data.DepositCount < t.finalizedDepositCount {
+		return fmt.Errorf("cannot finalize %d deposits when %d are already finalized", eth1Data.DepositCount, t.finalizedDepositCount)
+	}
+
+	t.finalizedExecutionBlock = &executionBlock{
+		height: executionBlockHeight,
+	}
+	copy(t.finalizedExecutionBlock.hash[:], eth1Data.BlockHash)
+	if eth1Data.DepositCount > 0 {
+		t.tree = t.tree.finalize(eth1Data.DepositCount, treeDepth)
+	}
+	t.finalizedDepositCount = eth1Data.DepositCount
+
+	return nil
+}
+
+// Snapshot returns a snapshot of the finalized portion of the tree.
+func (t *Tree) Snapshot() (*apiv1.DepositSnapshot, error) {
+	if t.finalizedExecutionBlock == nil {
+		return nil, errors.New("tree has not been finalized")
+	}
+
+	finalized, depositCount := t.tree.finalized(make([]phase0.Root, 0))
+
+	return &apiv1.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          t.finalizedRoot(finalized, depositCount),
+		DepositCount:         depositCount,
+		ExecutionBlockHash:   t.finalizedExecutionBlock.hash,
+		ExecutionBlockHeight: t.finalizedExecutionBlock.height,
+	}, nil
+}
+
+// finalizedRoot calculates the root of the tree containing only finalized deposits.
+func (*Tree) finalizedRoot(finalized []phase0.Root, depositCount uint64) phase0.Root {
+	// Snapshot parts always form a valid tree, so the error can be ignored.
+	tree, _ := fromSnapshotParts(finalized, depositCount, treeDepth)
+
+	return hashPair(tree.root(), lengthRoot(depositCount))
+}
+
+// Proof returns the root of the deposit at the given index and the proof of its
+// inclusion in the tree, in the form required by phase0.Deposit.
+// Proofs are not available for finalized deposits.
+func (t *Tree) Proof(index uint64) (phase0.Root, [][]byte, error) {
+	if index >= t.depositCount {
+		return phase0.Root{}, nil, fmt.Errorf("deposit %d not in tree of %d deposits", index, t.depositCount)
+	}
+	if index < t.finalizedDepositCount {
+		return phase0.Root{}, nil, fmt.Errorf("deposit %d has been finalized", index)
+	}
+
+	leaf, branch, err := generateProof(t.tree, index, treeDepth)
+	if err != nil {
+		return phase0.Root{}, nil, err
+	}
+
+	proof := make([][]byte, 0, treeDepth+1)
+	for i := range branch {
+		proof = append(proof, branch[i][:])
+	}
+	length := lengthRoot(t.depositCount)
+	proof = append(proof, length[:])
+
+	return leaf, proof, nil
+}
+
+// VerifyETH1Data checks that the tree matches the supplied ETH1 data, which
+// should be for the same number of deposits as the tree.
+func (t *Tree) VerifyETH1Data(eth1Data *phase0.ETH1Data) error {
+	if eth1Data == nil {
+		return errors.New("no ETH1 data supplied")
+	}
+	if eth1Data.DepositCount != t.depositCount {
+		return fmt.Errorf("ETH1 data has %d deposits but tree has %d", eth1Data.DepositCount, t.depositCount)
+	}
+	if root := t.Root(); root != eth1Data.DepositRoot {
+		return fmt.Errorf("ETH1 data deposit root %#x does not match tree root %#x", eth1Data.DepositRoot, root)
+	}
+
+	return nil
+}
+
+// VerifyProof verifies a proof of inclusion of a deposit, as generated by Proof,
+// against a deposit root.
+func VerifyProof(leaf phase0.Root, proof [][]byte, index uint64, root phase0.Root) bool {
+	if len(proof) != treeDepth+1 {
+		return false
+	}
+
+	value := leaf
+	for i := range proof {
+		if len(proof[i]) != phase0.RootLength {
+			return false
+		}
+		var sibling phase0.Root
+		copy(sibling[:], proof[i])
+		if (index>>uint(i))&1 == 1 {
+			value = hashPair(sibling, value)
+		} else {
+			value = hashPair(value, sibling)
+		}
+	}
+
+	return value == root
+}
+
+// lengthRoot returns the mix-in for the given deposit count.
+func lengthRoot(depositCount uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:8], depositCount)
+
+	return root
+}
@@ -0,0 +1,199 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/deposit"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testLeaf generates a deterministic leaf for testing.
+func testLeaf(i int) phase0.Root {
+	return sha256.Sum256([]byte(fmt.Sprintf("deposit %d", i)))
+}
+
+// referenceRoot calculates the root of a deposit tree by hashing the full tree.
+func referenceRoot(leaves []phase0.Root) phase0.Root {
+	hash := func(a phase0.Root, b phase0.Root) phase0.Root {
+		return sha256.Sum256(append(append([]byte{}, a[:]...), b[:]...))
+	}
+
+	level := append([]phase0.Root{}, leaves...)
+	zero := phase0.Root{}
+	for depth := 0; depth < 32; depth++ {
+		if len(level)%2 == 1 {
+			level = append(level, zero)
+		}
+		next := make([]phase0.Root, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, hash(level[i], level[i+1]))
+		}
+		if len(next) == 0 {
+			next = append(next, hash(zero, zero))
+		}
+		level = next
+		zero = hash(zero, zero)
+	}
+
+	var length phase0.Root
+	binary.LittleEndian.PutUint64(length[:8], uint64(len(leaves)))
+
+	return hash(level[0], length)
+}
+
+func TestEmptyRoot(t *testing.T) {
+	tree := deposit.NewTree()
+	require.Equal(t, uint64(0), tree.DepositCount())
+	// Well-known root of the empty deposit contract.
+	require.Equal(t, "0xd70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e", fmt.Sprintf("%#x", tree.Root()))
+}
+
+func TestRootAndProofs(t *testing.T) {
+	tree := deposit.NewTree()
+	leaves := make([]phase0.Root, 0)
+	for i := 0; i < 33; i++ {
+		leaves = append(leaves, testLeaf(i))
+		require.NoError(t, tree.PushLeaf(leaves[i]))
+		require.Equal(t, uint64(i+1), tree.DepositCount())
+		root := tree.Root()
+		require.Equal(t, referenceRoot(leaves), root)
+
+		for j := range leaves {
+			leaf, proof, err := tree.Proof(uint64(j))
+			require.NoError(t, err)
+			require.Equal(t, leaves[j], leaf)
+			require.Len(t, proof, 33)
+			require.True(t, deposit.VerifyProof(leaf, proof, uint64(j), root))
+			require.False(t, deposit.VerifyProof(leaf, proof, uint64(j+1), root))
+		}
+	}
+
+	_, _, err := tree.Proof(33)
+	require.EqualError(t, err, "deposit 33 not in tree of 33 deposits")
+}
+
+func TestPushDeposit(t *testing.T) {
+	data := &phase0.DepositData{
+		PublicKey:             phase0.BLSPubKey{0x01},
+		WithdrawalCredentials: make([]byte, 32),
+		Amount:                32000000000,
+		Signature:             phase0.BLSSignature{0x02},
+	}
+	leaf, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree := deposit.NewTree()
+	require.EqualError(t, tree.PushDeposit(nil), "no deposit data supplied")
+	require.NoError(t, tree.PushDeposit(data))
+	require.Equal(t, referenceRoot([]phase0.Root{leaf}), tree.Root())
+}
+
+func TestFinalizeAndSnapshot(t *testing.T) {
+	for _, finalizedCount := range []int{0, 1, 2, 3, 5, 8, 13, 16} {
+		t.Run(fmt.Sprintf("Finalized%d", finalizedCount), func(t *testing.T) {
+			tree := deposit.NewTree()
+			_, err := tree.Snapshot()
+			require.EqualError(t, err, "tree has not been finalized")
+
+			leaves := make([]phase0.Root, 0)
+			for i := 0; i < 20; i++ {
+				leaves = append(leaves, testLeaf(i))
+				require.NoError(t, tree.PushLeaf(leaves[i]))
+			}
+			root := tree.Root()
+
+			blockHash := make([]byte, 32)
+			blockHash[0] = 0x01
+			require.NoError(t, tree.Finalize(&phase0.ETH1Data{
+				DepositRoot:  referenceRoot(leaves[:finalizedCount]),
+				DepositCount: uint64(finalizedCount),
+				BlockHash:    blockHash,
+			}, 100))
+			// Finalization does not alter the root.
+			require.Equal(t, root, tree.Root())
+
+			for i := range leaves {
+				_, proof, err := tree.Proof(uint64(i))
+				if i < finalizedCount {
+					require.EqualError(t, err, fmt.Sprintf("deposit %d has been finalized", i))
+					continue
+				}
+				require.NoError(t, err)
+				require.True(t, deposit.VerifyProof(leaves[i], proof, uint64(i), root))
+			}
+
+			snapshot, err := tree.Snapshot()
+			require.NoError(t, err)
+			require.Equal(t, uint64(finalizedCount), snapshot.DepositCount)
+			require.Equal(t, referenceRoot(leaves[:finalizedCount]), snapshot.DepositRoot)
+			require.Equal(t, phase0.Hash32{0x01}, snapshot.ExecutionBlockHash)
+			require.Equal(t, uint64(100), snapshot.ExecutionBlockHeight)
+
+			// Rebuild the tree from the snapshot and add the remaining deposits.
+			restored, err := deposit.NewTreeFromSnapshot(snapshot)
+			require.NoError(t, err)
+			for i := finalizedCount; i < len(leaves); i++ {
+				require.NoError(t, restored.PushLeaf(leaves[i]))
+			}
+			require.Equal(t, root, restored.Root())
+			require.NoError(t, restored.VerifyETH1Data(&phase0.ETH1Data{
+				DepositRoot:  root,
+				DepositCount: uint64(len(leaves)),
+				BlockHash:    blockHash,
+			}))
+		})
+	}
+}
+
+func TestFinalizeErrors(t *testing.T) {
+	tree := deposit.NewTree()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tree.PushLeaf(testLeaf(i)))
+	}
+
+	require.EqualError(t, tree.Finalize(nil, 0), "no ETH1 data supplied")
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{BlockHash: []byte{0x01}}, 0), "incorrect length for block hash")
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 5, BlockHash: make([]byte, 32)}, 0), "cannot finalize 5 deposits when tree contains 4")
+	require.NoError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 3, BlockHash: make([]byte, 32)}, 0))
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 2, BlockHash: make([]byte, 32)}, 0), "cannot finalize 2 deposits when 3 are already finalized")
+}
+
+func TestNewTreeFromSnapshotErrors(t *testing.T) {
+	_, err := deposit.NewTreeFromSnapshot(nil)
+	require.EqualError(t, err, "no snapshot supplied")
+
+	_, err = deposit.NewTreeFromSnapshot(&apiv1.DepositSnapshot{
+		Finalized:    []phase0.Root{testLeaf(0)},
+		DepositRoot:  phase0.Root{0x01},
+		DepositCount: 1,
+	})
+	require.EqualError(t, err, fmt.Sprintf("snapshot deposit root 0x0100000000000000000000000000000000000000000000000000000000000000 does not match calculated root %#x", referenceRoot([]phase0.Root{testLeaf(0)})))
+}
+
+func TestVerifyETH1Data(t *testing.T) {
+	tree := deposit.NewTree()
+	require.NoError(t, tree.PushLeaf(testLeaf(0)))
+
+	require.EqualError(t, tree.VerifyETH1Data(nil), "no ETH1 data supplied")
+	require.EqualError(t, tree.VerifyETH1Data(&phase0.ETH1Data{DepositCount: 2}), "ETH1 data has 2 deposits but tree has 1")
+	require.Error(t, tree.VerifyETH1Data(&phase0.ETH1Data{DepositCount: 1}))
+	require.NoError(t, tree.VerifyETH1Data(&phase0.ETH1Data{DepositCount: 1, DepositRoot: tree.Root()}))
+}
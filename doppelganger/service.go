@@ -0,0 +1,210 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doppelganger provides protection against running validators that
+// are already active elsewhere.
+//
+// Before a validator client starts performing duties for a set of validators
+// it can use this package to confirm that none of them have been live in
+// the previous epoch, and that none of them become live whilst it waits for
+// the configured number of epochs.  Note that when failing over between
+// validator clients the previous instance must have stopped before the start
+// of the previous epoch, otherwise its activity will be reported as a
+// doppelganger.
+package doppelganger
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service checks for validators that are live elsewhere.
+type Service struct {
+	log              zerolog.Logger
+	livenessProvider consensusclient.ValidatorLivenessProvider
+	genesisTime      time.Time
+	slotDuration     time.Duration
+	slotsPerEpoch    uint64
+	epochs           uint64
+}
+
+// New creates a new doppelganger service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "doppelganger").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	livenessProvider, isProvider := parameters.service.(consensusclient.ValidatorLivenessProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide validator liveness")
+	}
+
+	genesisTimeProvider, isProvider := parameters.service.(consensusclient.GenesisTimeProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide genesis time")
+	}
+	genesisTime, err := genesisTimeProvider.GenesisTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis time")
+	}
+
+	slotDurationProvider, isProvider := parameters.service.(consensusclient.SlotDurationProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide slot duration")
+	}
+	slotDuration, err := slotDurationProvider.SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("slot duration cannot be 0")
+	}
+
+	slotsPerEpochProvider, isProvider := parameters.service.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("service does not provide slots per epoch")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	return &Service{
+		log:              log,
+		livenessProvider: livenessProvider,
+		genesisTime:      genesisTime,
+		slotDuration:     slotDuration,
+		slotsPerEpoch:    slotsPerEpoch,
+		epochs:           parameters.epochs,
+	}, nil
+}
+
+// Check returns the validators that were live in the epoch prior to the
+// given epoch.
+// Beacon nodes only provide liveness for the current and previous epochs, so
+// earlier epochs cannot be checked; Watch makes up for this by watching the
+// configured number of epochs from the current epoch.
+func (s *Service) Check(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	if len(validatorIndices) == 0 || epoch == 0 {
+		return []phase0.ValidatorIndex{}, nil
+	}
+
+	live := make(map[phase0.ValidatorIndex]bool)
+	if err := s.checkEpoch(ctx, epoch-1, validatorIndices, live); err != nil {
+		return nil, err
+	}
+
+	return sortedIndices(live), nil
+}
+
+// Watch watches the validators for the configured number of epochs from the
+// current epoch, as well as checking the epoch prior to the current epoch.
+// It returns as soon as any of the validators are found to be live, with the
+// live validators.  If no validators are found to be live it returns an empty
+// list once the watch is complete, at which point it is safe for the
+// validators to start their duties.
+func (s *Service) Watch(ctx context.Context, validatorIndices []phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	startEpoch := s.currentEpoch()
+	log := s.log.With().Uint64("start_epoch", uint64(startEpoch)).Int("validators", len(validatorIndices)).Logger()
+
+	live, err := s.Check(ctx, startEpoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+	if len(live) > 0 {
+		return live, nil
+	}
+	log.Trace().Msg("No validators live in prior epoch")
+
+	for epoch := startEpoch; epoch < startEpoch+phase0.Epoch(s.epochs); epoch++ {
+		// Wait until the end of the first slot of the following epoch, by which
+		// time attestations for the last slot of this epoch will have been seen.
+		checkTime := s.epochStart(epoch + 1).Add(s.slotDuration)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Until(checkTime)):
+		}
+
+		liveMap := make(map[phase0.ValidatorIndex]bool)
+		if err := s.checkEpoch(ctx, epoch, validatorIndices, liveMap); err != nil {
+			return nil, err
+		}
+		if len(liveMap) > 0 {
+			return sortedIndices(liveMap), nil
+		}
+		log.Trace().Uint64("epoch", uint64(epoch)).Msg("No validators live in epoch")
+	}
+
+	return []phase0.ValidatorIndex{}, nil
+}
+
+// checkEpoch adds the validators that were live in the given epoch to the live map.
+func (s *Service) checkEpoch(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex, live map[phase0.ValidatorIndex]bool) error {
+	liveness, err := s.livenessProvider.ValidatorLiveness(ctx, epoch, validatorIndices)
+	if err != nil {
+		return errors.Wrapf(err, "failed to obtain validator liveness for epoch %d", epoch)
+	}
+	for _, validatorLiveness := range liveness {
+		if validatorLiveness.IsLive {
+			s.log.Warn().Uint64("epoch", uint64(epoch)).Uint64("validator_index", uint64(validatorLiveness.Index)).Msg("Validator is live")
+			live[validatorLiveness.Index] = true
+		}
+	}
+
+	return nil
+}
+
+// currentEpoch returns the current epoch.
+func (s *Service) currentEpoch() phase0.Epoch {
+	if time.Now().Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Epoch(uint64(time.Since(s.genesisTime)/s.slotDuration) / s.slotsPerEpoch)
+}
+
+// epochStart returns the start time of the given epoch.
+func (s *Service) epochStart(epoch phase0.Epoch) time.Time {
+	return s.genesisTime.Add(time.Duration(uint64(epoch)*s.slotsPerEpoch) * s.slotDuration)
+}
+
+// sortedIndices returns the keys of an index map in order.
+func sortedIndices(indices map[phase0.ValidatorIndex]bool) []phase0.ValidatorIndex {
+	res := make([]phase0.ValidatorIndex, 0, len(indices))
+	for index := range indices {
+		res = append(res, index)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+
+	return res
+}
@@ -0,0 +1,317 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doppelganger_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/doppelganger"
+	"github.com/jefmcl/go-eth2-client/mock"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// livenessFunc returns a liveness function that reports the given validators
+// as live in the given epochs.
+func livenessFunc(live map[phase0.Epoch][]phase0.ValidatorIndex) func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+	return func(_ context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+		res := make([]*api.ValidatorLiveness, len(validatorIndices))
+		for i := range validatorIndices {
+			res[i] = &api.ValidatorLiveness{
+				Index: validatorIndices[i],
+			}
+			for _, liveIndex := range live[epoch] {
+				if liveIndex == validatorIndices[i] {
+					res[i].IsLive = true
+				}
+			}
+		}
+		return res, nil
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []doppelganger.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []doppelganger.Parameter{
+				doppelganger.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no service specified",
+		},
+		{
+			name: "EpochsZero",
+			params: []doppelganger.Parameter{
+				doppelganger.WithLogLevel(zerolog.Disabled),
+				doppelganger.WithService(service),
+				doppelganger.WithEpochs(0),
+			},
+			err: "problem with parameters: epochs must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []doppelganger.Parameter{
+				doppelganger.WithLogLevel(zerolog.Disabled),
+				doppelganger.WithService(service),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := doppelganger.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name             string
+		live             map[phase0.Epoch][]phase0.ValidatorIndex
+		livenessErr      error
+		epoch            phase0.Epoch
+		validatorIndices []phase0.ValidatorIndex
+		expected         []phase0.ValidatorIndex
+		checkedEpochs    []phase0.Epoch
+		err              string
+	}{
+		{
+			name:     "NoValidators",
+			epoch:    10,
+			expected: []phase0.ValidatorIndex{},
+		},
+		{
+			name:             "Genesis",
+			epoch:            0,
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+			expected:         []phase0.ValidatorIndex{},
+		},
+		{
+			name:             "EarlyEpoch",
+			epoch:            1,
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+			expected:         []phase0.ValidatorIndex{},
+			checkedEpochs:    []phase0.Epoch{0},
+		},
+		{
+			name:             "NoneLive",
+			epoch:            10,
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+			expected:         []phase0.ValidatorIndex{},
+			checkedEpochs:    []phase0.Epoch{9},
+		},
+		{
+			name: "SomeLive",
+			live: map[phase0.Epoch][]phase0.ValidatorIndex{
+				8:  {3},
+				9:  {1, 3},
+				10: {2},
+			},
+			epoch:            10,
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+			expected:         []phase0.ValidatorIndex{1, 3},
+			checkedEpochs:    []phase0.Epoch{9},
+		},
+		{
+			name:             "LivenessError",
+			livenessErr:      errors.New("mock error"),
+			epoch:            10,
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+			err:              "failed to obtain validator liveness for epoch 9: mock error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := mock.New(ctx)
+			require.NoError(t, err)
+			service.ValidatorLivenessFunc = livenessFunc(test.live)
+			if test.livenessErr != nil {
+				service.SetError("ValidatorLiveness", test.livenessErr)
+			}
+
+			s, err := doppelganger.New(ctx,
+				doppelganger.WithLogLevel(zerolog.Disabled),
+				doppelganger.WithService(service),
+			)
+			require.NoError(t, err)
+
+			live, err := s.Check(ctx, test.epoch, test.validatorIndices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, live)
+
+			checkedEpochs := make([]phase0.Epoch, 0)
+			for _, call := range service.Calls("ValidatorLiveness") {
+				checkedEpochs = append(checkedEpochs, call.Args[0].(phase0.Epoch))
+			}
+			if test.checkedEpochs == nil {
+				require.Empty(t, checkedEpochs)
+			} else {
+				require.Equal(t, test.checkedEpochs, checkedEpochs)
+			}
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+
+	slotDuration := 20 * time.Millisecond
+	slotsPerEpoch := uint64(2)
+
+	tests := []struct {
+		name     string
+		live     map[phase0.Epoch][]phase0.ValidatorIndex
+		expected []phase0.ValidatorIndex
+	}{
+		{
+			name:     "NoneLive",
+			expected: []phase0.ValidatorIndex{},
+		},
+		{
+			name: "LiveBefore",
+			live: map[phase0.Epoch][]phase0.ValidatorIndex{
+				9: {2},
+			},
+			expected: []phase0.ValidatorIndex{2},
+		},
+		{
+			name: "LiveDuring",
+			live: map[phase0.Epoch][]phase0.ValidatorIndex{
+				11: {3},
+			},
+			expected: []phase0.ValidatorIndex{3},
+		},
+		{
+			name: "LiveAfter",
+			live: map[phase0.Epoch][]phase0.ValidatorIndex{
+				12: {1},
+			},
+			expected: []phase0.ValidatorIndex{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := mock.New(ctx)
+			require.NoError(t, err)
+			service.ValidatorLivenessFunc = livenessFunc(test.live)
+			// Genesis is set such that we are half way through the first slot of epoch 10.
+			genesisTime := time.Now().Add(-10*time.Duration(slotsPerEpoch)*slotDuration - slotDuration/2)
+			service.GenesisTimeFunc = func(context.Context) (time.Time, error) { return genesisTime, nil }
+			service.SlotDurationFunc = func(context.Context) (time.Duration, error) { return slotDuration, nil }
+			service.SlotsPerEpochFunc = func(context.Context) (uint64, error) { return slotsPerEpoch, nil }
+
+			s, err := doppelganger.New(ctx,
+				doppelganger.WithLogLevel(zerolog.Disabled),
+				doppelganger.WithService(service),
+			)
+			require.NoError(t, err)
+
+			live, err := s.Watch(ctx, []phase0.ValidatorIndex{1, 2, 3})
+			require.NoError(t, err)
+			require.Equal(t, test.expected, live)
+		})
+	}
+}
+
+// TestWatchLivenessWindow ensures that Watch only requests liveness for the
+// epochs that beacon nodes serve.
+func TestWatchLivenessWindow(t *testing.T) {
+	ctx := context.Background()
+
+	slotDuration := 20 * time.Millisecond
+	slotsPerEpoch := uint64(2)
+	// Genesis is set such that we are half way through the first slot of epoch 10.
+	genesisTime := time.Now().Add(-10*time.Duration(slotsPerEpoch)*slotDuration - slotDuration/2)
+
+	for _, epochs := range []uint64{1, 2, 3} {
+		t.Run(fmt.Sprintf("Epochs%d", epochs), func(t *testing.T) {
+			service, err := mock.New(ctx)
+			require.NoError(t, err)
+			service.GenesisTimeFunc = func(context.Context) (time.Time, error) { return genesisTime, nil }
+			service.SlotDurationFunc = func(context.Context) (time.Duration, error) { return slotDuration, nil }
+			service.SlotsPerEpochFunc = func(context.Context) (uint64, error) { return slotsPerEpoch, nil }
+			// Liveness is only available for the current and previous epochs.
+			liveness := livenessFunc(nil)
+			service.ValidatorLivenessFunc = func(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+				currentEpoch := phase0.Epoch(uint64(time.Since(genesisTime)/slotDuration) / slotsPerEpoch)
+				if epoch+1 < currentEpoch || epoch > currentEpoch {
+					return nil, fmt.Errorf("epoch %d outside of liveness window", epoch)
+				}
+				return liveness(ctx, epoch, validatorIndices)
+			}
+
+			s, err := doppelganger.New(ctx,
+				doppelganger.WithLogLevel(zerolog.Disabled),
+				doppelganger.WithService(service),
+				doppelganger.WithEpochs(epochs),
+			)
+			require.NoError(t, err)
+
+			live, err := s.Watch(ctx, []phase0.ValidatorIndex{1, 2, 3})
+			require.NoError(t, err)
+			require.Empty(t, live)
+			require.Len(t, service.Calls("ValidatorLiveness"), int(epochs)+1)
+		})
+	}
+}
+
+func TestWatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+	service.SlotDurationFunc = func(context.Context) (time.Duration, error) { return time.Hour, nil }
+
+	s, err := doppelganger.New(ctx,
+		doppelganger.WithLogLevel(zerolog.Disabled),
+		doppelganger.WithService(consensusclient.Service(service)),
+	)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	_, err = s.Watch(ctx, []phase0.ValidatorIndex{1})
+	require.Equal(t, context.Canceled, err)
+}
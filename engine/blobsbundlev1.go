@@ -0,0 +1,99 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlobsBundleV1 is the blobs for a payload being built by an execution client.
+type BlobsBundleV1 struct {
+	// BlockHash is the hash of the payload containing the blobs.
+	BlockHash phase0.Hash32
+	KZGs      []deneb.KzgCommitment
+	Blobs     []deneb.Blob
+}
+
+// blobsBundleV1JSON is the engine API representation of the struct.
+type blobsBundleV1JSON struct {
+	BlockHash string   `json:"blockHash"`
+	KZGs      []string `json:"kzgs"`
+	Blobs     []string `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobsBundleV1) MarshalJSON() ([]byte, error) {
+	kzgs := make([]string, len(b.KZGs))
+	for i := range b.KZGs {
+		kzgs[i] = encodeData(b.KZGs[i][:])
+	}
+	blobs := make([]string, len(b.Blobs))
+	for i := range b.Blobs {
+		blobs[i] = encodeData(b.Blobs[i][:])
+	}
+
+	return json.Marshal(&blobsBundleV1JSON{
+		BlockHash: encodeData(b.BlockHash[:]),
+		KZGs:      kzgs,
+		Blobs:     blobs,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobsBundleV1) UnmarshalJSON(input []byte) error {
+	var data blobsBundleV1JSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if err := decodeFixedData("block hash", data.BlockHash, b.BlockHash[:]); err != nil {
+		return err
+	}
+
+	if data.KZGs == nil {
+		return errors.New("kzgs missing")
+	}
+	b.KZGs = make([]deneb.KzgCommitment, len(data.KZGs))
+	for i := range data.KZGs {
+		if err := decodeFixedData("kzg", data.KZGs[i], b.KZGs[i][:]); err != nil {
+			return err
+		}
+	}
+
+	if data.Blobs == nil {
+		return errors.New("blobs missing")
+	}
+	b.Blobs = make([]deneb.Blob, len(data.Blobs))
+	for i := range data.Blobs {
+		if err := decodeFixedData("blob", data.Blobs[i], b.Blobs[i][:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlobsBundleV1) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
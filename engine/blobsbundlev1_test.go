@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestBlobsBundleV1JSON(t *testing.T) {
+	blockHash := "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	kzg := fmt.Sprintf("0x%s", strings.Repeat("a1", 48))
+	blob := fmt.Sprintf("0x%s", strings.Repeat("c3", 131072))
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.blobsBundleV1JSON",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"kzgs":[],"blobs":[]}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BlockHashShort",
+			input: []byte(`{"blockHash":"0x0102","kzgs":[],"blobs":[]}`),
+			err:   "incorrect length for block hash",
+		},
+		{
+			name:  "KZGsMissing",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","blobs":[]}`, blockHash)),
+			err:   "kzgs missing",
+		},
+		{
+			name:  "KZGInvalid",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","kzgs":["0xzz"],"blobs":[]}`, blockHash)),
+			err:   "invalid value for kzg: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "BlobsMissing",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","kzgs":[]}`, blockHash)),
+			err:   "blobs missing",
+		},
+		{
+			name:  "BlobShort",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","kzgs":[],"blobs":["0x0102"]}`, blockHash)),
+			err:   "incorrect length for blob",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","kzgs":["%s"],"blobs":["%s"]}`, blockHash, kzg, blob)),
+		},
+		{
+			name:  "GoodNoBlobs",
+			input: []byte(fmt.Sprintf(`{"blockHash":"%s","kzgs":[],"blobs":[]}`, blockHash)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.BlobsBundleV1
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ExecutionPayloadV1 is a Bellatrix execution payload in its engine API representation.
+type ExecutionPayloadV1 bellatrix.ExecutionPayload
+
+// ExecutionPayloadV2 is a Capella execution payload in its engine API representation.
+type ExecutionPayloadV2 capella.ExecutionPayload
+
+// ExecutionPayloadV3 is a Deneb execution payload in its engine API representation.
+type ExecutionPayloadV3 deneb.ExecutionPayload
+
+// executionPayloadJSON is the engine API representation of all versions of
+// the execution payload; fields introduced by later versions are omitted
+// when not present.
+type executionPayloadJSON struct {
+	ParentHash    string             `json:"parentHash"`
+	FeeRecipient  string             `json:"feeRecipient"`
+	StateRoot     string             `json:"stateRoot"`
+	ReceiptsRoot  string             `json:"receiptsRoot"`
+	LogsBloom     string             `json:"logsBloom"`
+	PrevRandao    string             `json:"prevRandao"`
+	BlockNumber   string             `json:"blockNumber"`
+	GasLimit      string             `json:"gasLimit"`
+	GasUsed       string             `json:"gasUsed"`
+	Timestamp     string             `json:"timestamp"`
+	ExtraData     string             `json:"extraData"`
+	BaseFeePerGas string             `json:"baseFeePerGas"`
+	BlockHash     string             `json:"blockHash"`
+	Transactions  []string           `json:"transactions"`
+	Withdrawals   *[]*withdrawalJSON `json:"withdrawals,omitempty"`
+	ExcessDataGas string             `json:"excessDataGas,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadV1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packExecutionPayload(&deneb.ExecutionPayload{
+		ParentHash:    e.ParentHash,
+		FeeRecipient:  e.FeeRecipient,
+		StateRoot:     e.StateRoot,
+		ReceiptsRoot:  e.ReceiptsRoot,
+		LogsBloom:     e.LogsBloom,
+		PrevRandao:    e.PrevRandao,
+		BlockNumber:   e.BlockNumber,
+		GasLimit:      e.GasLimit,
+		GasUsed:       e.GasUsed,
+		Timestamp:     e.Timestamp,
+		ExtraData:     e.ExtraData,
+		BaseFeePerGas: uint256FromLE(e.BaseFeePerGas),
+		BlockHash:     e.BlockHash,
+		Transactions:  e.Transactions,
+	}))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadV1) UnmarshalJSON(input []byte) error {
+	var data executionPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	payload, err := unpackExecutionPayload(&data)
+	if err != nil {
+		return err
+	}
+
+	*e = ExecutionPayloadV1{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: uint256ToLE(payload.BaseFeePerGas),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadV2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packExecutionPayload(&deneb.ExecutionPayload{
+		ParentHash:    e.ParentHash,
+		FeeRecipient:  e.FeeRecipient,
+		StateRoot:     e.StateRoot,
+		ReceiptsRoot:  e.ReceiptsRoot,
+		LogsBloom:     e.LogsBloom,
+		PrevRandao:    e.PrevRandao,
+		BlockNumber:   e.BlockNumber,
+		GasLimit:      e.GasLimit,
+		GasUsed:       e.GasUsed,
+		Timestamp:     e.Timestamp,
+		ExtraData:     e.ExtraData,
+		BaseFeePerGas: uint256FromLE(e.BaseFeePerGas),
+		BlockHash:     e.BlockHash,
+		Transactions:  e.Transactions,
+		Withdrawals:   withdrawalsOrEmpty(e.Withdrawals),
+	}))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadV2) UnmarshalJSON(input []byte) error {
+	var data executionPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if data.Withdrawals == nil {
+		return errors.New("withdrawals missing")
+	}
+	payload, err := unpackExecutionPayload(&data)
+	if err != nil {
+		return err
+	}
+
+	*e = ExecutionPayloadV2{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: uint256ToLE(payload.BaseFeePerGas),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals:   payload.Withdrawals,
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadV3) MarshalJSON() ([]byte, error) {
+	if e.BaseFeePerGas == nil {
+		return nil, errors.New("base fee per gas missing")
+	}
+	if e.ExcessDataGas == nil {
+		return nil, errors.New("excess data gas missing")
+	}
+
+	payload := deneb.ExecutionPayload(*e)
+	payload.Withdrawals = withdrawalsOrEmpty(payload.Withdrawals)
+	data := packExecutionPayload(&payload)
+	data.ExcessDataGas = e.ExcessDataGas.Hex()
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadV3) UnmarshalJSON(input []byte) error {
+	var data executionPayloadJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if data.Withdrawals == nil {
+		return errors.New("withdrawals missing")
+	}
+	payload, err := unpackExecutionPayload(&data)
+	if err != nil {
+		return err
+	}
+	payload.ExcessDataGas, err = decodeUint256("excess data gas", data.ExcessDataGas)
+	if err != nil {
+		return err
+	}
+
+	*e = ExecutionPayloadV3(*payload)
+
+	return nil
+}
+
+// packExecutionPayload creates the engine API representation of a payload.
+// Withdrawals are only included if they are not nil.
+func packExecutionPayload(payload *deneb.ExecutionPayload) *executionPayloadJSON {
+	transactions := make([]string, len(payload.Transactions))
+	for i := range payload.Transactions {
+		transactions[i] = encodeData(payload.Transactions[i])
+	}
+
+	data := &executionPayloadJSON{
+		ParentHash:    encodeData(payload.ParentHash[:]),
+		FeeRecipient:  encodeData(payload.FeeRecipient[:]),
+		StateRoot:     encodeData(payload.StateRoot[:]),
+		ReceiptsRoot:  encodeData(payload.ReceiptsRoot[:]),
+		LogsBloom:     encodeData(payload.LogsBloom[:]),
+		PrevRandao:    encodeData(payload.PrevRandao[:]),
+		BlockNumber:   encodeQuantity(payload.BlockNumber),
+		GasLimit:      encodeQuantity(payload.GasLimit),
+		GasUsed:       encodeQuantity(payload.GasUsed),
+		Timestamp:     encodeQuantity(payload.Timestamp),
+		ExtraData:     encodeData(payload.ExtraData),
+		BaseFeePerGas: payload.BaseFeePerGas.Hex(),
+		BlockHash:     encodeData(payload.BlockHash[:]),
+		Transactions:  transactions,
+	}
+	if payload.Withdrawals != nil {
+		withdrawals := make([]*withdrawalJSON, len(payload.Withdrawals))
+		for i := range payload.Withdrawals {
+			withdrawals[i] = packWithdrawal(payload.Withdrawals[i])
+		}
+		data.Withdrawals = &withdrawals
+	}
+
+	return data
+}
+
+// unpackExecutionPayload unpacks the fields common to all versions of the
+// payload, along with withdrawals if present.
+// nolint:gocyclo
+func unpackExecutionPayload(data *executionPayloadJSON) (*deneb.ExecutionPayload, error) {
+	payload := &deneb.ExecutionPayload{}
+	var err error
+
+	if err := decodeFixedData("parent hash", data.ParentHash, payload.ParentHash[:]); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("fee recipient", data.FeeRecipient, payload.FeeRecipient[:]); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("state root", data.StateRoot, payload.StateRoot[:]); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("receipts root", data.ReceiptsRoot, payload.ReceiptsRoot[:]); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("logs bloom", data.LogsBloom, payload.LogsBloom[:]); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("prev randao", data.PrevRandao, payload.PrevRandao[:]); err != nil {
+		return nil, err
+	}
+	if payload.BlockNumber, err = decodeQuantity("block number", data.BlockNumber); err != nil {
+		return nil, err
+	}
+	if payload.GasLimit, err = decodeQuantity("gas limit", data.GasLimit); err != nil {
+		return nil, err
+	}
+	if payload.GasUsed, err = decodeQuantity("gas used", data.GasUsed); err != nil {
+		return nil, err
+	}
+	if payload.Timestamp, err = decodeQuantity("timestamp", data.Timestamp); err != nil {
+		return nil, err
+	}
+	if payload.ExtraData, err = decodeData("extra data", data.ExtraData); err != nil {
+		return nil, err
+	}
+	if len(payload.ExtraData) > 32 {
+		return nil, errors.New("incorrect length for extra data")
+	}
+	if payload.BaseFeePerGas, err = decodeUint256("base fee per gas", data.BaseFeePerGas); err != nil {
+		return nil, err
+	}
+	if err := decodeFixedData("block hash", data.BlockHash, payload.BlockHash[:]); err != nil {
+		return nil, err
+	}
+
+	if data.Transactions == nil {
+		return nil, errors.New("transactions missing")
+	}
+	payload.Transactions = make([]bellatrix.Transaction, len(data.Transactions))
+	for i := range data.Transactions {
+		transaction, err := decodeData("transaction", data.Transactions[i])
+		if err != nil {
+			return nil, err
+		}
+		payload.Transactions[i] = transaction
+	}
+
+	if data.Withdrawals != nil {
+		payload.Withdrawals = make([]*capella.Withdrawal, len(*data.Withdrawals))
+		for i, withdrawal := range *data.Withdrawals {
+			if withdrawal == nil {
+				return nil, errors.New("withdrawal missing")
+			}
+			if payload.Withdrawals[i], err = unpackWithdrawal(withdrawal); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return payload, nil
+}
+
+// withdrawalsOrEmpty ensures that withdrawals are present in the engine API
+// representation even if there are none.
+func withdrawalsOrEmpty(withdrawals []*capella.Withdrawal) []*capella.Withdrawal {
+	if withdrawals == nil {
+		return []*capella.Withdrawal{}
+	}
+
+	return withdrawals
+}
+
+// withdrawalJSON is the engine API representation of a withdrawal.
+type withdrawalJSON struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validatorIndex"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+func packWithdrawal(withdrawal *capella.Withdrawal) *withdrawalJSON {
+	return &withdrawalJSON{
+		Index:          encodeQuantity(uint64(withdrawal.Index)),
+		ValidatorIndex: encodeQuantity(uint64(withdrawal.ValidatorIndex)),
+		Address:        encodeData(withdrawal.Address[:]),
+		Amount:         encodeQuantity(uint64(withdrawal.Amount)),
+	}
+}
+
+func unpackWithdrawal(data *withdrawalJSON) (*capella.Withdrawal, error) {
+	withdrawal := &capella.Withdrawal{}
+
+	index, err := decodeQuantity("withdrawal index", data.Index)
+	if err != nil {
+		return nil, err
+	}
+	withdrawal.Index = capella.WithdrawalIndex(index)
+
+	validatorIndex, err := decodeQuantity("withdrawal validator index", data.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	withdrawal.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+
+	if err := decodeFixedData("withdrawal address", data.Address, withdrawal.Address[:]); err != nil {
+		return nil, err
+	}
+
+	amount, err := decodeQuantity("withdrawal amount", data.Amount)
+	if err != nil {
+		return nil, err
+	}
+	withdrawal.Amount = phase0.Gwei(amount)
+
+	return withdrawal, nil
+}
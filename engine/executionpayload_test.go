@@ -0,0 +1,720 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestExecutionPayloadV1JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.executionPayloadJSON",
+		},
+		{
+			name:  "ParentHashMissing",
+			input: []byte(`{"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "parent hash missing",
+		},
+		{
+			name:  "ParentHashWrongType",
+			input: []byte(`{"parentHash":true,"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field executionPayloadJSON.parentHash of type string",
+		},
+		{
+			name:  "ParentHashNoPrefix",
+			input: []byte(`{"parentHash":"01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for parent hash: missing 0x prefix",
+		},
+		{
+			name:  "ParentHashInvalid",
+			input: []byte(`{"parentHash":"0xzz01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for parent hash: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for parent hash",
+		},
+		{
+			name:  "FeeRecipientMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "fee recipient missing",
+		},
+		{
+			name:  "FeeRecipientShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b727980","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for fee recipient",
+		},
+		{
+			name:  "StateRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "state root missing",
+		},
+		{
+			name:  "StateRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for state root",
+		},
+		{
+			name:  "ReceiptsRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "receipts root missing",
+		},
+		{
+			name:  "ReceiptsRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for receipts root",
+		},
+		{
+			name:  "LogsBloomMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "logs bloom missing",
+		},
+		{
+			name:  "LogsBloomShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for logs bloom",
+		},
+		{
+			name:  "PrevRandaoMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "prev randao missing",
+		},
+		{
+			name:  "PrevRandaoShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for prev randao",
+		},
+		{
+			name:  "BlockNumberMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "block number missing",
+		},
+		{
+			name:  "BlockNumberNoPrefix",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"1110457","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for block number: missing 0x prefix",
+		},
+		{
+			name:  "BlockNumberInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0xg","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for block number: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasUsedMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "gas used missing",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0xg","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for timestamp: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "ExtraDataMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "extra data missing",
+		},
+		{
+			name:  "ExtraDataLong",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x0a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3ea","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for extra data",
+		},
+		{
+			name:  "BaseFeePerGasMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "base fee per gas missing",
+		},
+		{
+			name:  "BaseFeePerGasInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0xg","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "invalid value for base fee per gas: invalid hex string",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BlockHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "incorrect length for block hash",
+		},
+		{
+			name:  "TransactionsMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0"}`),
+			err:   "transactions missing",
+		},
+		{
+			name:  "TransactionInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0xzz"]}`),
+			err:   "invalid value for transaction: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+		},
+		{
+			name:  "GoodNoTransactions",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":[]}`),
+		},
+		{
+			name:  "GoodNoExtraData",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ExecutionPayloadV1
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestExecutionPayloadV2JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.executionPayloadJSON",
+		},
+		{
+			name:  "ParentHashMissing",
+			input: []byte(`{"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "parent hash missing",
+		},
+		{
+			name:  "ParentHashWrongType",
+			input: []byte(`{"parentHash":true,"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field executionPayloadJSON.parentHash of type string",
+		},
+		{
+			name:  "ParentHashNoPrefix",
+			input: []byte(`{"parentHash":"01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for parent hash: missing 0x prefix",
+		},
+		{
+			name:  "ParentHashInvalid",
+			input: []byte(`{"parentHash":"0xzz01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for parent hash: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for parent hash",
+		},
+		{
+			name:  "FeeRecipientMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "fee recipient missing",
+		},
+		{
+			name:  "FeeRecipientShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b727980","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for fee recipient",
+		},
+		{
+			name:  "StateRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "state root missing",
+		},
+		{
+			name:  "StateRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for state root",
+		},
+		{
+			name:  "ReceiptsRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "receipts root missing",
+		},
+		{
+			name:  "ReceiptsRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for receipts root",
+		},
+		{
+			name:  "LogsBloomMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "logs bloom missing",
+		},
+		{
+			name:  "LogsBloomShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for logs bloom",
+		},
+		{
+			name:  "PrevRandaoMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "prev randao missing",
+		},
+		{
+			name:  "PrevRandaoShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for prev randao",
+		},
+		{
+			name:  "BlockNumberMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "block number missing",
+		},
+		{
+			name:  "BlockNumberNoPrefix",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"1110457","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for block number: missing 0x prefix",
+		},
+		{
+			name:  "BlockNumberInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0xg","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for block number: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasUsedMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "gas used missing",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0xg","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for timestamp: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "ExtraDataMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "extra data missing",
+		},
+		{
+			name:  "ExtraDataLong",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x0a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3ea","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for extra data",
+		},
+		{
+			name:  "BaseFeePerGasMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "base fee per gas missing",
+		},
+		{
+			name:  "BaseFeePerGasInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0xg","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for base fee per gas: invalid hex string",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BlockHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "incorrect length for block hash",
+		},
+		{
+			name:  "TransactionsMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "transactions missing",
+		},
+		{
+			name:  "TransactionInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0xzz"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "invalid value for transaction: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "WithdrawalsMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`),
+			err:   "withdrawals missing",
+		},
+		{
+			name:  "WithdrawalIndexMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"}]}`),
+			err:   "withdrawal index missing",
+		},
+		{
+			name:  "WithdrawalValidatorIndexInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0xg","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"}]}`),
+			err:   "invalid value for withdrawal validator index: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "WithdrawalAddressShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f86","amount":"0x2fb1a1"}]}`),
+			err:   "incorrect length for withdrawal address",
+		},
+		{
+			name:  "WithdrawalAmountMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d"}]}`),
+			err:   "withdrawal amount missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+		},
+		{
+			name:  "GoodNoTransactions",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":[],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+		},
+		{
+			name:  "GoodNoExtraData",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+		},
+		{
+			name:  "GoodNoWithdrawals",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ExecutionPayloadV2
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestExecutionPayloadV3JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.executionPayloadJSON",
+		},
+		{
+			name:  "ParentHashMissing",
+			input: []byte(`{"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "parent hash missing",
+		},
+		{
+			name:  "ParentHashWrongType",
+			input: []byte(`{"parentHash":true,"feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field executionPayloadJSON.parentHash of type string",
+		},
+		{
+			name:  "ParentHashNoPrefix",
+			input: []byte(`{"parentHash":"01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for parent hash: missing 0x prefix",
+		},
+		{
+			name:  "ParentHashInvalid",
+			input: []byte(`{"parentHash":"0xzz01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for parent hash: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for parent hash",
+		},
+		{
+			name:  "FeeRecipientMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "fee recipient missing",
+		},
+		{
+			name:  "FeeRecipientShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b727980","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for fee recipient",
+		},
+		{
+			name:  "StateRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "state root missing",
+		},
+		{
+			name:  "StateRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for state root",
+		},
+		{
+			name:  "ReceiptsRootMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "receipts root missing",
+		},
+		{
+			name:  "ReceiptsRootShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for receipts root",
+		},
+		{
+			name:  "LogsBloomMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "logs bloom missing",
+		},
+		{
+			name:  "LogsBloomShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for logs bloom",
+		},
+		{
+			name:  "PrevRandaoMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "prev randao missing",
+		},
+		{
+			name:  "PrevRandaoShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for prev randao",
+		},
+		{
+			name:  "BlockNumberMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "block number missing",
+		},
+		{
+			name:  "BlockNumberNoPrefix",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"1110457","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for block number: missing 0x prefix",
+		},
+		{
+			name:  "BlockNumberInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0xg","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for block number: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasUsedMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "gas used missing",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0xg","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for timestamp: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "ExtraDataMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "extra data missing",
+		},
+		{
+			name:  "ExtraDataLong",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x0a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3ea","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for extra data",
+		},
+		{
+			name:  "BaseFeePerGasMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "base fee per gas missing",
+		},
+		{
+			name:  "BaseFeePerGasInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0xg","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for base fee per gas: invalid hex string",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BlockHashShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for block hash",
+		},
+		{
+			name:  "TransactionsMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "transactions missing",
+		},
+		{
+			name:  "TransactionInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0xzz"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for transaction: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "WithdrawalsMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"excessDataGas":"0x20000"}`),
+			err:   "withdrawals missing",
+		},
+		{
+			name:  "WithdrawalIndexMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"}],"excessDataGas":"0x20000"}`),
+			err:   "withdrawal index missing",
+		},
+		{
+			name:  "WithdrawalValidatorIndexInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0xg","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"}],"excessDataGas":"0x20000"}`),
+			err:   "invalid value for withdrawal validator index: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "WithdrawalAddressShort",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f86","amount":"0x2fb1a1"}],"excessDataGas":"0x20000"}`),
+			err:   "incorrect length for withdrawal address",
+		},
+		{
+			name:  "WithdrawalAmountMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d"}],"excessDataGas":"0x20000"}`),
+			err:   "withdrawal amount missing",
+		},
+		{
+			name:  "ExcessDataGasMissing",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`),
+			err:   "excess data gas missing",
+		},
+		{
+			name:  "ExcessDataGasInvalid",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0xg"}`),
+			err:   "invalid value for excess data gas: invalid hex string",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+		},
+		{
+			name:  "GoodNoTransactions",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":[],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+		},
+		{
+			name:  "GoodNoExtraData",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`),
+		},
+		{
+			name:  "GoodNoWithdrawals",
+			input: []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[],"excessDataGas":"0x20000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ExecutionPayloadV3
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+// TestExecutionPayloadConversion ensures that values in the engine API
+// representation are the same as those in the spec representation.
+func TestExecutionPayloadConversion(t *testing.T) {
+	v1Input := []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"]}`)
+	var v1 engine.ExecutionPayloadV1
+	require.NoError(t, json.Unmarshal(v1Input, &v1))
+	bellatrixPayload := bellatrix.ExecutionPayload(v1)
+	require.Equal(t, uint64(1110457), bellatrixPayload.BlockNumber)
+	require.Equal(t, [32]byte{0x07, 0xca, 0x9a, 0x3b}, bellatrixPayload.BaseFeePerGas)
+	require.Equal(t, "1000000007", bellatrixJSONField(t, &bellatrixPayload, "base_fee_per_gas"))
+
+	v2Input := []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}]}`)
+	var v2 engine.ExecutionPayloadV2
+	require.NoError(t, json.Unmarshal(v2Input, &v2))
+	capellaPayload := capella.ExecutionPayload(v2)
+	require.Equal(t, [32]byte{0x07, 0xca, 0x9a, 0x3b}, capellaPayload.BaseFeePerGas)
+	require.Len(t, capellaPayload.Withdrawals, 2)
+	require.Equal(t, capella.WithdrawalIndex(0xa1b2), capellaPayload.Withdrawals[0].Index)
+	require.Equal(t, uint64(12345), uint64(capellaPayload.Withdrawals[0].ValidatorIndex))
+	require.Equal(t, uint64(3125665), uint64(capellaPayload.Withdrawals[0].Amount))
+
+	v3Input := []byte(`{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`)
+	var v3 engine.ExecutionPayloadV3
+	require.NoError(t, json.Unmarshal(v3Input, &v3))
+	denebPayload := deneb.ExecutionPayload(v3)
+	require.Equal(t, uint64(1000000007), denebPayload.BaseFeePerGas.Uint64())
+	require.Equal(t, uint64(131072), denebPayload.ExcessDataGas.Uint64())
+}
+
+// bellatrixJSONField returns a field from the spec JSON representation of a payload.
+func bellatrixJSONField(t *testing.T, payload *bellatrix.ExecutionPayload, field string) string {
+	t.Helper()
+
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &fields))
+
+	return fields[field].(string)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkchoiceState is the state of the chain as seen by the consensus client.
+type ForkchoiceState struct {
+	HeadBlockHash      phase0.Hash32
+	SafeBlockHash      phase0.Hash32
+	FinalizedBlockHash phase0.Hash32
+}
+
+// forkchoiceStateJSON is the engine API representation of the struct.
+type forkchoiceStateJSON struct {
+	HeadBlockHash      string `json:"headBlockHash"`
+	SafeBlockHash      string `json:"safeBlockHash"`
+	FinalizedBlockHash string `json:"finalizedBlockHash"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkchoiceState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkchoiceStateJSON{
+		HeadBlockHash:      encodeData(f.HeadBlockHash[:]),
+		SafeBlockHash:      encodeData(f.SafeBlockHash[:]),
+		FinalizedBlockHash: encodeData(f.FinalizedBlockHash[:]),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkchoiceState) UnmarshalJSON(input []byte) error {
+	var data forkchoiceStateJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if err := decodeFixedData("head block hash", data.HeadBlockHash, f.HeadBlockHash[:]); err != nil {
+		return err
+	}
+	if err := decodeFixedData("safe block hash", data.SafeBlockHash, f.SafeBlockHash[:]); err != nil {
+		return err
+	}
+	if err := decodeFixedData("finalized block hash", data.FinalizedBlockHash, f.FinalizedBlockHash[:]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkchoiceState) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestForkchoiceStateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.forkchoiceStateJSON",
+		},
+		{
+			name:  "HeadBlockHashMissing",
+			input: []byte(`{"safeBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","finalizedBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "head block hash missing",
+		},
+		{
+			name:  "HeadBlockHashNoPrefix",
+			input: []byte(`{"headBlockHash":"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","safeBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","finalizedBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "invalid value for head block hash: missing 0x prefix",
+		},
+		{
+			name:  "SafeBlockHashMissing",
+			input: []byte(`{"headBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","finalizedBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "safe block hash missing",
+		},
+		{
+			name:  "SafeBlockHashShort",
+			input: []byte(`{"headBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","safeBlockHash":"0x0102","finalizedBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "incorrect length for safe block hash",
+		},
+		{
+			name:  "FinalizedBlockHashMissing",
+			input: []byte(`{"headBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","safeBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "finalized block hash missing",
+		},
+		{
+			name:  "FinalizedBlockHashInvalid",
+			input: []byte(`{"headBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","safeBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","finalizedBlockHash":"0xzz"}`),
+			err:   "invalid value for finalized block hash: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"headBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","safeBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","finalizedBlockHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ForkchoiceState
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ForkchoiceUpdatedResult is the result of updating the forkchoice of an execution client.
+type ForkchoiceUpdatedResult struct {
+	// PayloadStatus is the status of the new head.
+	PayloadStatus *PayloadStatus
+	// PayloadID is the identifier of the payload being built, if payload
+	// attributes were supplied and the execution client started building.
+	PayloadID *PayloadID
+}
+
+// forkchoiceUpdatedResultJSON is the engine API representation of the struct.
+type forkchoiceUpdatedResultJSON struct {
+	PayloadStatus *PayloadStatus `json:"payloadStatus"`
+	PayloadID     *PayloadID     `json:"payloadId"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkchoiceUpdatedResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkchoiceUpdatedResultJSON{
+		PayloadStatus: f.PayloadStatus,
+		PayloadID:     f.PayloadID,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkchoiceUpdatedResult) UnmarshalJSON(input []byte) error {
+	var data forkchoiceUpdatedResultJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.PayloadStatus == nil {
+		return errors.New("payload status missing")
+	}
+	f.PayloadStatus = data.PayloadStatus
+	f.PayloadID = data.PayloadID
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkchoiceUpdatedResult) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestForkchoiceUpdatedResultJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.forkchoiceUpdatedResultJSON",
+		},
+		{
+			name:  "PayloadStatusMissing",
+			input: []byte(`{"payloadId":"0x0102030405060708"}`),
+			err:   "payload status missing",
+		},
+		{
+			name:  "PayloadStatusInvalid",
+			input: []byte(`{"payloadStatus":{"latestValidHash":null,"validationError":null},"payloadId":null}`),
+			err:   "invalid JSON: status missing",
+		},
+		{
+			name:  "PayloadIDShort",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","validationError":null},"payloadId":"0x01020304"}`),
+			err:   "invalid JSON: incorrect length for payload ID",
+		},
+		{
+			name:  "PayloadIDWrongType",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","validationError":null},"payloadId":1}`),
+			err:   "invalid JSON: invalid JSON: json: cannot unmarshal number into Go value of type string",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"payloadStatus":{"status":"VALID","latestValidHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","validationError":null},"payloadId":"0x0102030405060708"}`),
+		},
+		{
+			name:  "GoodNoPayloadID",
+			input: []byte(`{"payloadStatus":{"status":"SYNCING","latestValidHash":null,"validationError":null},"payloadId":null}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.ForkchoiceUpdatedResult
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/holiman/uint256"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// GetPayloadV2Response is the response to a request for a Capella payload.
+type GetPayloadV2Response struct {
+	ExecutionPayload *capella.ExecutionPayload
+	// BlockValue is the value of the payload to the fee recipient, in wei.
+	BlockValue *uint256.Int
+}
+
+// getPayloadV2ResponseJSON is the engine API representation of the struct.
+type getPayloadV2ResponseJSON struct {
+	ExecutionPayload *ExecutionPayloadV2 `json:"executionPayload"`
+	BlockValue       string              `json:"blockValue"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *GetPayloadV2Response) MarshalJSON() ([]byte, error) {
+	if g.ExecutionPayload == nil {
+		return nil, errors.New("execution payload missing")
+	}
+	if g.BlockValue == nil {
+		return nil, errors.New("block value missing")
+	}
+
+	return json.Marshal(&getPayloadV2ResponseJSON{
+		ExecutionPayload: (*ExecutionPayloadV2)(g.ExecutionPayload),
+		BlockValue:       g.BlockValue.Hex(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *GetPayloadV2Response) UnmarshalJSON(input []byte) error {
+	var data getPayloadV2ResponseJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ExecutionPayload == nil {
+		return errors.New("execution payload missing")
+	}
+	g.ExecutionPayload = (*capella.ExecutionPayload)(data.ExecutionPayload)
+
+	var err error
+	if g.BlockValue, err = decodeUint256("block value", data.BlockValue); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (g *GetPayloadV2Response) String() string {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
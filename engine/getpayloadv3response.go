@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/holiman/uint256"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// GetPayloadV3Response is the response to a request for a Deneb payload.
+type GetPayloadV3Response struct {
+	ExecutionPayload *deneb.ExecutionPayload
+	// BlockValue is the value of the payload to the fee recipient, in wei.
+	BlockValue *uint256.Int
+	// BlobsBundle contains the blobs committed to by the payload's transactions.
+	BlobsBundle *apiv1deneb.BlobsBundle
+}
+
+// getPayloadV3ResponseJSON is the engine API representation of the struct.
+type getPayloadV3ResponseJSON struct {
+	ExecutionPayload *ExecutionPayloadV3 `json:"executionPayload"`
+	BlockValue       string              `json:"blockValue"`
+	BlobsBundle      *blobsBundleJSON    `json:"blobsBundle"`
+}
+
+// blobsBundleJSON is the engine API representation of a blobs bundle.
+type blobsBundleJSON struct {
+	Commitments []string `json:"commitments"`
+	Proofs      []string `json:"proofs"`
+	Blobs       []string `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *GetPayloadV3Response) MarshalJSON() ([]byte, error) {
+	if g.ExecutionPayload == nil {
+		return nil, errors.New("execution payload missing")
+	}
+	if g.BlockValue == nil {
+		return nil, errors.New("block value missing")
+	}
+	if g.BlobsBundle == nil {
+		return nil, errors.New("blobs bundle missing")
+	}
+
+	blobsBundle := &blobsBundleJSON{
+		Commitments: make([]string, len(g.BlobsBundle.Commitments)),
+		Proofs:      make([]string, len(g.BlobsBundle.Proofs)),
+		Blobs:       make([]string, len(g.BlobsBundle.Blobs)),
+	}
+	for i := range g.BlobsBundle.Commitments {
+		blobsBundle.Commitments[i] = encodeData(g.BlobsBundle.Commitments[i][:])
+	}
+	for i := range g.BlobsBundle.Proofs {
+		blobsBundle.Proofs[i] = encodeData(g.BlobsBundle.Proofs[i][:])
+	}
+	for i := range g.BlobsBundle.Blobs {
+		blobsBundle.Blobs[i] = encodeData(g.BlobsBundle.Blobs[i][:])
+	}
+
+	return json.Marshal(&getPayloadV3ResponseJSON{
+		ExecutionPayload: (*ExecutionPayloadV3)(g.ExecutionPayload),
+		BlockValue:       g.BlockValue.Hex(),
+		BlobsBundle:      blobsBundle,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *GetPayloadV3Response) UnmarshalJSON(input []byte) error {
+	var data getPayloadV3ResponseJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ExecutionPayload == nil {
+		return errors.New("execution payload missing")
+	}
+	g.ExecutionPayload = (*deneb.ExecutionPayload)(data.ExecutionPayload)
+
+	var err error
+	if g.BlockValue, err = decodeUint256("block value", data.BlockValue); err != nil {
+		return err
+	}
+
+	if data.BlobsBundle == nil {
+		return errors.New("blobs bundle missing")
+	}
+	if data.BlobsBundle.Commitments == nil {
+		return errors.New("commitments missing")
+	}
+	if data.BlobsBundle.Proofs == nil {
+		return errors.New("proofs missing")
+	}
+	if data.BlobsBundle.Blobs == nil {
+		return errors.New("blobs missing")
+	}
+	g.BlobsBundle = &apiv1deneb.BlobsBundle{
+		Commitments: make([]deneb.KzgCommitment, len(data.BlobsBundle.Commitments)),
+		Proofs:      make([]deneb.KzgProof, len(data.BlobsBundle.Proofs)),
+		Blobs:       make([]deneb.Blob, len(data.BlobsBundle.Blobs)),
+	}
+	for i := range data.BlobsBundle.Commitments {
+		if err := decodeFixedData("commitment", data.BlobsBundle.Commitments[i], g.BlobsBundle.Commitments[i][:]); err != nil {
+			return err
+		}
+	}
+	for i := range data.BlobsBundle.Proofs {
+		if err := decodeFixedData("proof", data.BlobsBundle.Proofs[i], g.BlobsBundle.Proofs[i][:]); err != nil {
+			return err
+		}
+	}
+	for i := range data.BlobsBundle.Blobs {
+		if err := decodeFixedData("blob", data.BlobsBundle.Blobs[i], g.BlobsBundle.Blobs[i][:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (g *GetPayloadV3Response) String() string {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestGetPayloadV3ResponseJSON(t *testing.T) {
+	payload := `{"parentHash":"0x01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3da","feeRecipient":"0x020910171e252c333a41484f565d646b72798087","stateRoot":"0x030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dc","receiptsRoot":"0x040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dd","logsBloom":"0x050c131a21282f363d444b525960676e757c838a91989fa6adb4bbc2c9d0d7dee5ecf3fa01080f161d242b323940474e555c636a71787f868d949ba2a9b0b7bec5ccd3dae1e8eff6fd040b121920272e353c434a51585f666d747b828990979ea5acb3bac1c8cfd6dde4ebf2f900070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0e7eef5fc030a11181f262d343b424950575e656c737a81888f969da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8dfe6edf4fb020910171e252c333a41484f565d646b727980878e959ca3aab1b8bfc6cdd4dbe2e9f0f7fe","prevRandao":"0x060d141b222930373e454c535a61686f767d848b9299a0a7aeb5bcc3cad1d8df","blockNumber":"0x10f1b9","gasLimit":"0x1c9c380","gasUsed":"0x5208","timestamp":"0x6422bb3f","extraData":"0x6265617665726275696c642e6f7267","baseFeePerGas":"0x3b9aca07","blockHash":"0x070e151c232a31383f464d545b626970777e858c939aa1a8afb6bdc4cbd2d9e0","transactions":["0x02f87201","0xf86c0a85"],"withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x080f161d242b323940474e555c636a71787f868d","amount":"0x2fb1a1"},{"index":"0xa1b3","validatorIndex":"0x303a","address":"0x0910171e252c333a41484f565d646b727980878e","amount":"0x0"}],"excessDataGas":"0x20000"}`
+	commitment := fmt.Sprintf("0x%s", strings.Repeat("a1", 48))
+	proof := fmt.Sprintf("0x%s", strings.Repeat("b2", 48))
+	blob := fmt.Sprintf("0x%s", strings.Repeat("c3", 131072))
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.getPayloadV3ResponseJSON",
+		},
+		{
+			name:  "ExecutionPayloadMissing",
+			input: []byte(`{"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"proofs":[],"blobs":[]}}`),
+			err:   "execution payload missing",
+		},
+		{
+			name:  "BlockValueMissing",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blobsBundle":{"commitments":[],"proofs":[],"blobs":[]}}`, payload)),
+			err:   "block value missing",
+		},
+		{
+			name:  "BlobsBundleMissing",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000"}`, payload)),
+			err:   "blobs bundle missing",
+		},
+		{
+			name:  "CommitmentsMissing",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"proofs":[],"blobs":[]}}`, payload)),
+			err:   "commitments missing",
+		},
+		{
+			name:  "CommitmentShort",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":["0x0102"],"proofs":[],"blobs":[]}}`, payload)),
+			err:   "incorrect length for commitment",
+		},
+		{
+			name:  "ProofsMissing",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"blobs":[]}}`, payload)),
+			err:   "proofs missing",
+		},
+		{
+			name:  "ProofShort",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"proofs":["0x0102"],"blobs":[]}}`, payload)),
+			err:   "incorrect length for proof",
+		},
+		{
+			name:  "BlobsMissing",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"proofs":[]}}`, payload)),
+			err:   "blobs missing",
+		},
+		{
+			name:  "BlobShort",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"proofs":[],"blobs":["0x0102"]}}`, payload)),
+			err:   "incorrect length for blob",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":["%s"],"proofs":["%s"],"blobs":["%s"]}}`, payload, commitment, proof, blob)),
+		},
+		{
+			name:  "GoodNoBlobs",
+			input: []byte(fmt.Sprintf(`{"executionPayload":%s,"blockValue":"0xde0b6b3a7640000","blobsBundle":{"commitments":[],"proofs":[],"blobs":[]}}`, payload)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.GetPayloadV3Response
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// The engine API encodes quantities as hex strings without leading zeros, and
+// data as hex strings with two characters per byte; both require a 0x prefix.
+
+// encodeData encodes data as a hex string.
+func encodeData(data []byte) string {
+	return fmt.Sprintf("0x%x", data)
+}
+
+// decodeData decodes a hex data string.
+func decodeData(name string, input string) ([]byte, error) {
+	if input == "" {
+		return nil, fmt.Errorf("%s missing", name)
+	}
+	if !strings.HasPrefix(input, "0x") {
+		return nil, fmt.Errorf("invalid value for %s: missing 0x prefix", name)
+	}
+	data, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return data, nil
+}
+
+// decodeFixedData decodes a hex data string in to a fixed-length destination.
+func decodeFixedData(name string, input string, dst []byte) error {
+	data, err := decodeData(name, input)
+	if err != nil {
+		return err
+	}
+	if len(data) != len(dst) {
+		return fmt.Errorf("incorrect length for %s", name)
+	}
+	copy(dst, data)
+
+	return nil
+}
+
+// encodeQuantity encodes a quantity as a hex string.
+func encodeQuantity(quantity uint64) string {
+	return fmt.Sprintf("%#x", quantity)
+}
+
+// decodeQuantity decodes a hex quantity string.
+func decodeQuantity(name string, input string) (uint64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("%s missing", name)
+	}
+	if !strings.HasPrefix(input, "0x") {
+		return 0, fmt.Errorf("invalid value for %s: missing 0x prefix", name)
+	}
+	quantity, err := strconv.ParseUint(input[2:], 16, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return quantity, nil
+}
+
+// decodeUint256 decodes a hex quantity string that may exceed 64 bits.
+func decodeUint256(name string, input string) (*uint256.Int, error) {
+	if input == "" {
+		return nil, fmt.Errorf("%s missing", name)
+	}
+	quantity, err := uint256.FromHex(input)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return quantity, nil
+}
+
+// uint256FromLE converts a little-endian 32-byte value, as used for the base
+// fee per gas in Bellatrix and Capella payloads, to an integer.
+func uint256FromLE(input [32]byte) *uint256.Int {
+	var be [32]byte
+	for i := 0; i < 32; i++ {
+		be[i] = input[32-1-i]
+	}
+
+	return new(uint256.Int).SetBytes(be[:])
+}
+
+// uint256ToLE converts an integer to a little-endian 32-byte value.
+func uint256ToLE(input *uint256.Int) [32]byte {
+	be := input.Bytes32()
+	var res [32]byte
+	for i := 0; i < 32; i++ {
+		res[i] = be[32-1-i]
+	}
+
+	return res
+}
@@ -0,0 +1,35 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ExchangeCapabilities sends the engine API methods supported by the caller,
+// and returns those supported by the execution client.
+func (s *Service) ExchangeCapabilities(ctx context.Context, capabilities []string) ([]string, error) {
+	if capabilities == nil {
+		capabilities = []string{}
+	}
+
+	var res []string
+	if err := s.call(ctx, "engine_exchangeCapabilities", &res, capabilities); err != nil {
+		return nil, errors.Wrap(err, "failed to exchange capabilities")
+	}
+
+	return res, nil
+}
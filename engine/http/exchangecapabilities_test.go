@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	enginehttp "github.com/jefmcl/go-eth2-client/engine/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeCapabilities(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	capabilities, err := s.ExchangeCapabilities(ctx, enginehttp.SupportedMethods)
+	require.NoError(t, err)
+	require.Equal(t, enginehttp.SupportedMethods, capabilities)
+
+	capabilities, err = s.ExchangeCapabilities(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, capabilities)
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/pkg/errors"
+)
+
+// ForkchoiceUpdatedV1 updates the forkchoice state and, if attributes are
+// supplied, starts building a Bellatrix payload.
+func (s *Service) ForkchoiceUpdatedV1(ctx context.Context,
+	state *engine.ForkchoiceState,
+	attributes *engine.PayloadAttributes,
+) (
+	*engine.ForkchoiceUpdatedResult,
+	error,
+) {
+	if attributes != nil {
+		if attributes.Withdrawals != nil {
+			return nil, errors.New("version 1 payload attributes cannot contain withdrawals")
+		}
+		if attributes.ParentBeaconBlockRoot != nil {
+			return nil, errors.New("version 1 payload attributes cannot contain a parent beacon block root")
+		}
+	}
+
+	return s.forkchoiceUpdated(ctx, "engine_forkchoiceUpdatedV1", state, attributes)
+}
+
+// ForkchoiceUpdatedV2 updates the forkchoice state and, if attributes are
+// supplied, starts building a Capella payload.
+func (s *Service) ForkchoiceUpdatedV2(ctx context.Context,
+	state *engine.ForkchoiceState,
+	attributes *engine.PayloadAttributes,
+) (
+	*engine.ForkchoiceUpdatedResult,
+	error,
+) {
+	if attributes != nil {
+		if attributes.Withdrawals == nil {
+			return nil, errors.New("version 2 payload attributes require withdrawals")
+		}
+		if attributes.ParentBeaconBlockRoot != nil {
+			return nil, errors.New("version 2 payload attributes cannot contain a parent beacon block root")
+		}
+	}
+
+	return s.forkchoiceUpdated(ctx, "engine_forkchoiceUpdatedV2", state, attributes)
+}
+
+// ForkchoiceUpdatedV3 updates the forkchoice state and, if attributes are
+// supplied, starts building a Deneb payload.
+func (s *Service) ForkchoiceUpdatedV3(ctx context.Context,
+	state *engine.ForkchoiceState,
+	attributes *engine.PayloadAttributes,
+) (
+	*engine.ForkchoiceUpdatedResult,
+	error,
+) {
+	if attributes != nil {
+		if attributes.Withdrawals == nil {
+			return nil, errors.New("version 3 payload attributes require withdrawals")
+		}
+		if attributes.ParentBeaconBlockRoot == nil {
+			return nil, errors.New("version 3 payload attributes require a parent beacon block root")
+		}
+	}
+
+	return s.forkchoiceUpdated(ctx, "engine_forkchoiceUpdatedV3", state, attributes)
+}
+
+// forkchoiceUpdated calls the given version of the forkchoice updated method.
+func (s *Service) forkchoiceUpdated(ctx context.Context,
+	method string,
+	state *engine.ForkchoiceState,
+	attributes *engine.PayloadAttributes,
+) (
+	*engine.ForkchoiceUpdatedResult,
+	error,
+) {
+	if state == nil {
+		return nil, errors.New("no forkchoice state specified")
+	}
+
+	var res engine.ForkchoiceUpdatedResult
+	if err := s.call(ctx, method, &res, state, attributes); err != nil {
+		return nil, errors.Wrap(err, "failed to update forkchoice")
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceUpdated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, el := newTestService(ctx, t)
+
+	head := phase0.Hash32{0x21}
+	_, err := s.NewPayloadV2(ctx, testCapellaPayload(head))
+	require.NoError(t, err)
+	state := &engine.ForkchoiceState{
+		HeadBlockHash:      head,
+		SafeBlockHash:      phase0.Hash32{0x22},
+		FinalizedBlockHash: phase0.Hash32{0x23},
+	}
+	root := phase0.Root{0x24}
+
+	tests := []struct {
+		name       string
+		state      *engine.ForkchoiceState
+		attributes *engine.PayloadAttributes
+		call       func(context.Context, *engine.ForkchoiceState, *engine.PayloadAttributes) (*engine.ForkchoiceUpdatedResult, error)
+		status     engine.ExecutionStatus
+		payloadID  bool
+		err        string
+	}{
+		{
+			name: "StateMissing",
+			call: s.ForkchoiceUpdatedV2,
+			err:  "no forkchoice state specified",
+		},
+		{
+			name:       "V1WithdrawalsPresent",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Withdrawals: []*capella.Withdrawal{}},
+			call:       s.ForkchoiceUpdatedV1,
+			err:        "version 1 payload attributes cannot contain withdrawals",
+		},
+		{
+			name:       "V1ParentBeaconBlockRootPresent",
+			state:      state,
+			attributes: &engine.PayloadAttributes{ParentBeaconBlockRoot: &root},
+			call:       s.ForkchoiceUpdatedV1,
+			err:        "version 1 payload attributes cannot contain a parent beacon block root",
+		},
+		{
+			name:       "V2WithdrawalsMissing",
+			state:      state,
+			attributes: &engine.PayloadAttributes{},
+			call:       s.ForkchoiceUpdatedV2,
+			err:        "version 2 payload attributes require withdrawals",
+		},
+		{
+			name:       "V2ParentBeaconBlockRootPresent",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Withdrawals: []*capella.Withdrawal{}, ParentBeaconBlockRoot: &root},
+			call:       s.ForkchoiceUpdatedV2,
+			err:        "version 2 payload attributes cannot contain a parent beacon block root",
+		},
+		{
+			name:       "V3WithdrawalsMissing",
+			state:      state,
+			attributes: &engine.PayloadAttributes{ParentBeaconBlockRoot: &root},
+			call:       s.ForkchoiceUpdatedV3,
+			err:        "version 3 payload attributes require withdrawals",
+		},
+		{
+			name:       "V3ParentBeaconBlockRootMissing",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Withdrawals: []*capella.Withdrawal{}},
+			call:       s.ForkchoiceUpdatedV3,
+			err:        "version 3 payload attributes require a parent beacon block root",
+		},
+		{
+			name: "UnknownHead",
+			state: &engine.ForkchoiceState{
+				HeadBlockHash: phase0.Hash32{0x25},
+			},
+			call:   s.ForkchoiceUpdatedV2,
+			status: engine.ExecutionStatusSyncing,
+		},
+		{
+			name:   "V1NoAttributes",
+			state:  state,
+			call:   s.ForkchoiceUpdatedV1,
+			status: engine.ExecutionStatusValid,
+		},
+		{
+			name:       "V1",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Timestamp: 1680000012},
+			call:       s.ForkchoiceUpdatedV1,
+			status:     engine.ExecutionStatusValid,
+			payloadID:  true,
+		},
+		{
+			name:       "V2",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Timestamp: 1680000012, Withdrawals: []*capella.Withdrawal{}},
+			call:       s.ForkchoiceUpdatedV2,
+			status:     engine.ExecutionStatusValid,
+			payloadID:  true,
+		},
+		{
+			name:       "V3",
+			state:      state,
+			attributes: &engine.PayloadAttributes{Timestamp: 1680000012, Withdrawals: []*capella.Withdrawal{}, ParentBeaconBlockRoot: &root},
+			call:       s.ForkchoiceUpdatedV3,
+			status:     engine.ExecutionStatusValid,
+			payloadID:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.call(ctx, test.state, test.attributes)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.status, res.PayloadStatus.Status)
+			if test.payloadID {
+				require.NotNil(t, res.PayloadID)
+			} else {
+				require.Nil(t, res.PayloadID)
+			}
+		})
+	}
+
+	require.Equal(t, state, el.ForkchoiceState())
+}
@@ -0,0 +1,31 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/pkg/errors"
+)
+
+// GetBlobsBundleV1 obtains the blobs for the payload being built for the given ID.
+func (s *Service) GetBlobsBundleV1(ctx context.Context, id engine.PayloadID) (*engine.BlobsBundleV1, error) {
+	var res engine.BlobsBundleV1
+	if err := s.call(ctx, "engine_getBlobsBundleV1", &res, id); err != nil {
+		return nil, errors.Wrap(err, "failed to obtain blobs bundle")
+	}
+
+	return &res, nil
+}
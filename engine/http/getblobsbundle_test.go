@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlobsBundleV1(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	_, err := s.GetBlobsBundleV1(ctx, engine.PayloadID{0x01})
+	require.EqualError(t, err, "failed to obtain blobs bundle: engine_getBlobsBundleV1 failed with code -38001: Unknown payload")
+
+	head := testDenebPayload(phase0.Hash32{0x41})
+	_, err = s.NewPayloadV3(ctx, head, nil, phase0.Root{})
+	require.NoError(t, err)
+	res, err := s.ForkchoiceUpdatedV3(ctx, &engine.ForkchoiceState{HeadBlockHash: head.BlockHash}, testAttributes(3))
+	require.NoError(t, err)
+	require.NotNil(t, res.PayloadID)
+
+	payload, err := s.GetPayloadV3(ctx, *res.PayloadID)
+	require.NoError(t, err)
+
+	bundle, err := s.GetBlobsBundleV1(ctx, *res.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, payload.ExecutionPayload.BlockHash, bundle.BlockHash)
+	require.Empty(t, bundle.KZGs)
+	require.Empty(t, bundle.Blobs)
+}
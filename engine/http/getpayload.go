@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/pkg/errors"
+)
+
+// GetPayloadV1 obtains the Bellatrix payload being built for the given ID.
+func (s *Service) GetPayloadV1(ctx context.Context, id engine.PayloadID) (*bellatrix.ExecutionPayload, error) {
+	var res engine.ExecutionPayloadV1
+	if err := s.call(ctx, "engine_getPayloadV1", &res, id); err != nil {
+		return nil, errors.Wrap(err, "failed to obtain payload")
+	}
+
+	return (*bellatrix.ExecutionPayload)(&res), nil
+}
+
+// GetPayloadV2 obtains the Capella payload being built for the given ID,
+// along with its value.
+func (s *Service) GetPayloadV2(ctx context.Context, id engine.PayloadID) (*engine.GetPayloadV2Response, error) {
+	var res engine.GetPayloadV2Response
+	if err := s.call(ctx, "engine_getPayloadV2", &res, id); err != nil {
+		return nil, errors.Wrap(err, "failed to obtain payload")
+	}
+
+	return &res, nil
+}
+
+// GetPayloadV3 obtains the Deneb payload being built for the given ID,
+// along with its value and blobs.
+func (s *Service) GetPayloadV3(ctx context.Context, id engine.PayloadID) (*engine.GetPayloadV3Response, error) {
+	var res engine.GetPayloadV3Response
+	if err := s.call(ctx, "engine_getPayloadV3", &res, id); err != nil {
+		return nil, errors.Wrap(err, "failed to obtain payload")
+	}
+
+	return &res, nil
+}
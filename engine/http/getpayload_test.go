@@ -0,0 +1,134 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttributes creates payload attributes for the given version.
+func testAttributes(version int) *engine.PayloadAttributes {
+	attributes := &engine.PayloadAttributes{
+		Timestamp:             1680000012,
+		PrevRandao:            [32]byte{0x31},
+		SuggestedFeeRecipient: bellatrix.ExecutionAddress{0x32},
+	}
+	if version >= 2 {
+		attributes.Withdrawals = []*capella.Withdrawal{
+			{
+				Index:          7,
+				ValidatorIndex: 8,
+				Address:        bellatrix.ExecutionAddress{0x33},
+				Amount:         1234567,
+			},
+		}
+	}
+	if version >= 3 {
+		attributes.ParentBeaconBlockRoot = &phase0.Root{0x34}
+	}
+
+	return attributes
+}
+
+func TestGetPayloadV1(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	_, err := s.GetPayloadV1(ctx, engine.PayloadID{0x01})
+	require.EqualError(t, err, "failed to obtain payload: engine_getPayloadV1 failed with code -38001: Unknown payload")
+
+	head := testBellatrixPayload(phase0.Hash32{0x35})
+	_, err = s.NewPayloadV1(ctx, head)
+	require.NoError(t, err)
+	res, err := s.ForkchoiceUpdatedV1(ctx, &engine.ForkchoiceState{HeadBlockHash: head.BlockHash}, testAttributes(1))
+	require.NoError(t, err)
+	require.NotNil(t, res.PayloadID)
+
+	payload, err := s.GetPayloadV1(ctx, *res.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, head.BlockHash, payload.ParentHash)
+	require.Equal(t, head.BlockNumber+1, payload.BlockNumber)
+	require.Equal(t, head.BaseFeePerGas, payload.BaseFeePerGas)
+	require.Equal(t, testAttributes(1).SuggestedFeeRecipient, payload.FeeRecipient)
+	require.Equal(t, testAttributes(1).PrevRandao, payload.PrevRandao)
+	require.Equal(t, testAttributes(1).Timestamp, payload.Timestamp)
+
+	// Built payloads can only be obtained with the matching version.
+	_, err = s.GetPayloadV2(ctx, *res.PayloadID)
+	require.EqualError(t, err, "failed to obtain payload: engine_getPayloadV2 failed with code -38005: Unsupported fork")
+}
+
+func TestGetPayloadV2(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	head := testCapellaPayload(phase0.Hash32{0x36})
+	_, err := s.NewPayloadV2(ctx, head)
+	require.NoError(t, err)
+	res, err := s.ForkchoiceUpdatedV2(ctx, &engine.ForkchoiceState{HeadBlockHash: head.BlockHash}, testAttributes(2))
+	require.NoError(t, err)
+	require.NotNil(t, res.PayloadID)
+
+	resp, err := s.GetPayloadV2(ctx, *res.PayloadID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.BlockValue.Uint64())
+	payload := resp.ExecutionPayload
+	require.Equal(t, head.BlockHash, payload.ParentHash)
+	require.Equal(t, head.BlockNumber+1, payload.BlockNumber)
+	require.Equal(t, head.BaseFeePerGas, payload.BaseFeePerGas)
+	require.Equal(t, testAttributes(2).Withdrawals, payload.Withdrawals)
+	require.Equal(t, []bellatrix.Transaction{}, payload.Transactions)
+}
+
+func TestGetPayloadV3(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	// Build a Deneb payload on top of a Capella head.
+	head := testCapellaPayload(phase0.Hash32{0x37})
+	_, err := s.NewPayloadV2(ctx, head)
+	require.NoError(t, err)
+	res, err := s.ForkchoiceUpdatedV3(ctx, &engine.ForkchoiceState{HeadBlockHash: head.BlockHash}, testAttributes(3))
+	require.NoError(t, err)
+	require.NotNil(t, res.PayloadID)
+
+	resp, err := s.GetPayloadV3(ctx, *res.PayloadID)
+	require.NoError(t, err)
+	payload := resp.ExecutionPayload
+	require.Equal(t, head.BlockHash, payload.ParentHash)
+	require.Equal(t, uint64(1000000007), payload.BaseFeePerGas.Uint64())
+	require.Equal(t, uint64(0), payload.ExcessDataGas.Uint64())
+	require.Equal(t, testAttributes(3).Withdrawals, payload.Withdrawals)
+	require.Equal(t, []deneb.KzgCommitment{}, resp.BlobsBundle.Commitments)
+	require.Equal(t, []deneb.Blob{}, resp.BlobsBundle.Blobs)
+
+	// The built payload can be submitted back.
+	status, err := s.NewPayloadV3(ctx, payload, nil, *testAttributes(3).ParentBeaconBlockRoot)
+	require.NoError(t, err)
+	require.Equal(t, engine.ExecutionStatusValid, status.Status)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// jwtSecretLength is the length of the secret shared with the execution client.
+const jwtSecretLength = 32
+
+// jwtHeader is the pre-encoded header for the tokens generated by the client.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// jwtClaims are the claims of the tokens generated by the client.
+type jwtClaims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+// readJWTSecret reads a hex-encoded JWT secret from a file.
+func readJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid secret")
+	}
+
+	return secret, nil
+}
+
+// checkJWTSecret checks that a JWT secret is the length required by the engine API.
+func checkJWTSecret(secret []byte) error {
+	if len(secret) != jwtSecretLength {
+		return fmt.Errorf("secret must be %d bytes, is %d bytes", jwtSecretLength, len(secret))
+	}
+
+	return nil
+}
+
+// jwt generates an HS256 token for a request, issued at the current time.
+// The engine API requires that tokens are no more than 60 seconds old, so a
+// new token is generated for each request.
+func (s *Service) jwt() (string, error) {
+	claims, err := json.Marshal(&jwtClaims{
+		IssuedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal claims")
+	}
+
+	signingInput := fmt.Sprintf("%s.%s", jwtHeader, base64.RawURLEncoding.EncodeToString(claims))
+	mac := hmac.New(sha256.New, s.jwtSecret)
+	_, _ = mac.Write([]byte(signingInput))
+
+	return fmt.Sprintf("%s.%s", signingInput, base64.RawURLEncoding.EncodeToString(mac.Sum(nil))), nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// NewPayloadV1 submits a Bellatrix execution payload.
+func (s *Service) NewPayloadV1(ctx context.Context, payload *bellatrix.ExecutionPayload) (*engine.PayloadStatus, error) {
+	if payload == nil {
+		return nil, errors.New("no payload specified")
+	}
+
+	var res engine.PayloadStatus
+	if err := s.call(ctx, "engine_newPayloadV1", &res, (*engine.ExecutionPayloadV1)(payload)); err != nil {
+		return nil, errors.Wrap(err, "failed to submit payload")
+	}
+
+	return &res, nil
+}
+
+// NewPayloadV2 submits a Capella execution payload.
+func (s *Service) NewPayloadV2(ctx context.Context, payload *capella.ExecutionPayload) (*engine.PayloadStatus, error) {
+	if payload == nil {
+		return nil, errors.New("no payload specified")
+	}
+
+	var res engine.PayloadStatus
+	if err := s.call(ctx, "engine_newPayloadV2", &res, (*engine.ExecutionPayloadV2)(payload)); err != nil {
+		return nil, errors.Wrap(err, "failed to submit payload")
+	}
+
+	return &res, nil
+}
+
+// NewPayloadV3 submits a Deneb execution payload, along with the versioned
+// hashes of the blobs it is expected to contain and the root of its parent
+// beacon block.
+func (s *Service) NewPayloadV3(ctx context.Context,
+	payload *deneb.ExecutionPayload,
+	versionedHashes []deneb.VersionedHash,
+	parentBeaconBlockRoot phase0.Root,
+) (
+	*engine.PayloadStatus,
+	error,
+) {
+	if payload == nil {
+		return nil, errors.New("no payload specified")
+	}
+
+	hashes := make([]string, len(versionedHashes))
+	for i := range versionedHashes {
+		hashes[i] = fmt.Sprintf("%#x", versionedHashes[i])
+	}
+
+	var res engine.PayloadStatus
+	if err := s.call(ctx, "engine_newPayloadV3", &res,
+		(*engine.ExecutionPayloadV3)(payload),
+		hashes,
+		parentBeaconBlockRoot.String(),
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to submit payload")
+	}
+
+	return &res, nil
+}
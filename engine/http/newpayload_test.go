@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayloadV1(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, el := newTestService(ctx, t)
+
+	_, err := s.NewPayloadV1(ctx, nil)
+	require.EqualError(t, err, "no payload specified")
+
+	blockHash := phase0.Hash32{0x11}
+	payload := testBellatrixPayload(blockHash)
+	status, err := s.NewPayloadV1(ctx, payload)
+	require.NoError(t, err)
+	require.Equal(t, engine.ExecutionStatusValid, status.Status)
+	require.Equal(t, &blockHash, status.LatestValidHash)
+
+	received := el.Payload(blockHash)
+	require.NotNil(t, received)
+	require.Equal(t, spec.DataVersionBellatrix, received.Version)
+	require.Equal(t, payload, received.Bellatrix)
+}
+
+func TestNewPayloadV2(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, el := newTestService(ctx, t)
+
+	_, err := s.NewPayloadV2(ctx, nil)
+	require.EqualError(t, err, "no payload specified")
+
+	blockHash := phase0.Hash32{0x12}
+	payload := testCapellaPayload(blockHash)
+	status, err := s.NewPayloadV2(ctx, payload)
+	require.NoError(t, err)
+	require.Equal(t, engine.ExecutionStatusValid, status.Status)
+
+	received := el.Payload(blockHash)
+	require.NotNil(t, received)
+	require.Equal(t, spec.DataVersionCapella, received.Version)
+	require.Equal(t, payload, received.Capella)
+
+	// Payloads without withdrawals are sent with an empty list.
+	blockHash = phase0.Hash32{0x13}
+	payload = testCapellaPayload(blockHash)
+	payload.Withdrawals = nil
+	_, err = s.NewPayloadV2(ctx, payload)
+	require.NoError(t, err)
+	require.Empty(t, el.Payload(blockHash).Capella.Withdrawals)
+}
+
+func TestNewPayloadV3(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, el := newTestService(ctx, t)
+
+	_, err := s.NewPayloadV3(ctx, nil, nil, phase0.Root{})
+	require.EqualError(t, err, "no payload specified")
+
+	blockHash := phase0.Hash32{0x14}
+	payload := testDenebPayload(blockHash)
+	status, err := s.NewPayloadV3(ctx, payload, []deneb.VersionedHash{{0x01}}, phase0.Root{0x15})
+	require.NoError(t, err)
+	require.Equal(t, engine.ExecutionStatusValid, status.Status)
+
+	received := el.Payload(blockHash)
+	require.NotNil(t, received)
+	require.Equal(t, spec.DataVersionDeneb, received.Version)
+	require.Equal(t, payload, received.Deneb)
+
+	// Payloads with missing fields cannot be sent.
+	payload = testDenebPayload(blockHash)
+	payload.ExcessDataGas = nil
+	_, err = s.NewPayloadV3(ctx, payload, nil, phase0.Root{})
+	require.EqualError(t, err, "failed to submit payload: failed to marshal request: json: error calling MarshalJSON for type *engine.ExecutionPayloadV3: excess data gas missing")
+}
+
+func TestNewPayloadSyncing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, el := newTestService(ctx, t)
+	el.SetSyncing(true)
+
+	status, err := s.NewPayloadV2(ctx, testCapellaPayload(phase0.Hash32{0x16}))
+	require.NoError(t, err)
+	require.Equal(t, engine.ExecutionStatusSyncing, status.Status)
+	require.Nil(t, status.LatestValidHash)
+}
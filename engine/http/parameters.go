@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	address       string
+	timeout       time.Duration
+	extraHeaders  map[string]string
+	transport     http.RoundTripper
+	jwtSecret     []byte
+	jwtSecretFile string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithAddress provides the address for the execution client's engine API.
+func WithAddress(address string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.address = address
+	})
+}
+
+// WithTimeout sets the maximum duration for all requests to the execution client.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithExtraHeaders sets additional headers to be sent with each HTTP request.
+func WithExtraHeaders(headers map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extraHeaders = headers
+	})
+}
+
+// WithTransport sets the transport used for all HTTP requests.
+// If not supplied a standard transport is used.
+func WithTransport(transport http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// WithJWTSecret sets the secret shared with the execution client, used to
+// authenticate requests.
+func WithJWTSecret(secret []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.jwtSecret = secret
+	})
+}
+
+// WithJWTSecretFile sets the path to a file containing the hex-encoded secret
+// shared with the execution client, used to authenticate requests.
+func WithJWTSecretFile(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.jwtSecretFile = path
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		// The engine API allows execution clients up to 8 seconds to
+		// process a new payload.
+		timeout:      8 * time.Second,
+		extraHeaders: make(map[string]string),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.address == "" {
+		return nil, errors.New("no address specified")
+	}
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+	if parameters.jwtSecret == nil && parameters.jwtSecretFile == "" {
+		return nil, errors.New("no JWT secret specified")
+	}
+	if parameters.jwtSecret != nil && parameters.jwtSecretFile != "" {
+		return nil, errors.New("only one of JWT secret and JWT secret file can be specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Error represents an http error.
+type Error struct {
+	Method     string
+	StatusCode int
+	Data       []byte
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// RPCError represents an error returned by the execution client.
+type RPCError struct {
+	Method  string
+	Code    int
+	Message string
+}
+
+func (e RPCError) Error() string {
+	return fmt.Sprintf("%s failed with code %d: %s", e.Method, e.Code, e.Message)
+}
+
+// rpcRequest is a JSON-RPC request.
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// rpcResponse is a JSON-RPC response.
+type rpcResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      uint64           `json:"id"`
+	Result  json.RawMessage  `json:"result"`
+	Error   *rpcErrorMessage `json:"error"`
+}
+
+// rpcErrorMessage is the error in a JSON-RPC response.
+type rpcErrorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// call calls a JSON-RPC method, unmarshaling the result in to the supplied object.
+// Errors returned by the execution client are returned as an RPCError.
+func (s *Service) call(ctx context.Context, method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	id := s.nextRequestID()
+	body, err := json.Marshal(&rpcRequest{
+		JSONRPC: "2.0",
+		ID:      id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+
+	log := s.log.With().Uint64("id", id).Str("address", s.address).Str("method", method).Logger()
+	log.Trace().Str("body", string(body)).Msg("Request")
+
+	token, err := s.jwt()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate JWT")
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, s.base.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to create %s request", method))
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to call %s", method))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to read %s response", method))
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Str("response", string(data)).Msg("Response")
+
+	if resp.StatusCode/100 != 2 {
+		return Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Data:       bytes.TrimSpace(data),
+		}
+	}
+
+	var response rpcResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to parse %s response", method))
+	}
+	if response.ID != id {
+		return fmt.Errorf("%s response has ID %d, expected %d", method, response.ID, id)
+	}
+	if response.Error != nil {
+		return RPCError{
+			Method:  method,
+			Code:    response.Error.Code,
+			Message: response.Error.Message,
+		}
+	}
+	if result == nil {
+		return nil
+	}
+	if len(response.Result) == 0 || string(response.Result) == "null" {
+		return fmt.Errorf("%s response has no result", method)
+	}
+	if err := json.Unmarshal(response.Result, result); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to parse %s result", method))
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is an engine API client service.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	extraHeaders map[string]string
+	jwtSecret    []byte
+	// requestID is the ID of the most recent JSON-RPC request.
+	requestID uint64
+}
+
+// SupportedMethods are the engine API methods supported by this client.
+var SupportedMethods = []string{
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_getBlobsBundleV1",
+}
+
+// New creates a new engine API client service, connecting with a standard HTTP.
+func New(ctx context.Context, params ...Parameter) (engine.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "engine").Str("impl", "http").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	jwtSecret := parameters.jwtSecret
+	if parameters.jwtSecretFile != "" {
+		jwtSecret, err = readJWTSecret(parameters.jwtSecretFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read JWT secret")
+		}
+	}
+	if err := checkJWTSecret(jwtSecret); err != nil {
+		return nil, errors.Wrap(err, "invalid JWT secret")
+	}
+
+	var transport http.RoundTripper = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   parameters.timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:        64,
+		MaxConnsPerHost:     64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     600 * time.Second,
+	}
+	if parameters.transport != nil {
+		transport = parameters.transport
+	}
+	client := &http.Client{
+		Timeout:   parameters.timeout,
+		Transport: transport,
+	}
+
+	address := parameters.address
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	if !strings.HasSuffix(address, "/") {
+		address = fmt.Sprintf("%s/", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL")
+	}
+
+	s := &Service{
+		log:          log,
+		base:         base,
+		address:      parameters.address,
+		client:       client,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+		jwtSecret:    jwtSecret,
+	}
+
+	// Exchange capabilities to confirm the connection and authentication are good.
+	if _, err := s.ExchangeCapabilities(ctx, SupportedMethods); err != nil {
+		return nil, errors.Wrap(err, "failed to confirm engine connection")
+	}
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Engine (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
+
+// nextRequestID provides the ID for a new JSON-RPC request.
+func (s *Service) nextRequestID() uint64 {
+	return atomic.AddUint64(&s.requestID, 1)
+}
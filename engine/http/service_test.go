@@ -0,0 +1,261 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/holiman/uint256"
+	enginehttp "github.com/jefmcl/go-eth2-client/engine/http"
+	"github.com/jefmcl/go-eth2-client/engine/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var testJWTSecret = []byte{
+	0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+}
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	el, err := server.New(
+		server.WithLogLevel(zerolog.Disabled),
+		server.WithJWTSecret(testJWTSecret),
+	)
+	require.NoError(t, err)
+	srv := httptest.NewServer(el)
+	defer srv.Close()
+
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(secretFile, []byte("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20\n"), 0o600))
+	invalidSecretFile := filepath.Join(dir, "invalid.hex")
+	require.NoError(t, os.WriteFile(invalidSecretFile, []byte("not hex"), 0o600))
+
+	tests := []struct {
+		name       string
+		parameters []enginehttp.Parameter
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithTimeout(0),
+				enginehttp.WithJWTSecret(testJWTSecret),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "JWTSecretMissing",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+			},
+			err: "problem with parameters: no JWT secret specified",
+		},
+		{
+			name: "JWTSecretDuplicate",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecret(testJWTSecret),
+				enginehttp.WithJWTSecretFile(secretFile),
+			},
+			err: "problem with parameters: only one of JWT secret and JWT secret file can be specified",
+		},
+		{
+			name: "JWTSecretShort",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecret([]byte{0x01, 0x02}),
+			},
+			err: "invalid JWT secret: secret must be 32 bytes, is 2 bytes",
+		},
+		{
+			name: "JWTSecretFileMissing",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecretFile(filepath.Join(dir, "missing.hex")),
+			},
+			err: "failed to read JWT secret: failed to read file: open " + filepath.Join(dir, "missing.hex") + ": no such file or directory",
+		},
+		{
+			name: "JWTSecretFileInvalid",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecretFile(invalidSecretFile),
+			},
+			err: "failed to read JWT secret: invalid secret: encoding/hex: invalid byte: U+006E 'n'",
+		},
+		{
+			name: "AddressInvalid",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(string([]byte{0x01})),
+				enginehttp.WithJWTSecret(testJWTSecret),
+			},
+			err: `invalid URL: parse "http://\x01/": net/url: invalid control character in URL`,
+		},
+		{
+			name: "JWTSecretIncorrect",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecret(make([]byte, 32)),
+			},
+			err: "failed to confirm engine connection: failed to exchange capabilities: engine_exchangeCapabilities failed with status 401: signature is invalid",
+		},
+		{
+			name: "Good",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithLogLevel(zerolog.Disabled),
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithTimeout(5 * time.Second),
+				enginehttp.WithExtraHeaders(map[string]string{"User-Agent": "test"}),
+				enginehttp.WithJWTSecret(testJWTSecret),
+			},
+		},
+		{
+			name: "GoodJWTSecretFile",
+			parameters: []enginehttp.Parameter{
+				enginehttp.WithLogLevel(zerolog.Disabled),
+				enginehttp.WithAddress(srv.URL),
+				enginehttp.WithJWTSecretFile(secretFile),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := enginehttp.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Engine (HTTP)", s.Name())
+				require.Equal(t, srv.URL, s.Address())
+			}
+		})
+	}
+}
+
+// newTestService creates an engine client connected to a new fake execution client.
+func newTestService(ctx context.Context, t *testing.T) (*enginehttp.Service, *server.Server) {
+	t.Helper()
+
+	el, err := server.New(
+		server.WithLogLevel(zerolog.Disabled),
+		server.WithJWTSecret(testJWTSecret),
+	)
+	require.NoError(t, err)
+	srv := httptest.NewServer(el)
+	t.Cleanup(srv.Close)
+
+	s, err := enginehttp.New(ctx,
+		enginehttp.WithLogLevel(zerolog.Disabled),
+		enginehttp.WithAddress(srv.URL),
+		enginehttp.WithTimeout(5*time.Second),
+		enginehttp.WithJWTSecret(testJWTSecret),
+	)
+	require.NoError(t, err)
+
+	return s.(*enginehttp.Service), el
+}
+
+// testBellatrixPayload creates a Bellatrix payload with all fields populated.
+func testBellatrixPayload(blockHash phase0.Hash32) *bellatrix.ExecutionPayload {
+	return &bellatrix.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+		StateRoot:     [32]byte{0x03},
+		ReceiptsRoot:  [32]byte{0x04},
+		LogsBloom:     [256]byte{0x05},
+		PrevRandao:    [32]byte{0x06},
+		BlockNumber:   1000,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1680000000,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: [32]byte{0x07, 0xca, 0x9a, 0x3b},
+		BlockHash:     blockHash,
+		Transactions:  []bellatrix.Transaction{{0x02, 0xf8, 0x72}, {0xf8, 0x6c}},
+	}
+}
+
+// testCapellaPayload creates a Capella payload with all fields populated.
+func testCapellaPayload(blockHash phase0.Hash32) *capella.ExecutionPayload {
+	payload := testBellatrixPayload(blockHash)
+
+	return &capella.ExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: payload.BaseFeePerGas,
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals: []*capella.Withdrawal{
+			{
+				Index:          5,
+				ValidatorIndex: 6,
+				Address:        bellatrix.ExecutionAddress{0x08},
+				Amount:         32000000000,
+			},
+		},
+	}
+}
+
+// testDenebPayload creates a Deneb payload with all fields populated.
+func testDenebPayload(blockHash phase0.Hash32) *deneb.ExecutionPayload {
+	payload := testCapellaPayload(blockHash)
+
+	return &deneb.ExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: uint256.NewInt(1000000007),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals:   payload.Withdrawals,
+		ExcessDataGas: uint256.NewInt(131072),
+	}
+}
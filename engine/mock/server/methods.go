@@ -0,0 +1,397 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/holiman/uint256"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// supportedMethods are the methods returned when exchanging capabilities.
+var supportedMethods = []string{
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_getBlobsBundleV1",
+}
+
+func (s *Server) exchangeCapabilities(params []json.RawMessage) (interface{}, *rpcError) {
+	if len(params) != 1 {
+		return nil, invalidParams(errors.New("expected 1 parameter"))
+	}
+	var capabilities []string
+	if err := json.Unmarshal(params[0], &capabilities); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	return supportedMethods, nil
+}
+
+func (s *Server) newPayloadV1(params []json.RawMessage) (interface{}, *rpcError) {
+	if len(params) != 1 {
+		return nil, invalidParams(errors.New("expected 1 parameter"))
+	}
+	var payload engine.ExecutionPayloadV1
+	if err := json.Unmarshal(params[0], &payload); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	return s.storePayload(payload.BlockHash, &Payload{
+		Version:   spec.DataVersionBellatrix,
+		Bellatrix: (*bellatrix.ExecutionPayload)(&payload),
+	}), nil
+}
+
+func (s *Server) newPayloadV2(params []json.RawMessage) (interface{}, *rpcError) {
+	if len(params) != 1 {
+		return nil, invalidParams(errors.New("expected 1 parameter"))
+	}
+	var payload engine.ExecutionPayloadV2
+	if err := json.Unmarshal(params[0], &payload); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	return s.storePayload(payload.BlockHash, &Payload{
+		Version: spec.DataVersionCapella,
+		Capella: (*capella.ExecutionPayload)(&payload),
+	}), nil
+}
+
+func (s *Server) newPayloadV3(params []json.RawMessage) (interface{}, *rpcError) {
+	if len(params) != 3 {
+		return nil, invalidParams(errors.New("expected 3 parameters"))
+	}
+	var payload engine.ExecutionPayloadV3
+	if err := json.Unmarshal(params[0], &payload); err != nil {
+		return nil, invalidParams(err)
+	}
+	var versionedHashes []string
+	if err := json.Unmarshal(params[1], &versionedHashes); err != nil {
+		return nil, invalidParams(err)
+	}
+	if versionedHashes == nil {
+		return nil, invalidParams(errors.New("versioned hashes missing"))
+	}
+	var parentBeaconBlockRoot string
+	if err := json.Unmarshal(params[2], &parentBeaconBlockRoot); err != nil {
+		return nil, invalidParams(err)
+	}
+	if parentBeaconBlockRoot == "" {
+		return nil, invalidParams(errors.New("parent beacon block root missing"))
+	}
+
+	return s.storePayload(payload.BlockHash, &Payload{
+		Version: spec.DataVersionDeneb,
+		Deneb:   (*deneb.ExecutionPayload)(&payload),
+	}), nil
+}
+
+// storePayload stores a submitted payload, returning its status.
+func (s *Server) storePayload(blockHash phase0.Hash32, payload *Payload) *engine.PayloadStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.payloads[blockHash] = payload
+	if s.syncing {
+		return &engine.PayloadStatus{
+			Status: engine.ExecutionStatusSyncing,
+		}
+	}
+
+	return &engine.PayloadStatus{
+		Status:          engine.ExecutionStatusValid,
+		LatestValidHash: &blockHash,
+	}
+}
+
+func (s *Server) forkchoiceUpdatedV1(params []json.RawMessage) (interface{}, *rpcError) {
+	return s.forkchoiceUpdated(spec.DataVersionBellatrix, params)
+}
+
+func (s *Server) forkchoiceUpdatedV2(params []json.RawMessage) (interface{}, *rpcError) {
+	return s.forkchoiceUpdated(spec.DataVersionCapella, params)
+}
+
+func (s *Server) forkchoiceUpdatedV3(params []json.RawMessage) (interface{}, *rpcError) {
+	return s.forkchoiceUpdated(spec.DataVersionDeneb, params)
+}
+
+// forkchoiceUpdated updates the forkchoice state and, if payload attributes
+// are supplied, builds a payload of the given version on top of the new head.
+func (s *Server) forkchoiceUpdated(version spec.DataVersion, params []json.RawMessage) (interface{}, *rpcError) {
+	if len(params) != 2 {
+		return nil, invalidParams(errors.New("expected 2 parameters"))
+	}
+	var state engine.ForkchoiceState
+	if err := json.Unmarshal(params[0], &state); err != nil {
+		return nil, invalidParams(err)
+	}
+	var attributes *engine.PayloadAttributes
+	if err := json.Unmarshal(params[1], &attributes); err != nil {
+		return nil, invalidParams(err)
+	}
+	if attributes != nil {
+		if err := checkPayloadAttributes(version, attributes); err != nil {
+			return nil, &rpcError{Code: errCodeInvalidPayloadAttributes, Message: err.Error()}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	head, exists := s.payloads[state.HeadBlockHash]
+	if s.syncing || !exists {
+		return &engine.ForkchoiceUpdatedResult{
+			PayloadStatus: &engine.PayloadStatus{
+				Status: engine.ExecutionStatusSyncing,
+			},
+		}, nil
+	}
+	s.forkchoiceState = &state
+
+	res := &engine.ForkchoiceUpdatedResult{
+		PayloadStatus: &engine.PayloadStatus{
+			Status:          engine.ExecutionStatusValid,
+			LatestValidHash: &state.HeadBlockHash,
+		},
+	}
+	if attributes != nil {
+		s.nextPayloadID++
+		payloadID := engine.PayloadID{}
+		binary.BigEndian.PutUint64(payloadID[:], s.nextPayloadID)
+		s.builds[payloadID] = buildPayload(version, state.HeadBlockHash, head, attributes)
+		res.PayloadID = &payloadID
+	}
+
+	return res, nil
+}
+
+// checkPayloadAttributes checks that the payload attributes are correct for the version.
+func checkPayloadAttributes(version spec.DataVersion, attributes *engine.PayloadAttributes) error {
+	switch version {
+	case spec.DataVersionBellatrix:
+		if attributes.Withdrawals != nil {
+			return errors.New("withdrawals not allowed")
+		}
+	case spec.DataVersionCapella:
+		if attributes.Withdrawals == nil {
+			return errors.New("withdrawals missing")
+		}
+	case spec.DataVersionDeneb:
+		if attributes.Withdrawals == nil {
+			return errors.New("withdrawals missing")
+		}
+		if attributes.ParentBeaconBlockRoot == nil {
+			return errors.New("parent beacon block root missing")
+		}
+	}
+	if version != spec.DataVersionDeneb && attributes.ParentBeaconBlockRoot != nil {
+		return errors.New("parent beacon block root not allowed")
+	}
+
+	return nil
+}
+
+// buildPayload builds an empty payload on top of the head.
+func buildPayload(version spec.DataVersion,
+	parentHash phase0.Hash32,
+	head *Payload,
+	attributes *engine.PayloadAttributes,
+) *Payload {
+	var blockNumber uint64
+	var gasLimit uint64
+	var baseFeePerGas *uint256.Int
+	switch head.Version {
+	case spec.DataVersionBellatrix:
+		blockNumber = head.Bellatrix.BlockNumber
+		gasLimit = head.Bellatrix.GasLimit
+		baseFeePerGas = uint256FromLE(head.Bellatrix.BaseFeePerGas)
+	case spec.DataVersionCapella:
+		blockNumber = head.Capella.BlockNumber
+		gasLimit = head.Capella.GasLimit
+		baseFeePerGas = uint256FromLE(head.Capella.BaseFeePerGas)
+	default:
+		blockNumber = head.Deneb.BlockNumber
+		gasLimit = head.Deneb.GasLimit
+		baseFeePerGas = head.Deneb.BaseFeePerGas
+	}
+
+	// The block hash is not a real hash of the payload, but is unique to it.
+	blockHash := phase0.Hash32(sha256.Sum256([]byte(fmt.Sprintf("%#x:%d:%s", parentHash, attributes.Timestamp, version))))
+	extraData := []byte("mock")
+
+	res := &Payload{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{
+			ParentHash:    parentHash,
+			FeeRecipient:  attributes.SuggestedFeeRecipient,
+			PrevRandao:    attributes.PrevRandao,
+			BlockNumber:   blockNumber + 1,
+			GasLimit:      gasLimit,
+			Timestamp:     attributes.Timestamp,
+			ExtraData:     extraData,
+			BaseFeePerGas: uint256ToLE(baseFeePerGas),
+			BlockHash:     blockHash,
+			Transactions:  []bellatrix.Transaction{},
+		}
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{
+			ParentHash:    parentHash,
+			FeeRecipient:  attributes.SuggestedFeeRecipient,
+			PrevRandao:    attributes.PrevRandao,
+			BlockNumber:   blockNumber + 1,
+			GasLimit:      gasLimit,
+			Timestamp:     attributes.Timestamp,
+			ExtraData:     extraData,
+			BaseFeePerGas: uint256ToLE(baseFeePerGas),
+			BlockHash:     blockHash,
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   attributes.Withdrawals,
+		}
+	default:
+		res.Deneb = &deneb.ExecutionPayload{
+			ParentHash:    parentHash,
+			FeeRecipient:  attributes.SuggestedFeeRecipient,
+			PrevRandao:    attributes.PrevRandao,
+			BlockNumber:   blockNumber + 1,
+			GasLimit:      gasLimit,
+			Timestamp:     attributes.Timestamp,
+			ExtraData:     extraData,
+			BaseFeePerGas: baseFeePerGas,
+			BlockHash:     blockHash,
+			Transactions:  []bellatrix.Transaction{},
+			Withdrawals:   attributes.Withdrawals,
+			ExcessDataGas: uint256.NewInt(0),
+		}
+	}
+
+	return res
+}
+
+func (s *Server) getPayloadV1(params []json.RawMessage) (interface{}, *rpcError) {
+	payload, rpcErr := s.build(spec.DataVersionBellatrix, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return (*engine.ExecutionPayloadV1)(payload.Bellatrix), nil
+}
+
+func (s *Server) getPayloadV2(params []json.RawMessage) (interface{}, *rpcError) {
+	payload, rpcErr := s.build(spec.DataVersionCapella, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return &engine.GetPayloadV2Response{
+		ExecutionPayload: payload.Capella,
+		BlockValue:       uint256.NewInt(0),
+	}, nil
+}
+
+func (s *Server) getPayloadV3(params []json.RawMessage) (interface{}, *rpcError) {
+	payload, rpcErr := s.build(spec.DataVersionDeneb, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return &engine.GetPayloadV3Response{
+		ExecutionPayload: payload.Deneb,
+		BlockValue:       uint256.NewInt(0),
+		BlobsBundle: &apiv1deneb.BlobsBundle{
+			Commitments: []deneb.KzgCommitment{},
+			Proofs:      []deneb.KzgProof{},
+			Blobs:       []deneb.Blob{},
+		},
+	}, nil
+}
+
+func (s *Server) getBlobsBundleV1(params []json.RawMessage) (interface{}, *rpcError) {
+	payload, rpcErr := s.build(spec.DataVersionDeneb, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return &engine.BlobsBundleV1{
+		BlockHash: payload.Deneb.BlockHash,
+		KZGs:      []deneb.KzgCommitment{},
+		Blobs:     []deneb.Blob{},
+	}, nil
+}
+
+// build obtains the payload built for the ID in the parameters, ensuring
+// that it is of the requested version.
+func (s *Server) build(version spec.DataVersion, params []json.RawMessage) (*Payload, *rpcError) {
+	if len(params) != 1 {
+		return nil, invalidParams(errors.New("expected 1 parameter"))
+	}
+	var payloadID engine.PayloadID
+	if err := json.Unmarshal(params[0], &payloadID); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	s.mu.RLock()
+	payload, exists := s.builds[payloadID]
+	s.mu.RUnlock()
+	if !exists {
+		return nil, &rpcError{Code: errCodeUnknownPayload, Message: "Unknown payload"}
+	}
+	if payload.Version != version {
+		return nil, &rpcError{Code: errCodeUnsupportedFork, Message: "Unsupported fork"}
+	}
+
+	return payload, nil
+}
+
+// uint256FromLE converts a little-endian 32-byte value to an integer.
+func uint256FromLE(input [32]byte) *uint256.Int {
+	var be [32]byte
+	for i := 0; i < 32; i++ {
+		be[i] = input[32-1-i]
+	}
+
+	return new(uint256.Int).SetBytes(be[:])
+}
+
+// uint256ToLE converts an integer to a little-endian 32-byte value.
+func uint256ToLE(input *uint256.Int) [32]byte {
+	be := input.Bytes32()
+	var res [32]byte
+	for i := 0; i < 32; i++ {
+		res[i] = be[32-1-i]
+	}
+
+	return res
+}
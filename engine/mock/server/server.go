@@ -0,0 +1,283 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server provides a fake execution client that serves the engine API
+// over HTTP, backed by in-memory state.  Payloads submitted to it are stored,
+// and payloads built on request contain no transactions.  It implements
+// http.Handler so can be used directly with httptest.NewServer().
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Error codes defined by JSON-RPC and the engine API.
+const (
+	errCodeParseError               = -32700
+	errCodeMethodNotFound           = -32601
+	errCodeInvalidParams            = -32602
+	errCodeUnknownPayload           = -38001
+	errCodeInvalidPayloadAttributes = -38003
+	errCodeUnsupportedFork          = -38005
+)
+
+// maxTokenAge is the maximum difference between the issued-at time of a token
+// and the current time.
+const maxTokenAge = 60 * time.Second
+
+// Payload is an execution payload known to the server.
+type Payload struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *deneb.ExecutionPayload
+}
+
+// Server is a fake engine API HTTP server.
+type Server struct {
+	log       zerolog.Logger
+	jwtSecret []byte
+	methods   map[string]methodFunc
+
+	mu              sync.RWMutex
+	syncing         bool
+	forkchoiceState *engine.ForkchoiceState
+	// payloads are the payloads submitted to the server, keyed by block hash.
+	payloads map[phase0.Hash32]*Payload
+	// builds are the payloads built by the server, keyed by payload ID.
+	builds        map[engine.PayloadID]*Payload
+	nextPayloadID uint64
+}
+
+// methodFunc is the function that handles a JSON-RPC method.
+type methodFunc func(params []json.RawMessage) (interface{}, *rpcError)
+
+// New creates a new fake engine server.
+func New(params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "engine").Str("impl", "mock").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Server{
+		log:       log,
+		jwtSecret: parameters.jwtSecret,
+		payloads:  make(map[phase0.Hash32]*Payload),
+		builds:    make(map[engine.PayloadID]*Payload),
+	}
+	s.methods = map[string]methodFunc{
+		"engine_exchangeCapabilities": s.exchangeCapabilities,
+		"engine_newPayloadV1":         s.newPayloadV1,
+		"engine_newPayloadV2":         s.newPayloadV2,
+		"engine_newPayloadV3":         s.newPayloadV3,
+		"engine_forkchoiceUpdatedV1":  s.forkchoiceUpdatedV1,
+		"engine_forkchoiceUpdatedV2":  s.forkchoiceUpdatedV2,
+		"engine_forkchoiceUpdatedV3":  s.forkchoiceUpdatedV3,
+		"engine_getPayloadV1":         s.getPayloadV1,
+		"engine_getPayloadV2":         s.getPayloadV2,
+		"engine_getPayloadV3":         s.getPayloadV3,
+		"engine_getBlobsBundleV1":     s.getBlobsBundleV1,
+	}
+
+	return s, nil
+}
+
+// SetSyncing sets whether the server reports that it is syncing, in which
+// case payloads are not validated and no payloads are built.
+func (s *Server) SetSyncing(syncing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.syncing = syncing
+}
+
+// Payload provides the payload with the given block hash, if it has been
+// submitted to the server.
+func (s *Server) Payload(blockHash phase0.Hash32) *Payload {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.payloads[blockHash]
+}
+
+// ForkchoiceState provides the most recent forkchoice state supplied to the
+// server, if any.
+func (s *Server) ForkchoiceState() *engine.ForkchoiceState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.forkchoiceState
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := s.log.With().Str("method", r.Method).Str("path", r.URL.Path).Logger()
+	log.Trace().Msg("Request received")
+
+	if r.URL.Path != "/" {
+		http.Error(w, "endpoint not found", http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.checkJWT(r.Header.Get("Authorization")); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	var request rpcRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeResponse(w, &rpcResponse{
+			JSONRPC: "2.0",
+			Error:   &rpcError{Code: errCodeParseError, Message: err.Error()},
+		})
+		return
+	}
+
+	response := &rpcResponse{
+		JSONRPC: "2.0",
+		ID:      request.ID,
+	}
+	method, exists := s.methods[request.Method]
+	if !exists {
+		response.Error = &rpcError{Code: errCodeMethodNotFound, Message: fmt.Sprintf("the method %s does not exist/is not available", request.Method)}
+	} else {
+		response.Result, response.Error = method(request.Params)
+	}
+	writeResponse(w, response)
+}
+
+// checkJWT checks that the authorization header contains a token signed with
+// the server's secret, issued within the permitted time.
+func (s *Server) checkJWT(header string) error {
+	if !strings.HasPrefix(header, "Bearer ") {
+		return errors.New("missing token")
+	}
+	parts := strings.Split(strings.TrimPrefix(header, "Bearer "), ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	headerData, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return errors.New("malformed token header")
+	}
+	var tokenHeader struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(headerData, &tokenHeader); err != nil {
+		return errors.New("malformed token header")
+	}
+	if tokenHeader.Alg != "HS256" {
+		return errors.New("unsupported signing algorithm")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.New("malformed token signature")
+	}
+	mac := hmac.New(sha256.New, s.jwtSecret)
+	_, _ = mac.Write([]byte(fmt.Sprintf("%s.%s", parts[0], parts[1])))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("signature is invalid")
+	}
+
+	claimsData, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errors.New("malformed token claims")
+	}
+	var claims struct {
+		IssuedAt *int64 `json:"iat"`
+	}
+	if err := json.Unmarshal(claimsData, &claims); err != nil {
+		return errors.New("malformed token claims")
+	}
+	if claims.IssuedAt == nil {
+		return errors.New("missing issued-at")
+	}
+	age := time.Since(time.Unix(*claims.IssuedAt, 0))
+	if age > maxTokenAge || age < -maxTokenAge {
+		return errors.New("stale token")
+	}
+
+	return nil
+}
+
+// rpcRequest is a JSON-RPC request.
+type rpcRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      uint64            `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// rpcResponse is a JSON-RPC response.
+type rpcResponse struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      uint64      `json:"id"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *rpcError   `json:"error,omitempty"`
+}
+
+// rpcError is the error in a JSON-RPC response.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// invalidParams returns an invalid parameters error.
+func invalidParams(err error) *rpcError {
+	return &rpcError{Code: errCodeInvalidParams, Message: err.Error()}
+}
+
+func writeResponse(w http.ResponseWriter, response *rpcResponse) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		body = []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"error":{"code":-32603,"message":%q}}`, response.ID, err.Error()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// PayloadAttributes are the attributes of a payload to be built by an execution client.
+type PayloadAttributes struct {
+	Timestamp             uint64
+	PrevRandao            [32]byte
+	SuggestedFeeRecipient bellatrix.ExecutionAddress
+	// Withdrawals are the withdrawals to include in the payload.
+	// Required from version 2.
+	Withdrawals []*capella.Withdrawal
+	// ParentBeaconBlockRoot is the root of the beacon block containing the payload's parent.
+	// Required from version 3.
+	ParentBeaconBlockRoot *phase0.Root
+}
+
+// payloadAttributesJSON is the engine API representation of the struct.
+type payloadAttributesJSON struct {
+	Timestamp             string             `json:"timestamp"`
+	PrevRandao            string             `json:"prevRandao"`
+	SuggestedFeeRecipient string             `json:"suggestedFeeRecipient"`
+	Withdrawals           *[]*withdrawalJSON `json:"withdrawals,omitempty"`
+	ParentBeaconBlockRoot string             `json:"parentBeaconBlockRoot,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttributes) MarshalJSON() ([]byte, error) {
+	data := &payloadAttributesJSON{
+		Timestamp:             encodeQuantity(p.Timestamp),
+		PrevRandao:            encodeData(p.PrevRandao[:]),
+		SuggestedFeeRecipient: encodeData(p.SuggestedFeeRecipient[:]),
+	}
+	if p.Withdrawals != nil {
+		withdrawals := make([]*withdrawalJSON, len(p.Withdrawals))
+		for i := range p.Withdrawals {
+			withdrawals[i] = packWithdrawal(p.Withdrawals[i])
+		}
+		data.Withdrawals = &withdrawals
+	}
+	if p.ParentBeaconBlockRoot != nil {
+		data.ParentBeaconBlockRoot = encodeData(p.ParentBeaconBlockRoot[:])
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttributes) UnmarshalJSON(input []byte) error {
+	var data payloadAttributesJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	var err error
+	if p.Timestamp, err = decodeQuantity("timestamp", data.Timestamp); err != nil {
+		return err
+	}
+	if err := decodeFixedData("prev randao", data.PrevRandao, p.PrevRandao[:]); err != nil {
+		return err
+	}
+	if err := decodeFixedData("suggested fee recipient", data.SuggestedFeeRecipient, p.SuggestedFeeRecipient[:]); err != nil {
+		return err
+	}
+
+	p.Withdrawals = nil
+	if data.Withdrawals != nil {
+		p.Withdrawals = make([]*capella.Withdrawal, len(*data.Withdrawals))
+		for i, withdrawal := range *data.Withdrawals {
+			if withdrawal == nil {
+				return errors.New("withdrawal missing")
+			}
+			if p.Withdrawals[i], err = unpackWithdrawal(withdrawal); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.ParentBeaconBlockRoot = nil
+	if data.ParentBeaconBlockRoot != "" {
+		parentBeaconBlockRoot := phase0.Root{}
+		if err := decodeFixedData("parent beacon block root", data.ParentBeaconBlockRoot, parentBeaconBlockRoot[:]); err != nil {
+			return err
+		}
+		p.ParentBeaconBlockRoot = &parentBeaconBlockRoot
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PayloadAttributes) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
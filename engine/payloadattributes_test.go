@@ -0,0 +1,118 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestPayloadAttributesJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.payloadAttributesJSON",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253"}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampInvalid",
+			input: []byte(`{"timestamp":"0xg","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253"}`),
+			err:   "invalid value for timestamp: strconv.ParseUint: parsing \"g\": invalid syntax",
+		},
+		{
+			name:  "PrevRandaoMissing",
+			input: []byte(`{"timestamp":"0x6422bb3f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253"}`),
+			err:   "prev randao missing",
+		},
+		{
+			name:  "PrevRandaoShort",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x0102","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253"}`),
+			err:   "incorrect length for prev randao",
+		},
+		{
+			name:  "SuggestedFeeRecipientMissing",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+			err:   "suggested fee recipient missing",
+		},
+		{
+			name:  "SuggestedFeeRecipientShort",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x0102"}`),
+			err:   "incorrect length for suggested fee recipient",
+		},
+		{
+			name:  "WithdrawalNil",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[null]}`),
+			err:   "withdrawal missing",
+		},
+		{
+			name:  "WithdrawalAmountInvalid",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x404142434445464748494a4b4c4d4e4f50515253","amount":"1"}]}`),
+			err:   "invalid value for withdrawal amount: missing 0x prefix",
+		},
+		{
+			name:  "ParentBeaconBlockRootShort",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[],"parentBeaconBlockRoot":"0x0102"}`),
+			err:   "incorrect length for parent beacon block root",
+		},
+		{
+			name:  "GoodV1",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253"}`),
+		},
+		{
+			name:  "GoodV2",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x404142434445464748494a4b4c4d4e4f50515253","amount":"0x2fb1a1"}]}`),
+		},
+		{
+			name:  "GoodV2NoWithdrawals",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[]}`),
+		},
+		{
+			name:  "GoodV3",
+			input: []byte(`{"timestamp":"0x6422bb3f","prevRandao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","suggestedFeeRecipient":"0x404142434445464748494a4b4c4d4e4f50515253","withdrawals":[{"index":"0xa1b2","validatorIndex":"0x3039","address":"0x404142434445464748494a4b4c4d4e4f50515253","amount":"0x2fb1a1"}],"parentBeaconBlockRoot":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.PayloadAttributes
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// PayloadID is the identifier of a payload being built by an execution client.
+type PayloadID [8]byte
+
+// String returns a string version of the structure.
+func (p PayloadID) String() string {
+	return encodeData(p[:])
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PayloadID) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", p.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadID) UnmarshalJSON(input []byte) error {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return decodeFixedData("payload ID", data, p[:])
+}
@@ -0,0 +1,148 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ExecutionStatus is the status of an execution payload as seen by an execution client.
+type ExecutionStatus int
+
+const (
+	// ExecutionStatusValid means the payload is valid.
+	ExecutionStatusValid ExecutionStatus = iota
+	// ExecutionStatusInvalid means the payload, or one of its ancestors, is invalid.
+	ExecutionStatusInvalid
+	// ExecutionStatusSyncing means the execution client is syncing and cannot validate the payload.
+	ExecutionStatusSyncing
+	// ExecutionStatusAccepted means the payload has been accepted but not validated.
+	ExecutionStatusAccepted
+	// ExecutionStatusInvalidBlockHash means the block hash of the payload is incorrect.
+	ExecutionStatusInvalidBlockHash
+)
+
+var executionStatusStrings = [...]string{
+	"VALID",
+	"INVALID",
+	"SYNCING",
+	"ACCEPTED",
+	"INVALID_BLOCK_HASH",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionStatus) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", executionStatusStrings[*e])), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionStatus) UnmarshalJSON(input []byte) error {
+	var err error
+	switch string(input) {
+	case `"VALID"`:
+		*e = ExecutionStatusValid
+	case `"INVALID"`:
+		*e = ExecutionStatusInvalid
+	case `"SYNCING"`:
+		*e = ExecutionStatusSyncing
+	case `"ACCEPTED"`:
+		*e = ExecutionStatusAccepted
+	case `"INVALID_BLOCK_HASH"`:
+		*e = ExecutionStatusInvalidBlockHash
+	default:
+		err = fmt.Errorf("unrecognised execution status %s", string(input))
+	}
+	return err
+}
+
+func (e ExecutionStatus) String() string {
+	return executionStatusStrings[e]
+}
+
+// PayloadStatus is the result of submitting a payload, or updating the
+// forkchoice, of an execution client.
+type PayloadStatus struct {
+	// Status is the status of the payload.
+	Status ExecutionStatus
+	// LatestValidHash is the hash of the most recent valid block in the
+	// branch of the payload, if known.
+	LatestValidHash *phase0.Hash32
+	// ValidationError is the reason the payload is invalid, if supplied.
+	ValidationError string
+}
+
+// payloadStatusJSON is the engine API representation of the struct.
+type payloadStatusJSON struct {
+	Status          *ExecutionStatus `json:"status"`
+	LatestValidHash *string          `json:"latestValidHash"`
+	ValidationError *string          `json:"validationError"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadStatus) MarshalJSON() ([]byte, error) {
+	data := &payloadStatusJSON{
+		Status: &p.Status,
+	}
+	if p.LatestValidHash != nil {
+		latestValidHash := encodeData(p.LatestValidHash[:])
+		data.LatestValidHash = &latestValidHash
+	}
+	if p.ValidationError != "" {
+		data.ValidationError = &p.ValidationError
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadStatus) UnmarshalJSON(input []byte) error {
+	var data payloadStatusJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Status == nil {
+		return errors.New("status missing")
+	}
+	p.Status = *data.Status
+
+	p.LatestValidHash = nil
+	if data.LatestValidHash != nil {
+		latestValidHash := phase0.Hash32{}
+		if err := decodeFixedData("latest valid hash", *data.LatestValidHash, latestValidHash[:]); err != nil {
+			return err
+		}
+		p.LatestValidHash = &latestValidHash
+	}
+
+	p.ValidationError = ""
+	if data.ValidationError != nil {
+		p.ValidationError = *data.ValidationError
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PayloadStatus) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/engine"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestPayloadStatusJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type engine.payloadStatusJSON",
+		},
+		{
+			name:  "StatusMissing",
+			input: []byte(`{"latestValidHash":null,"validationError":null}`),
+			err:   "status missing",
+		},
+		{
+			name:  "StatusInvalid",
+			input: []byte(`{"status":"UNKNOWN","latestValidHash":null,"validationError":null}`),
+			err:   "invalid JSON: unrecognised execution status \"UNKNOWN\"",
+		},
+		{
+			name:  "LatestValidHashInvalid",
+			input: []byte(`{"status":"VALID","latestValidHash":"0xzz","validationError":null}`),
+			err:   "invalid value for latest valid hash: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "LatestValidHashShort",
+			input: []byte(`{"status":"VALID","latestValidHash":"0x0102","validationError":null}`),
+			err:   "incorrect length for latest valid hash",
+		},
+		{
+			name:  "GoodValid",
+			input: []byte(`{"status":"VALID","latestValidHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","validationError":null}`),
+		},
+		{
+			name:  "GoodInvalid",
+			input: []byte(`{"status":"INVALID","latestValidHash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","validationError":"invalid state root"}`),
+		},
+		{
+			name:  "GoodSyncing",
+			input: []byte(`{"status":"SYNCING","latestValidHash":null,"validationError":null}`),
+		},
+		{
+			name:  "GoodAccepted",
+			input: []byte(`{"status":"ACCEPTED","latestValidHash":null,"validationError":null}`),
+		},
+		{
+			name:  "GoodInvalidBlockHash",
+			input: []byte(`{"status":"INVALID_BLOCK_HASH","latestValidHash":null,"validationError":"block hash mismatch"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res engine.PayloadStatus
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine provides interfaces for talking to an execution client using
+// the engine API.
+//
+// Execution payloads are exchanged as the Bellatrix, Capella and Deneb types
+// from the spec package, and converted to and from the engine API's JSON
+// representation by ExecutionPayloadV1, ExecutionPayloadV2 and
+// ExecutionPayloadV3 respectively.
+package engine
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// Service is the service providing a connection to an execution client.
+type Service interface {
+	// Name returns the name of the engine implementation.
+	Name() string
+
+	// Address returns the address of the execution client.
+	Address() string
+}
+
+// CapabilitiesExchanger is the interface for exchanging capabilities with an execution client.
+type CapabilitiesExchanger interface {
+	// ExchangeCapabilities sends the engine API methods supported by the caller,
+	// and returns those supported by the execution client.
+	ExchangeCapabilities(ctx context.Context, capabilities []string) ([]string, error)
+}
+
+// NewPayloadSubmitter is the interface for submitting execution payloads to an execution client.
+type NewPayloadSubmitter interface {
+	// NewPayloadV1 submits a Bellatrix execution payload.
+	NewPayloadV1(ctx context.Context, payload *bellatrix.ExecutionPayload) (*PayloadStatus, error)
+
+	// NewPayloadV2 submits a Capella execution payload.
+	NewPayloadV2(ctx context.Context, payload *capella.ExecutionPayload) (*PayloadStatus, error)
+
+	// NewPayloadV3 submits a Deneb execution payload, along with the versioned
+	// hashes of the blobs it is expected to contain and the root of its parent
+	// beacon block.
+	NewPayloadV3(ctx context.Context,
+		payload *deneb.ExecutionPayload,
+		versionedHashes []deneb.VersionedHash,
+		parentBeaconBlockRoot phase0.Root,
+	) (
+		*PayloadStatus,
+		error,
+	)
+}
+
+// ForkchoiceUpdater is the interface for updating the forkchoice of an execution client.
+type ForkchoiceUpdater interface {
+	// ForkchoiceUpdatedV1 updates the forkchoice state and, if attributes are
+	// supplied, starts building a Bellatrix payload.
+	ForkchoiceUpdatedV1(ctx context.Context, state *ForkchoiceState, attributes *PayloadAttributes) (*ForkchoiceUpdatedResult, error)
+
+	// ForkchoiceUpdatedV2 updates the forkchoice state and, if attributes are
+	// supplied, starts building a Capella payload.
+	ForkchoiceUpdatedV2(ctx context.Context, state *ForkchoiceState, attributes *PayloadAttributes) (*ForkchoiceUpdatedResult, error)
+
+	// ForkchoiceUpdatedV3 updates the forkchoice state and, if attributes are
+	// supplied, starts building a Deneb payload.
+	ForkchoiceUpdatedV3(ctx context.Context, state *ForkchoiceState, attributes *PayloadAttributes) (*ForkchoiceUpdatedResult, error)
+}
+
+// PayloadProvider is the interface for obtaining execution payloads built by an execution client.
+type PayloadProvider interface {
+	// GetPayloadV1 obtains the Bellatrix payload being built for the given ID.
+	GetPayloadV1(ctx context.Context, id PayloadID) (*bellatrix.ExecutionPayload, error)
+
+	// GetPayloadV2 obtains the Capella payload being built for the given ID,
+	// along with its value.
+	GetPayloadV2(ctx context.Context, id PayloadID) (*GetPayloadV2Response, error)
+
+	// GetPayloadV3 obtains the Deneb payload being built for the given ID,
+	// along with its value and blobs.
+	GetPayloadV3(ctx context.Context, id PayloadID) (*GetPayloadV3Response, error)
+}
+
+// BlobsBundleProvider is the interface for obtaining the blobs for execution payloads.
+type BlobsBundleProvider interface {
+	// GetBlobsBundleV1 obtains the blobs for the payload being built for the given ID.
+	GetBlobsBundleV1(ctx context.Context, id PayloadID) (*BlobsBundleV1, error)
+}
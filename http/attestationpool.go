@@ -17,7 +17,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/jefmcl/go-eth2-client/api"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -28,7 +30,30 @@ type attestationPoolJSON struct {
 
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
+	return s.AttestationPoolWithOpts(ctx, &api.AttestationPoolOpts{
+		Slot: &slot,
+	})
+}
+
+// AttestationPoolWithOpts obtains the attestations in the pool matching the given options.
+func (s *Service) AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	filters := make([]string, 0, 2)
+	if opts.Slot != nil {
+		filters = append(filters, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.CommitteeIndex != nil {
+		filters = append(filters, fmt.Sprintf("committee_index=%d", *opts.CommitteeIndex))
+	}
+	url := "/eth/v1/beacon/pool/attestations"
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
+	}
+
+	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation pool")
 	}
@@ -46,9 +71,12 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 		return nil, errors.New("attestation pool not returned")
 	}
 	for i := range attestationPoolJSON.Data {
-		if attestationPoolJSON.Data[i].Data.Slot != slot {
+		if opts.Slot != nil && attestationPoolJSON.Data[i].Data.Slot != *opts.Slot {
 			return nil, errors.New("attestation pool entry not for requested slot")
 		}
+		if opts.CommitteeIndex != nil && attestationPoolJSON.Data[i].Data.Index != *opts.CommitteeIndex {
+			return nil, errors.New("attestation pool entry not for requested committee index")
+		}
 	}
 
 	return attestationPoolJSON.Data, nil
@@ -15,17 +15,23 @@ package http_test
 
 import (
 	"context"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
 	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAttestationPool(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -65,3 +71,81 @@ func TestAttestationPool(t *testing.T) {
 		})
 	}
 }
+
+func TestAttestationPoolWithOpts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	attestations := make([]*phase0.Attestation, 0)
+	for index := phase0.CommitteeIndex(0); index < 3; index++ {
+		attestations = append(attestations, &phase0.Attestation{
+			AggregationBits: []byte{0x03},
+			Data: &phase0.AttestationData{
+				Slot:            5,
+				Index:           index,
+				BeaconBlockRoot: phase0.Root{0x01},
+				Source:          &phase0.Checkpoint{},
+				Target:          &phase0.Checkpoint{},
+			},
+		})
+	}
+	srv.Chain().AddAttestations(attestations)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	slot := phase0.Slot(5)
+	committeeIndex := phase0.CommitteeIndex(1)
+	missingCommitteeIndex := phase0.CommitteeIndex(7)
+
+	tests := []struct {
+		name     string
+		opts     *api.AttestationPoolOpts
+		err      string
+		expected int
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name:     "Slot",
+			opts:     &api.AttestationPoolOpts{Slot: &slot},
+			expected: 3,
+		},
+		{
+			name:     "CommitteeIndex",
+			opts:     &api.AttestationPoolOpts{Slot: &slot, CommitteeIndex: &committeeIndex},
+			expected: 1,
+		},
+		{
+			name: "CommitteeIndexMissing",
+			opts: &api.AttestationPoolOpts{Slot: &slot, CommitteeIndex: &missingCommitteeIndex},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.AttestationPoolWithOptsProvider).AttestationPoolWithOpts(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, test.expected)
+			for _, attestation := range res {
+				if test.opts.CommitteeIndex != nil {
+					require.Equal(t, *test.opts.CommitteeIndex, attestation.Data.Index)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type attestationRewardsJSON struct {
+	Data *api.AttestationRewards `json:"data"`
+}
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	if len(validatorIndices) > s.indexChunkSize(ctx) {
+		return s.chunkedAttestationRewards(ctx, epoch, validatorIndices)
+	}
+
+	reqBody, err := validatorIndicesBody(validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attestation rewards")
+	}
+
+	var resp attestationRewardsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attestation rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no attestation rewards returned")
+	}
+
+	return resp.Data, nil
+}
+
+// chunkedAttestationRewards obtains the attestation rewards a chunk at a time.
+func (s *Service) chunkedAttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	res := &api.AttestationRewards{
+		IdealRewards: make([]*api.IdealAttestationRewards, 0),
+		TotalRewards: make([]*api.ValidatorAttestationRewards, 0, len(validatorIndices)),
+	}
+	idealRewardsSeen := make(map[phase0.Gwei]bool)
+	indexChunkSize := s.indexChunkSize(ctx)
+	for i := 0; i < len(validatorIndices); i += indexChunkSize {
+		chunkStart := i
+		chunkEnd := i + indexChunkSize
+		if len(validatorIndices) < chunkEnd {
+			chunkEnd = len(validatorIndices)
+		}
+		chunk := validatorIndices[chunkStart:chunkEnd]
+		chunkRes, err := s.AttestationRewards(ctx, epoch, chunk)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chunk")
+		}
+		// Ideal rewards are only returned for the effective balances of the
+		// validators in the chunk, so merge them across chunks.
+		for _, idealRewards := range chunkRes.IdealRewards {
+			if !idealRewardsSeen[idealRewards.EffectiveBalance] {
+				idealRewardsSeen[idealRewards.EffectiveBalance] = true
+				res.IdealRewards = append(res.IdealRewards, idealRewards)
+			}
+		}
+		res.TotalRewards = append(res.TotalRewards, chunkRes.TotalRewards...)
+	}
+	sort.Slice(res.IdealRewards, func(i int, j int) bool {
+		return res.IdealRewards[i].EffectiveBalance < res.IdealRewards[j].EffectiveBalance
+	})
+
+	return res, nil
+}
+
+// validatorIndicesBody creates a request body containing the given validator indices.
+func validatorIndicesBody(validatorIndices []phase0.ValidatorIndex) ([]byte, error) {
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+	reqBody, err := json.Marshal(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator indices")
+	}
+
+	return reqBody, nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// effectiveBalance provides a test effective balance for a validator.
+func effectiveBalance(id string) string {
+	switch id {
+	case "3", "4":
+		return "31000000000"
+	case "5":
+		return "30000000000"
+	default:
+		return "32000000000"
+	}
+}
+
+func TestAttestationRewards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var requests int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/rewards/attestations/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		var ids []string
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+			return
+		}
+		// Ideal rewards are only returned for the effective balances of the requested validators.
+		idealRewards := make([]string, 0)
+		effectiveBalances := map[string]bool{}
+		if len(ids) == 0 {
+			effectiveBalances[effectiveBalance("")] = true
+		}
+		totalRewards := make([]string, len(ids))
+		for i := range ids {
+			totalRewards[i] = fmt.Sprintf(`{"validator_index":"%s","head":"2000","target":"2000","source":"2000","inactivity":"0"}`, ids[i])
+			effectiveBalances[effectiveBalance(ids[i])] = true
+		}
+		for balance := range effectiveBalances {
+			idealRewards = append(idealRewards, fmt.Sprintf(`{"effective_balance":"%s","head":"2000","target":"2000","source":"2000","inactivity":"0"}`, balance))
+		}
+		fmt.Fprintf(w, `{"data":{"ideal_rewards":[%s],"total_rewards":[%s]}}`, strings.Join(idealRewards, ","), strings.Join(totalRewards, ","))
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithIndexChunkSize(2),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		validatorIndices  []phase0.ValidatorIndex
+		requests          int32
+		effectiveBalances []phase0.Gwei
+	}{
+		{
+			name:              "All",
+			requests:          1,
+			effectiveBalances: []phase0.Gwei{32000000000},
+		},
+		{
+			name:              "Single",
+			validatorIndices:  []phase0.ValidatorIndex{1},
+			requests:          1,
+			effectiveBalances: []phase0.Gwei{32000000000},
+		},
+		{
+			name:              "Chunked",
+			validatorIndices:  []phase0.ValidatorIndex{1, 2, 3},
+			requests:          2,
+			effectiveBalances: []phase0.Gwei{31000000000, 32000000000},
+		},
+		{
+			name:              "ChunkedDifferentEffectiveBalances",
+			validatorIndices:  []phase0.ValidatorIndex{5, 1, 3, 2, 4},
+			requests:          3,
+			effectiveBalances: []phase0.Gwei{30000000000, 31000000000, 32000000000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			atomic.StoreInt32(&requests, 0)
+			rewards, err := service.(client.AttestationRewardsProvider).AttestationRewards(ctx, 1, test.validatorIndices)
+			require.NoError(t, err)
+			require.Equal(t, test.requests, atomic.LoadInt32(&requests))
+			effectiveBalances := make([]phase0.Gwei, len(rewards.IdealRewards))
+			for i := range rewards.IdealRewards {
+				effectiveBalances[i] = rewards.IdealRewards[i].EffectiveBalance
+			}
+			require.Equal(t, test.effectiveBalances, effectiveBalances)
+			require.Len(t, rewards.TotalRewards, len(test.validatorIndices))
+			for i := range test.validatorIndices {
+				require.Equal(t, test.validatorIndices[i], rewards.TotalRewards[i].ValidatorIndex)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type beaconBlockAttestationsJSON struct {
+	Data []*phase0.Attestation `json:"data"`
+}
+
+// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+// N.B if a beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s/attestations", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon block attestations")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp beaconBlockAttestationsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon block attestations")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon block attestations not returned")
+	}
+
+	return resp.Data, nil
+}
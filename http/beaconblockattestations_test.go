@@ -0,0 +1,142 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	attestations := []*phase0.Attestation{
+		{
+			AggregationBits: []byte{0x03},
+			Data: &phase0.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: phase0.Root{0x01},
+				Source:          &phase0.Checkpoint{},
+				Target:          &phase0.Checkpoint{Root: phase0.Root{0x02}},
+			},
+		},
+	}
+	root, err := srv.Chain().AddBlock(&spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:       2,
+				ParentRoot: srv.Chain().Head(),
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      attestations,
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		blockID  string
+		err      string
+		expected []*phase0.Attestation
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:     "Genesis",
+			blockID:  "genesis",
+			expected: []*phase0.Attestation{},
+		},
+		{
+			name:     "Root",
+			blockID:  root.String(),
+			expected: attestations,
+		},
+		{
+			name:    "Unknown",
+			blockID: "100",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.BeaconBlockAttestationsProvider).BeaconBlockAttestations(ctx, test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestBeaconBlockAttestationsMissing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == "/eth/v1/beacon/blocks/head/attestations" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.BeaconBlockAttestationsProvider).BeaconBlockAttestations(ctx, "head")
+	require.EqualError(t, err, "beacon block attestations not returned")
+}
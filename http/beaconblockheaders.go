@@ -0,0 +1,76 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type beaconBlockHeadersJSON struct {
+	Data []*api.BeaconBlockHeader `json:"data"`
+}
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Service) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*api.BeaconBlockHeader, error) {
+	filters := make([]string, 0, 2)
+	if slot != nil {
+		filters = append(filters, fmt.Sprintf("slot=%d", *slot))
+	}
+	if parentRoot != nil {
+		filters = append(filters, fmt.Sprintf("parent_root=%#x", *parentRoot))
+	}
+	url := "/eth/v1/beacon/headers"
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
+	}
+
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon block headers")
+	}
+	if respBodyReader == nil {
+		// No headers match the filters.
+		return []*api.BeaconBlockHeader{}, nil
+	}
+
+	var resp beaconBlockHeadersJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon block headers")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no beacon block headers returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for _, header := range resp.Data {
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			return nil, errors.New("invalid beacon block header returned")
+		}
+		if slot != nil && header.Header.Message.Slot != *slot {
+			return nil, errors.New("beacon block header not for requested slot")
+		}
+		if parentRoot != nil && header.Header.Message.ParentRoot != *parentRoot {
+			return nil, errors.New("beacon block header not for requested parent root")
+		}
+	}
+
+	return resp.Data, nil
+}
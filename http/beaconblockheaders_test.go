@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// Create a chain with a block at slot 2 that is reorged out.
+	chain := srv.Chain()
+	root1, err := chain.ProposeBlock(1)
+	require.NoError(t, err)
+	root2, err := chain.ProposeBlock(2)
+	require.NoError(t, err)
+	require.NoError(t, chain.SetHead(root1))
+	root3, err := chain.ProposeBlock(3)
+	require.NoError(t, err)
+
+	slot2 := phase0.Slot(2)
+	slot3 := phase0.Slot(3)
+	slot4 := phase0.Slot(4)
+	tests := []struct {
+		name       string
+		slot       *phase0.Slot
+		parentRoot *phase0.Root
+		roots      []phase0.Root
+		canonical  []bool
+	}{
+		{
+			name:      "Head",
+			roots:     []phase0.Root{root3},
+			canonical: []bool{true},
+		},
+		{
+			name:      "SlotOrphaned",
+			slot:      &slot2,
+			roots:     []phase0.Root{root2},
+			canonical: []bool{false},
+		},
+		{
+			name:      "SlotCanonical",
+			slot:      &slot3,
+			roots:     []phase0.Root{root3},
+			canonical: []bool{true},
+		},
+		{
+			name:  "SlotEmpty",
+			slot:  &slot4,
+			roots: []phase0.Root{},
+		},
+		{
+			name:       "ParentRoot",
+			parentRoot: &root1,
+			roots:      []phase0.Root{root2, root3},
+			canonical:  []bool{false, true},
+		},
+		{
+			name:       "SlotAndParentRoot",
+			slot:       &slot3,
+			parentRoot: &root1,
+			roots:      []phase0.Root{root3},
+			canonical:  []bool{true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			headers, err := service.(client.FilteredBeaconBlockHeadersProvider).BeaconBlockHeaders(ctx, test.slot, test.parentRoot)
+			require.NoError(t, err)
+			require.Len(t, headers, len(test.roots))
+			for i := range headers {
+				require.Equal(t, test.roots[i], headers[i].Root)
+				require.Equal(t, test.canonical[i], headers[i].Canonical)
+			}
+		})
+	}
+}
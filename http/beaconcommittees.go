@@ -17,38 +17,57 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
-	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 type beaconCommitteesJSON struct {
-	Data []*api.BeaconCommittee `json:"data"`
+	Data []*apiv1.BeaconCommittee `json:"data"`
 }
 
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
-func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", stateID)
-	respBodyReader, err := s.get(ctx, url)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to request beacon committees")
+func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*apiv1.BeaconCommittee, error) {
+	return s.BeaconCommitteesWithOpts(ctx, &api.BeaconCommitteesOpts{
+		State: stateID,
+	})
+}
+
+// BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
+func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	return s.BeaconCommitteesWithOpts(ctx, &api.BeaconCommitteesOpts{
+		State: stateID,
+		Epoch: &epoch,
+	})
+}
+
+// BeaconCommitteesWithOpts fetches the beacon committees matching the given options.
+func (s *Service) BeaconCommitteesWithOpts(ctx context.Context, opts *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
 	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon committees")
+	if opts.State == "" {
+		return nil, errors.New("no state specified")
 	}
 
-	var resp beaconCommitteesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
-		return nil, errors.Wrap(err, "failed to parse beacon committees")
+	filters := make([]string, 0, 3)
+	if opts.Epoch != nil {
+		filters = append(filters, fmt.Sprintf("epoch=%d", *opts.Epoch))
+	}
+	if opts.Slot != nil {
+		filters = append(filters, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.Index != nil {
+		filters = append(filters, fmt.Sprintf("index=%d", *opts.Index))
+	}
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", opts.State)
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
 	}
 
-	return resp.Data, nil
-}
-
-// BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
-func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*api.BeaconCommittee, error) {
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees?epoch=%d", stateID, epoch)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon committees")
@@ -62,5 +81,15 @@ func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, e
 		return nil, errors.Wrap(err, "failed to parse beacon committees")
 	}
 
+	// Ensure the data returned to us is as expected given our input.
+	for _, committee := range resp.Data {
+		if opts.Slot != nil && committee.Slot != *opts.Slot {
+			return nil, errors.New("beacon committee not for requested slot")
+		}
+		if opts.Index != nil && committee.Index != *opts.Index {
+			return nil, errors.New("beacon committee not for requested index")
+		}
+	}
+
 	return resp.Data, nil
 }
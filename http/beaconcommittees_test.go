@@ -15,17 +15,24 @@ package http_test
 
 import (
 	"context"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestBeaconCommittees(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -55,6 +62,8 @@ func TestBeaconCommittees(t *testing.T) {
 }
 
 func TestBeaconCommitteesAtEpoch(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -98,3 +107,97 @@ func TestBeaconCommitteesAtEpoch(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconCommitteesWithOpts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	committees := make([]*apiv1.BeaconCommittee, 0)
+	for slot := phase0.Slot(0); slot < 4; slot++ {
+		for index := phase0.CommitteeIndex(0); index < 2; index++ {
+			committees = append(committees, &apiv1.BeaconCommittee{
+				Slot:       slot,
+				Index:      index,
+				Validators: []phase0.ValidatorIndex{phase0.ValidatorIndex(uint64(slot)*2 + uint64(index))},
+			})
+		}
+	}
+	srv.Chain().SetBeaconCommittees(0, committees)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	epoch := phase0.Epoch(0)
+	slot := phase0.Slot(2)
+	index := phase0.CommitteeIndex(1)
+
+	tests := []struct {
+		name     string
+		opts     *api.BeaconCommitteesOpts
+		err      string
+		expected int
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name: "StateMissing",
+			opts: &api.BeaconCommitteesOpts{},
+			err:  "no state specified",
+		},
+		{
+			name:     "All",
+			opts:     &api.BeaconCommitteesOpts{State: "head"},
+			expected: 8,
+		},
+		{
+			name:     "Epoch",
+			opts:     &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch},
+			expected: 8,
+		},
+		{
+			name:     "Slot",
+			opts:     &api.BeaconCommitteesOpts{State: "head", Slot: &slot},
+			expected: 2,
+		},
+		{
+			name:     "Index",
+			opts:     &api.BeaconCommitteesOpts{State: "head", Index: &index},
+			expected: 4,
+		},
+		{
+			name:     "SlotAndIndex",
+			opts:     &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch, Slot: &slot, Index: &index},
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.BeaconCommitteesWithOptsProvider).BeaconCommitteesWithOpts(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, test.expected)
+			for _, committee := range res {
+				if test.opts.Slot != nil {
+					require.Equal(t, *test.opts.Slot, committee.Slot)
+				}
+				if test.opts.Index != nil {
+					require.Equal(t, *test.opts.Index, committee.Index)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconCommitteeSelections(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name        string
+		nodeVersion string
+		submissions int
+	}{
+		{
+			name:        "BeaconNode",
+			nodeVersion: "Lighthouse/v4.2.0",
+		},
+		{
+			name:        "DVTMiddleware",
+			nodeVersion: "obolnetwork/charon/v0.16.0",
+			submissions: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, err := server.New(ctx,
+				server.WithLogLevel(zerolog.Disabled),
+				server.WithNodeVersion(test.nodeVersion),
+			)
+			require.NoError(t, err)
+			httpServer := httptest.NewServer(srv)
+			defer httpServer.Close()
+
+			service, err := http.New(ctx,
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress(httpServer.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			selections := []*apiv1.BeaconCommitteeSelection{
+				{
+					ValidatorIndex: 1,
+					Slot:           10,
+					SelectionProof: phase0.BLSSignature{0x01},
+				},
+				{
+					ValidatorIndex: 2,
+					Slot:           10,
+					SelectionProof: phase0.BLSSignature{0x02},
+				},
+			}
+			res, err := service.(client.BeaconCommitteeSelectionsProvider).BeaconCommitteeSelections(ctx, selections)
+			require.NoError(t, err)
+			require.Equal(t, selections, res)
+			require.Len(t, srv.Submissions("/eth/v1/validator/beacon_committee_selections"), test.submissions)
+		})
+	}
+}
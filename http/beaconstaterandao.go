@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jefmcl/go-eth2-client/api"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -34,11 +35,26 @@ type stateRandaoDataJSON struct {
 
 // BeaconStateRandao fetches a beacon state RANDAO given a state ID.
 func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
-	if stateID == "" {
+	return s.BeaconStateRandaoWithOpts(ctx, &api.BeaconStateRandaoOpts{
+		State: stateID,
+	})
+}
+
+// BeaconStateRandaoWithOpts fetches a beacon state RANDAO matching the given options.
+func (s *Service) BeaconStateRandaoWithOpts(ctx context.Context, opts *api.BeaconStateRandaoOpts) (*phase0.Root, error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.State == "" {
 		return nil, errors.New("no state ID specified")
 	}
 
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/randao", stateID))
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/randao", opts.State)
+	if opts.Epoch != nil {
+		url = fmt.Sprintf("%s?epoch=%d", url, *opts.Epoch)
+	}
+
+	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request state RANDAO")
 	}
@@ -50,6 +66,9 @@ func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase
 	if err := json.NewDecoder(respBodyReader).Decode(&data); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state RANDAO")
 	}
+	if data.Data == nil {
+		return nil, errors.New("state RANDAO not returned")
+	}
 
 	bytes, err := hex.DecodeString(strings.TrimPrefix(data.Data.Randao, "0x"))
 	if err != nil {
@@ -16,15 +16,24 @@ package http_test
 import (
 	"context"
 	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
 	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
 func TestBeaconStateRandao(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -74,3 +83,79 @@ func TestBeaconStateRandao(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconStateRandaoWithOpts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/randao") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path != "/eth/v1/beacon/states/head/randao" {
+			nethttp.NotFound(w, r)
+			return
+		}
+		randao := "0x0000000000000000000000000000000000000000000000000000000000000000"
+		if epoch := r.URL.Query().Get("epoch"); epoch != "" {
+			randao = fmt.Sprintf("0x%s%s", strings.Repeat("0", 64-len(epoch)), epoch)
+		}
+		fmt.Fprintf(w, `{"data":{"randao":"%s"}}`, randao)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	epoch := phase0.Epoch(12)
+
+	tests := []struct {
+		name     string
+		opts     *api.BeaconStateRandaoOpts
+		err      string
+		expected *phase0.Root
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name: "StateMissing",
+			opts: &api.BeaconStateRandaoOpts{},
+			err:  "no state ID specified",
+		},
+		{
+			name:     "State",
+			opts:     &api.BeaconStateRandaoOpts{State: "head"},
+			expected: &phase0.Root{},
+		},
+		{
+			name:     "Epoch",
+			opts:     &api.BeaconStateRandaoOpts{State: "head", Epoch: &epoch},
+			expected: &phase0.Root{31: 0x12},
+		},
+		{
+			name: "Unknown",
+			opts: &api.BeaconStateRandaoOpts{State: "finalized"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.BeaconStateRandaoWithOptsProvider).BeaconStateRandaoWithOpts(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
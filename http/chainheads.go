@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type chainHeadsJSON struct {
+	Data []*api.ChainHead `json:"data"`
+}
+
+// ChainHeads provides the heads of all chains known to the node.
+func (s *Service) ChainHeads(ctx context.Context) ([]*api.ChainHead, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v2/debug/beacon/heads")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request chain heads")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain chain heads")
+	}
+
+	var chainHeadsJSON chainHeadsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&chainHeadsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse chain heads")
+	}
+	if chainHeadsJSON.Data == nil {
+		return nil, errors.New("no chain heads returned")
+	}
+
+	return chainHeadsJSON.Data, nil
+}
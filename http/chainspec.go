@@ -0,0 +1,64 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ChainSpec provides the spec information of the chain.
+func (s *Service) ChainSpec(ctx context.Context) (*api.ChainSpec, error) {
+	s.chainSpecMutex.RLock()
+	if s.chainSpec != nil {
+		defer s.chainSpecMutex.RUnlock()
+		return s.chainSpec, nil
+	}
+	s.chainSpecMutex.RUnlock()
+
+	s.chainSpecMutex.Lock()
+	defer s.chainSpecMutex.Unlock()
+	if s.chainSpec != nil {
+		// Someone else fetched this whilst we were waiting for the lock.
+		return s.chainSpec, nil
+	}
+
+	// Up to us to fetch the information.
+	respBodyReader, err := s.get(ctx, "/eth/v1/config/spec")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request spec")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain spec")
+	}
+
+	var specJSON specJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&specJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse spec")
+	}
+	if specJSON.Data == nil {
+		return nil, errors.New("spec not returned")
+	}
+
+	chainSpec, err := api.ChainSpecFromMap(specJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+
+	s.chainSpec = chainSpec
+	return s.chainSpec, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainSpec(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	srv.Chain().SetConfig("GOSSIP_MAX_SIZE", "10485760")
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	chainSpec, err := service.(client.ChainSpecProvider).ChainSpec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "mock", chainSpec.ConfigName)
+	require.Equal(t, 12*time.Second, chainSpec.SecondsPerSlot)
+	require.Equal(t, uint64(32), chainSpec.SlotsPerEpoch)
+	require.Equal(t, phase0.DomainType{0x01, 0x00, 0x00, 0x00}, chainSpec.DomainBeaconAttester)
+	require.Equal(t, "10485760", chainSpec.Extra["GOSSIP_MAX_SIZE"])
+}
+
+func TestChainSpecInvalid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == "/eth/v1/config/spec" {
+			_, _ = w.Write([]byte(`{"data":{"GENESIS_FORK_VERSION":"0x00000000","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"0"}}`))
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.ChainSpecProvider).ChainSpec(ctx)
+	require.EqualError(t, err, "invalid spec: SLOTS_PER_EPOCH must be greater than 0")
+}
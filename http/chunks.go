@@ -0,0 +1,152 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// maxConcurrentChunks is the maximum number of chunks requested from the node at the same time.
+const maxConcurrentChunks = 4
+
+// chunkIDs splits a list of IDs in to chunks of the given size.
+// An empty list results in a single empty chunk, so that a single unfiltered request is made.
+func chunkIDs(ids []string, chunkSize int) [][]string {
+	if len(ids) == 0 || chunkSize <= 0 {
+		return [][]string{ids}
+	}
+
+	chunks := make([][]string, 0, (len(ids)+chunkSize-1)/chunkSize)
+	for i := 0; i < len(ids); i += chunkSize {
+		chunkEnd := i + chunkSize
+		if len(ids) < chunkEnd {
+			chunkEnd = len(ids)
+		}
+		chunks = append(chunks, ids[i:chunkEnd])
+	}
+
+	return chunks
+}
+
+// fetchChunks calls fetch for each of the chunks, running up to maxConcurrentChunks at a time.
+// If any call fails the remaining calls are cancelled and the first error is returned.
+func fetchChunks(ctx context.Context, chunks int, fetch func(ctx context.Context, chunk int) error) error {
+	if chunks == 1 {
+		return fetch(ctx, 0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var errMu sync.Mutex
+	var firstErr error
+	sem := make(chan struct{}, maxConcurrentChunks)
+	for i := 0; i < chunks; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(chunk int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fetch(ctx, chunk); err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrap(err, "failed to obtain chunk")
+					cancel()
+				}
+				errMu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// getSupported returns true if the node has not been found to lack the given GET endpoint.
+func (s *Service) getSupported(endpoint string) bool {
+	s.getUnsupportedMutex.RLock()
+	defer s.getUnsupportedMutex.RUnlock()
+
+	return !s.getUnsupported[endpoint]
+}
+
+// setGetUnsupported marks the given GET endpoint as unsupported.
+func (s *Service) setGetUnsupported(endpoint string) {
+	s.log.Debug().Str("endpoint", endpoint).Msg("Endpoint not found on node")
+	s.getUnsupportedMutex.Lock()
+	s.getUnsupported[endpoint] = true
+	s.getUnsupportedMutex.Unlock()
+}
+
+// postSupported returns true if the node has not rejected POST requests for the given endpoint.
+func (s *Service) postSupported(endpoint string) bool {
+	s.postUnsupportedMutex.RLock()
+	defer s.postUnsupportedMutex.RUnlock()
+
+	return !s.postUnsupported[endpoint]
+}
+
+// checkPostSupport checks an error returned from a POST request to see if it
+// indicates that the node does not support POST for the given endpoint.
+// If so, the endpoint is marked as unsupported.
+// Returns true if the request should be retried using GET.
+func (s *Service) checkPostSupport(endpoint string, err error) bool {
+	var httpErr Error
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	switch httpErr.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusUnsupportedMediaType:
+		s.log.Debug().Str("endpoint", endpoint).Msg("POST not supported by node; using GET")
+		s.setPostUnsupported(endpoint)
+		return true
+	case http.StatusNotFound, http.StatusBadRequest:
+		// This could be because the endpoint is not supported, or because the
+		// requested data is not present or the request is invalid.  We do not
+		// know which, so retry with GET and leave it to confirmPostUnsupported
+		// to decide.
+		return true
+	default:
+		return false
+	}
+}
+
+// confirmPostUnsupported is called when a GET request succeeds after a POST
+// request for the same data failed with the given error.
+// If the POST request returned a 404 or 400 then the data is present and the
+// request is valid, so the error came from the node not handling POST for the
+// endpoint and the endpoint is marked as unsupported.
+func (s *Service) confirmPostUnsupported(endpoint string, err error) {
+	var httpErr Error
+	if !errors.As(err, &httpErr) ||
+		(httpErr.StatusCode != http.StatusNotFound && httpErr.StatusCode != http.StatusBadRequest) {
+		return
+	}
+
+	s.log.Debug().Str("endpoint", endpoint).Msg("POST rejected by node; using GET")
+	s.setPostUnsupported(endpoint)
+}
+
+// setPostUnsupported marks POST as unsupported for the given endpoint.
+func (s *Service) setPostUnsupported(endpoint string) {
+	s.postUnsupportedMutex.Lock()
+	s.postUnsupported[endpoint] = true
+	s.postUnsupportedMutex.Unlock()
+}
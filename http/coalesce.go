@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// inflightRequest is a request that is in progress, whose result can be
+// shared by multiple callers.
+type inflightRequest struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// coalescedGet sends an HTTP get request and returns the body data, sharing
+// the request with any concurrent callers for the same endpoint.
+// If the response from the server is a 404 this will return nil for both the data and the error.
+func (s *Service) coalescedGet(ctx context.Context, endpoint string) ([]byte, error) {
+	return s.coalesce(ctx, fmt.Sprintf("%s %s", http.MethodGet, endpoint), func(ctx context.Context) ([]byte, error) {
+		return s.getData(ctx, endpoint)
+	})
+}
+
+// coalescedPost sends an HTTP post request and returns the body data, sharing
+// the request with any concurrent callers for the same endpoint and body.
+func (s *Service) coalescedPost(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
+	key := fmt.Sprintf("%s %s %x", http.MethodPost, endpoint, sha256.Sum256(body))
+	return s.coalesce(ctx, key, func(ctx context.Context) ([]byte, error) {
+		respBodyReader, err := s.post(ctx, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		return io.ReadAll(respBodyReader)
+	})
+}
+
+// coalesce calls fetch and returns its result, sharing the call with any
+// concurrent callers for the same key.
+func (s *Service) coalesce(ctx context.Context, key string, fetch func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	s.inflightMu.Lock()
+	request, exists := s.inflight[key]
+	if !exists {
+		request = &inflightRequest{
+			done: make(chan struct{}),
+		}
+		s.inflight[key] = request
+		go func() {
+			// The request is not tied to the context of any individual caller,
+			// so that it is not cancelled if the first caller goes away.
+			request.data, request.err = fetch(context.Background())
+			s.inflightMu.Lock()
+			delete(s.inflight, key)
+			s.inflightMu.Unlock()
+			close(request.done)
+		}()
+	}
+	s.inflightMu.Unlock()
+	monitorRequestCoalesced(exists)
+
+	select {
+	case <-request.done:
+		return request.data, request.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
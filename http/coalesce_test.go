@@ -0,0 +1,136 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCoalescing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	srv.AddFault("/eth/v1/validator/attestation_data", server.Fault{Latency: 200 * time.Millisecond})
+	var requests int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == "/eth/v1/validator/attestation_data" {
+			atomic.AddInt32(&requests, 1)
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	tests := []struct {
+		name     string
+		coalesce bool
+		requests int32
+	}{
+		{
+			name:     "Disabled",
+			coalesce: false,
+			requests: 8,
+		},
+		{
+			name:     "Enabled",
+			coalesce: true,
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := http.New(ctx,
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress(httpServer.URL),
+				http.WithTimeout(timeout),
+				http.WithRequestCoalescing(test.coalesce),
+			)
+			require.NoError(t, err)
+
+			atomic.StoreInt32(&requests, 0)
+			attestationDatas := make([]*phase0.AttestationData, 8)
+			errs := make([]error, len(attestationDatas))
+			var wg sync.WaitGroup
+			for i := range attestationDatas {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					attestationDatas[i], errs[i] = service.(client.AttestationDataProvider).AttestationData(ctx, 1, 0)
+				}(i)
+			}
+			wg.Wait()
+			for i := range attestationDatas {
+				require.NoError(t, errs[i])
+				require.NotNil(t, attestationDatas[i])
+			}
+			require.Equal(t, test.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestRequestCoalescingCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	srv.AddFault("/eth/v1/validator/attestation_data", server.Fault{Latency: 200 * time.Millisecond})
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithRequestCoalescing(true),
+	)
+	require.NoError(t, err)
+
+	// The first caller giving up does not affect the second.
+	firstCtx, firstCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer firstCancel()
+	var firstErr error
+	var secondErr error
+	var attestationData *phase0.AttestationData
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, firstErr = service.(client.AttestationDataProvider).AttestationData(firstCtx, 1, 0)
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		attestationData, secondErr = service.(client.AttestationDataProvider).AttestationData(ctx, 1, 0)
+	}()
+	wg.Wait()
+	require.ErrorIs(t, firstErr, context.DeadlineExceeded)
+	require.NoError(t, secondErr)
+	require.NotNil(t, attestationData)
+}
@@ -24,6 +24,8 @@ import (
 )
 
 func TestDepositContract(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
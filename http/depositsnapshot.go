@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type depositSnapshotJSON struct {
+	Data *api.DepositSnapshot `json:"data"`
+}
+
+// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+func (s *Service) DepositSnapshot(ctx context.Context) (*api.DepositSnapshot, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/deposit_snapshot")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request deposit snapshot")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp depositSnapshotJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse deposit snapshot")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no deposit snapshot returned")
+	}
+
+	return resp.Data, nil
+}
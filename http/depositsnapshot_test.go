@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/deposit"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Build a snapshot from a local tree.
+	tree := deposit.NewTree()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, tree.PushLeaf(phase0.Root{byte(i + 1)}))
+	}
+	require.NoError(t, tree.Finalize(&phase0.ETH1Data{
+		DepositCount: 3,
+		BlockHash:    make([]byte, 32),
+	}, 10))
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	available := int32(1)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/beacon/deposit_snapshot" {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		if atomic.LoadInt32(&available) == 0 {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&struct {
+			Data *apiv1.DepositSnapshot `json:"data"`
+		}{
+			Data: snapshot,
+		}))
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	res, err := service.(client.DepositSnapshotProvider).DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, res)
+
+	// Ensure the snapshot can be used to create a tree.
+	restored, err := deposit.NewTreeFromSnapshot(res)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), restored.DepositCount())
+
+	// Nodes without a snapshot return nil.
+	atomic.StoreInt32(&available, 0)
+	res, err = service.(client.DepositSnapshotProvider).DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
@@ -26,6 +26,8 @@ import (
 )
 
 func TestDomain(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -58,11 +58,15 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 	log.Trace().Str("url", url).Msg("GET request to events stream")
 
 	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
-		Dial: (&net.Dialer{
-			Timeout:   2 * time.Second,
-			KeepAlive: 2 * time.Second,
-		}).Dial,
+	if s.userTransport != nil {
+		client.Connection.Transport = s.userTransport
+	} else {
+		client.Connection.Transport = &http.Transport{
+			Dial: (&net.Dialer{
+				Timeout:   2 * time.Second,
+				KeepAlive: 2 * time.Second,
+			}).Dial,
+		}
 	}
 
 	go func() {
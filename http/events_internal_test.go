@@ -31,6 +31,10 @@ import (
 var timeout = 60 * time.Second
 
 func TestEventHandler(t *testing.T) {
+	if os.Getenv("HTTP_ADDRESS") == "" {
+		t.Skip("HTTP_ADDRESS not set")
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -27,6 +27,8 @@ import (
 )
 
 func TestEvents(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -0,0 +1,58 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type expectedWithdrawalsJSON struct {
+	Data []*capella.Withdrawal `json:"data"`
+}
+
+// ExpectedWithdrawals provides the withdrawals that will be included in the next
+// block built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/builder/states/%s/expected_withdrawals", stateID)
+	if proposalSlot != 0 {
+		url = fmt.Sprintf("%s?proposal_slot=%d", url, proposalSlot)
+	}
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request expected withdrawals")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain expected withdrawals")
+	}
+
+	var resp expectedWithdrawalsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse expected withdrawals")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no expected withdrawals returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedWithdrawals(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	withdrawals := []*capella.Withdrawal{
+		{
+			Index:          1,
+			ValidatorIndex: 2,
+			Address:        [20]byte{0x03},
+			Amount:         4,
+		},
+	}
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var mu sync.Mutex
+	var proposalSlot string
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/builder/states/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path != "/eth/v1/builder/states/head/expected_withdrawals" {
+			// Act as a node without the requested state.
+			nethttp.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		proposalSlot = r.URL.Query().Get("proposal_slot")
+		mu.Unlock()
+		require.NoError(t, json.NewEncoder(w).Encode(&struct {
+			Data []*capella.Withdrawal `json:"data"`
+		}{
+			Data: withdrawals,
+		}))
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		stateID      string
+		proposalSlot phase0.Slot
+		expected     string
+		err          string
+	}{
+		{
+			name: "StateIDMissing",
+			err:  "no state ID specified",
+		},
+		{
+			name:    "Unknown",
+			stateID: "finalized",
+			err:     "failed to obtain expected withdrawals",
+		},
+		{
+			name:    "Good",
+			stateID: "head",
+		},
+		{
+			name:         "ProposalSlot",
+			stateID:      "head",
+			proposalSlot: 12,
+			expected:     "12",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.ExpectedWithdrawalsProvider).ExpectedWithdrawals(ctx, test.stateID, test.proposalSlot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, withdrawals, res)
+			mu.Lock()
+			require.Equal(t, test.expected, proposalSlot)
+			mu.Unlock()
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// FilteredValidators provides the validators, with their balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices and validatorPubKeys restrict the returned values to the given validators; if neither is supplied
+// no validator filter will be applied.
+// validatorStates restricts the returned values to validators in the given states; if none are supplied no state
+// filter will be applied.  Group names such as "pending" or "active" are not supported; to filter by a group supply
+// each of its states, for example all states for which IsPending returns true.
+func (s *Service) FilteredValidators(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+	validatorPubKeys []phase0.BLSPubKey,
+	validatorStates []api.ValidatorState,
+) (
+	map[phase0.ValidatorIndex]*api.Validator,
+	error,
+) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	ids := append(validatorIndexIDs(validatorIndices), validatorPubKeyIDs(validatorPubKeys)...)
+	chunkSize := s.indexChunkSize(ctx)
+	if len(validatorPubKeys) > 0 {
+		chunkSize = s.pubKeyChunkSize(ctx)
+	}
+
+	return s.validators(ctx, stateID, ids, validatorStates, chunkSize)
+}
@@ -0,0 +1,362 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// filteredValidatorsServer creates a mock server with validators in a range of states.
+func filteredValidatorsServer(ctx context.Context, t *testing.T) *server.Server {
+	t.Helper()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	states := []apiv1.ValidatorState{
+		apiv1.ValidatorStateActiveOngoing,
+		apiv1.ValidatorStatePendingQueued,
+		apiv1.ValidatorStateActiveOngoing,
+		apiv1.ValidatorStateExitedUnslashed,
+		apiv1.ValidatorStateActiveOngoing,
+		apiv1.ValidatorStatePendingInitialized,
+	}
+	validators := make([]*apiv1.Validator, len(states))
+	for i := range states {
+		validators[i] = &apiv1.Validator{
+			Index:   phase0.ValidatorIndex(i),
+			Balance: phase0.Gwei(32000000000 + i),
+			Status:  states[i],
+			Validator: &phase0.Validator{
+				PublicKey:             phase0.BLSPubKey{byte(i + 1)},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+			},
+		}
+	}
+	srv.Chain().SetValidators(validators)
+
+	return srv
+}
+
+func TestFilteredValidators(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := filteredValidatorsServer(ctx, t)
+	var posts int32
+	var gets int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, "/validators") {
+			if r.Method == nethttp.MethodPost {
+				atomic.AddInt32(&posts, 1)
+			} else {
+				atomic.AddInt32(&gets, 1)
+			}
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		validatorIndices []phase0.ValidatorIndex
+		validatorPubKeys []phase0.BLSPubKey
+		validatorStates  []apiv1.ValidatorState
+		expected         []phase0.ValidatorIndex
+	}{
+		{
+			name:     "All",
+			expected: []phase0.ValidatorIndex{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:            "ActiveOngoing",
+			validatorStates: []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing},
+			expected:        []phase0.ValidatorIndex{0, 2, 4},
+		},
+		{
+			name: "Pending",
+			validatorStates: []apiv1.ValidatorState{
+				apiv1.ValidatorStatePendingInitialized,
+				apiv1.ValidatorStatePendingQueued,
+			},
+			expected: []phase0.ValidatorIndex{1, 5},
+		},
+		{
+			name:             "IndicesAndState",
+			validatorIndices: []phase0.ValidatorIndex{0, 1, 2},
+			validatorStates:  []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing},
+			expected:         []phase0.ValidatorIndex{0, 2},
+		},
+		{
+			name:             "PubKeysAndIndices",
+			validatorIndices: []phase0.ValidatorIndex{3},
+			validatorPubKeys: []phase0.BLSPubKey{{0x02}, {0x05}},
+			expected:         []phase0.ValidatorIndex{1, 3, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validators, err := service.(client.FilteredValidatorsProvider).FilteredValidators(ctx, "head", test.validatorIndices, test.validatorPubKeys, test.validatorStates)
+			require.NoError(t, err)
+			require.Len(t, validators, len(test.expected))
+			for _, index := range test.expected {
+				require.Contains(t, validators, index)
+			}
+		})
+	}
+
+	// All requests should have been made with POST.
+	require.Equal(t, int32(len(tests)), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(0), atomic.LoadInt32(&gets))
+}
+
+func TestFilteredValidatorsGETFallback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := filteredValidatorsServer(ctx, t)
+	var posts int32
+	var gets int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, "/validators") || strings.HasSuffix(r.URL.Path, "/validator_balances") {
+			if r.Method == nethttp.MethodPost {
+				atomic.AddInt32(&posts, 1)
+				nethttp.Error(w, `{"code":405,"message":"method not allowed"}`, nethttp.StatusMethodNotAllowed)
+				return
+			}
+			atomic.AddInt32(&gets, 1)
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithIndexChunkSize(2),
+	)
+	require.NoError(t, err)
+
+	// First request attempts POST, then falls back to chunked GET.
+	validators, err := service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1, 2, 3, 4})
+	require.NoError(t, err)
+	require.Len(t, validators, 5)
+	require.Equal(t, int32(1), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(3), atomic.LoadInt32(&gets))
+
+	// Subsequent requests go straight to GET, with the state filter in the query.
+	validators, err = service.(client.FilteredValidatorsProvider).FilteredValidators(ctx, "head", []phase0.ValidatorIndex{0, 1, 2, 3, 4}, nil, []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing})
+	require.NoError(t, err)
+	require.Len(t, validators, 3)
+	require.Equal(t, int32(1), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(6), atomic.LoadInt32(&gets))
+
+	// Balances track POST support separately.
+	balances, err := service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{0, 1, 2, 3, 4})
+	require.NoError(t, err)
+	require.Len(t, balances, 5)
+	require.Equal(t, phase0.Gwei(32000000003), balances[3])
+	require.Equal(t, int32(2), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(9), atomic.LoadInt32(&gets))
+}
+
+func TestFilteredValidatorsPOSTNotFound(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := filteredValidatorsServer(ctx, t)
+	var missingState int32
+	var posts int32
+	var gets int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, "/validators") {
+			if r.Method == nethttp.MethodPost {
+				atomic.AddInt32(&posts, 1)
+				nethttp.Error(w, `{"code":404,"message":"not found"}`, nethttp.StatusNotFound)
+				return
+			}
+			atomic.AddInt32(&gets, 1)
+			if atomic.LoadInt32(&missingState) == 1 {
+				nethttp.Error(w, `{"code":404,"message":"state not found"}`, nethttp.StatusNotFound)
+				return
+			}
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// A 404 for a missing state does not mark POST as unsupported.
+	atomic.StoreInt32(&missingState, 1)
+	_, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+	require.Error(t, err)
+	_, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+	require.Error(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(2), atomic.LoadInt32(&gets))
+
+	// A 404 for data that GET returns marks POST as unsupported.
+	atomic.StoreInt32(&missingState, 0)
+	validators, err := service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+	require.Equal(t, int32(3), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(3), atomic.LoadInt32(&gets))
+
+	validators, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+	require.Equal(t, int32(3), atomic.LoadInt32(&posts))
+	require.Equal(t, int32(4), atomic.LoadInt32(&gets))
+}
+
+func TestFilteredValidatorsPOSTCoalescing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := filteredValidatorsServer(ctx, t)
+	srv.AddFault("/eth/v1/beacon/states/head/validators", server.Fault{Latency: 200 * time.Millisecond})
+	var posts int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasSuffix(r.URL.Path, "/validators") && r.Method == nethttp.MethodPost {
+			atomic.AddInt32(&posts, 1)
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithRequestCoalescing(true),
+	)
+	require.NoError(t, err)
+
+	// Identical requests share a single POST.
+	results := make([]map[phase0.ValidatorIndex]*apiv1.Validator, 4)
+	errs := make([]error, len(results))
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+		}(i)
+	}
+	wg.Wait()
+	for i := range results {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i], 2)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&posts))
+
+	// Requests with different bodies do not.
+	results = make([]map[phase0.ValidatorIndex]*apiv1.Validator, 2)
+	errs = make([]error, len(results))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{phase0.ValidatorIndex(i)})
+		}(i)
+	}
+	wg.Wait()
+	for i := range results {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i], 1)
+	}
+	require.Equal(t, int32(3), atomic.LoadInt32(&posts))
+}
+
+func TestFilteredValidatorsPOSTRejected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{
+			name:       "BadRequest",
+			statusCode: nethttp.StatusBadRequest,
+		},
+		{
+			name:       "UnsupportedMediaType",
+			statusCode: nethttp.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := filteredValidatorsServer(ctx, t)
+			var posts int32
+			httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if strings.HasSuffix(r.URL.Path, "/validators") && r.Method == nethttp.MethodPost {
+					atomic.AddInt32(&posts, 1)
+					nethttp.Error(w, `{"code":0,"message":"rejected"}`, test.statusCode)
+					return
+				}
+				srv.ServeHTTP(w, r)
+			}))
+			defer httpServer.Close()
+
+			service, err := http.New(ctx,
+				http.WithLogLevel(zerolog.Disabled),
+				http.WithAddress(httpServer.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			// The first request falls back to GET, and later requests go straight to GET.
+			for i := 0; i < 2; i++ {
+				validators, err := service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+				require.NoError(t, err)
+				require.Len(t, validators, 2)
+			}
+			require.Equal(t, int32(1), atomic.LoadInt32(&posts))
+		})
+	}
+}
@@ -25,6 +25,8 @@ import (
 )
 
 func TestFinality(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -0,0 +1,146 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fixtures provides HTTP transports that record interactions with a
+// beacon node to fixture files, and replay them deterministically in tests.
+package fixtures
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// fixtureVersion is the version of the fixture file format.
+const fixtureVersion = 1
+
+// redacted is the value used in place of redacted header values.
+const redacted = "REDACTED"
+
+// Fixture is a set of recorded interactions.
+type Fixture struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method  string              `json:"method"`
+	Path    string              `json:"path"`
+	Query   string              `json:"query,omitempty"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    Body                `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       Body                `json:"body,omitempty"`
+	// Stream is true if the response is a stream of events.
+	Stream bool `json:"stream,omitempty"`
+}
+
+// Body is the body of a request or response.  It is stored in fixtures as a
+// string if it is valid UTF-8, otherwise as base64.
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+
+	return json.Marshal(fmt.Sprintf("base64:%s", base64.StdEncoding.EncodeToString(b)))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(input []byte) error {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if strings.HasPrefix(data, "base64:") {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(data, "base64:"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for body")
+		}
+		*b = decoded
+
+		return nil
+	}
+	*b = []byte(data)
+
+	return nil
+}
+
+// Load loads a fixture from a file.
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read fixture")
+	}
+
+	fixture := &Fixture{}
+	if err := json.Unmarshal(data, fixture); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fixture")
+	}
+	if fixture.Version != fixtureVersion {
+		return nil, fmt.Errorf("unsupported fixture version %d", fixture.Version)
+	}
+
+	return fixture, nil
+}
+
+// Save saves a fixture to a file.
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal fixture")
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return errors.Wrap(err, "failed to write fixture")
+	}
+
+	return nil
+}
+
+// copyHeaders copies headers, redacting values as required.
+func copyHeaders(headers map[string][]string, redactedHeaders map[string]bool) map[string][]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	res := make(map[string][]string, len(headers))
+	for k, v := range headers {
+		if redactedHeaders[strings.ToLower(k)] {
+			res[k] = []string{redacted}
+			continue
+		}
+		res[k] = append([]string{}, v...)
+	}
+
+	return res
+}
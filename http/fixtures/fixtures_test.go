@@ -0,0 +1,243 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/http/fixtures"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body fixtures.Body
+		json string
+	}{
+		{
+			name: "Empty",
+			body: fixtures.Body{},
+			json: `""`,
+		},
+		{
+			name: "Text",
+			body: fixtures.Body(`{"data":{}}`),
+			json: `"{\"data\":{}}"`,
+		},
+		{
+			name: "Binary",
+			body: fixtures.Body{0x00, 0xff, 0xfe},
+			json: `"base64:AP/+"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := test.body.MarshalJSON()
+			require.NoError(t, err)
+			require.Equal(t, test.json, string(data))
+			var body fixtures.Body
+			require.NoError(t, body.UnmarshalJSON(data))
+			require.Equal(t, []byte(test.body), []byte(body))
+		})
+	}
+}
+
+func TestNewReplayer(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []fixtures.Parameter
+		err    string
+	}{
+		{
+			name: "Nothing",
+			err:  "problem with parameters: no path or interactions specified",
+		},
+		{
+			name:   "TransportNil",
+			params: []fixtures.Parameter{fixtures.WithTransport(nil)},
+			err:    "problem with parameters: no transport specified",
+		},
+		{
+			name:   "PathMissing",
+			params: []fixtures.Parameter{fixtures.WithPath(filepath.Join(t.TempDir(), "missing.json"))},
+			err:    "failed to read fixture",
+		},
+		{
+			name: "InteractionInvalid",
+			params: []fixtures.Parameter{fixtures.WithInteractions([]*fixtures.Interaction{
+				{Request: &fixtures.Request{Method: "GET", Path: "/"}},
+			})},
+			err: "interaction missing request or response",
+		},
+		{
+			name:   "Good",
+			params: []fixtures.Parameter{fixtures.WithInteractions([]*fixtures.Interaction{})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := fixtures.NewReplayer(test.params...)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	address := httpServer.URL
+	path := filepath.Join(t.TempDir(), "fixture.json")
+
+	// Record.
+	recorder, err := fixtures.NewRecorder(fixtures.WithPath(path))
+	require.NoError(t, err)
+	recordCtx, recordCancel := context.WithCancel(ctx)
+	service, err := http.New(recordCtx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(address),
+		http.WithTransport(recorder),
+		http.WithExtraHeaders(map[string]string{"Authorization": "Bearer secret"}),
+	)
+	require.NoError(t, err)
+
+	recordedEvents := make(chan *apiv1.Event, 16)
+	require.NoError(t, service.(client.EventsProvider).Events(recordCtx, []string{"head"}, func(event *apiv1.Event) {
+		recordedEvents <- event
+	}))
+	// Propose blocks until the events stream picks one up.
+	var recordedEvent *apiv1.Event
+	require.Eventually(t, func() bool {
+		_, err := srv.Chain().ProposeBlock(srv.Chain().SyncState().HeadSlot + 1)
+		require.NoError(t, err)
+		select {
+		case recordedEvent = <-recordedEvents:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	recordedBlock, err := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	recordedSyncing, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Save())
+	recordCancel()
+	httpServer.Close()
+
+	// Ensure that the authorization header was redacted.
+	fixture, err := fixtures.Load(path)
+	require.NoError(t, err)
+	require.NotEmpty(t, fixture.Interactions)
+	for _, interaction := range fixture.Interactions {
+		if interaction.Response.Stream {
+			// The events stream does not send extra headers.
+			continue
+		}
+		require.Equal(t, []string{"REDACTED"}, interaction.Request.Headers["Authorization"])
+	}
+
+	// Replay, with the server no longer available.
+	replayer, err := fixtures.NewReplayer(fixtures.WithPath(path))
+	require.NoError(t, err)
+	service, err = http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(address),
+		http.WithTransport(replayer),
+	)
+	require.NoError(t, err)
+
+	replayedEvents := make(chan *apiv1.Event, 16)
+	require.NoError(t, service.(client.EventsProvider).Events(ctx, []string{"head"}, func(event *apiv1.Event) {
+		replayedEvents <- event
+	}))
+	select {
+	case replayedEvent := <-replayedEvents:
+		require.Equal(t, recordedEvent.Data.(*apiv1.HeadEvent).Block, replayedEvent.Data.(*apiv1.HeadEvent).Block)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "no event replayed")
+	}
+
+	replayedBlock, err := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, recordedBlock, replayedBlock)
+	replayedSyncing, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, recordedSyncing, replayedSyncing)
+
+	// Requests that were not recorded fail.
+	_, err = service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "genesis")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no recorded interaction for GET /eth/v2/beacon/blocks/genesis")
+}
+
+func TestRedactor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	recorder, err := fixtures.NewRecorder(
+		fixtures.WithRedactedHeaders("X-Api-Key"),
+		fixtures.WithRedactor(func(interaction *fixtures.Interaction) {
+			interaction.Request.Body = nil
+		}),
+	)
+	require.NoError(t, err)
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTransport(recorder),
+		http.WithExtraHeaders(map[string]string{"X-Api-Key": "secret"}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, service.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}))
+
+	interactions := recorder.Interactions()
+	require.NotEmpty(t, interactions)
+	for _, interaction := range interactions {
+		require.Equal(t, []string{"REDACTED"}, interaction.Request.Headers["X-Api-Key"])
+		require.Empty(t, interaction.Request.Body)
+	}
+
+	// Saving requires a path.
+	require.EqualError(t, recorder.Save(), "no path specified")
+}
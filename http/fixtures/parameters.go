@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	transport       http.RoundTripper
+	path            string
+	interactions    []*Interaction
+	redactedHeaders map[string]bool
+	redactors       []RedactorFunc
+}
+
+// Parameter is the interface for recorder and replayer parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// RedactorFunc is a function that can alter an interaction before it is
+// written to a fixture.
+type RedactorFunc func(*Interaction)
+
+// WithTransport sets the underlying transport used by the recorder to make
+// real requests.  If not supplied http.DefaultTransport is used.
+func WithTransport(transport http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// WithPath sets the path of the fixture file.  The recorder writes to this
+// path when saved; the replayer reads from it when created.
+func WithPath(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.path = path
+	})
+}
+
+// WithInteractions provides the interactions for the replayer directly, rather
+// than reading them from a file.
+func WithInteractions(interactions []*Interaction) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.interactions = interactions
+	})
+}
+
+// WithRedactedHeaders sets the names of request and response headers whose
+// values will be redacted in recorded fixtures.
+func WithRedactedHeaders(headers ...string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		for _, header := range headers {
+			p.redactedHeaders[strings.ToLower(header)] = true
+		}
+	})
+}
+
+// WithRedactor adds a function to alter interactions before they are written
+// to fixtures, for example to remove sensitive data from request bodies.
+func WithRedactor(redactor RedactorFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.redactors = append(p.redactors, redactor)
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		transport: http.DefaultTransport,
+		redactedHeaders: map[string]bool{
+			"authorization": true,
+		},
+	}
+	for _, p := range params {
+		if p != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.transport == nil {
+		return nil, errors.New("no transport specified")
+	}
+
+	return &parameters, nil
+}
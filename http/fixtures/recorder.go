@@ -0,0 +1,170 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Recorder is an HTTP transport that passes requests to an underlying
+// transport and records the interactions.
+type Recorder struct {
+	transport       http.RoundTripper
+	path            string
+	redactedHeaders map[string]bool
+	redactors       []RedactorFunc
+
+	mu           sync.Mutex
+	interactions []*Interaction
+}
+
+// NewRecorder creates a new recording transport.
+func NewRecorder(params ...Parameter) (*Recorder, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	return &Recorder{
+		transport:       parameters.transport,
+		path:            parameters.path,
+		redactedHeaders: parameters.redactedHeaders,
+		redactors:       parameters.redactors,
+		interactions:    make([]*Interaction, 0),
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: &Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.RawQuery,
+			Headers: req.Header.Clone(),
+			Body:    reqBody,
+		},
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header.Clone(),
+			Stream:     isStream(resp),
+		},
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	// Capture the response body as it is read, so that streams are recorded
+	// as they arrive rather than when they complete.
+	resp.Body = &recordingBody{
+		ReadCloser:  resp.Body,
+		recorder:    r,
+		interaction: interaction,
+	}
+
+	return resp, nil
+}
+
+// Interactions returns the interactions recorded so far, with redaction applied.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*Interaction, len(r.interactions))
+	for i, interaction := range r.interactions {
+		res[i] = &Interaction{
+			Request: &Request{
+				Method:  interaction.Request.Method,
+				Path:    interaction.Request.Path,
+				Query:   interaction.Request.Query,
+				Headers: copyHeaders(interaction.Request.Headers, r.redactedHeaders),
+				Body:    append(Body{}, interaction.Request.Body...),
+			},
+			Response: &Response{
+				StatusCode: interaction.Response.StatusCode,
+				Headers:    copyHeaders(interaction.Response.Headers, r.redactedHeaders),
+				Body:       append(Body{}, interaction.Response.Body...),
+				Stream:     interaction.Response.Stream,
+			},
+		}
+		for _, redactor := range r.redactors {
+			redactor(res[i])
+		}
+	}
+
+	return res
+}
+
+// Fixture returns a fixture containing the interactions recorded so far.
+func (r *Recorder) Fixture() *Fixture {
+	return &Fixture{
+		Version:      fixtureVersion,
+		Interactions: r.Interactions(),
+	}
+}
+
+// Save writes the interactions recorded so far to the fixture file.
+func (r *Recorder) Save() error {
+	if r.path == "" {
+		return errors.New("no path specified")
+	}
+
+	return r.Fixture().Save(r.path)
+}
+
+// recordingBody records a response body as it is read.
+type recordingBody struct {
+	io.ReadCloser
+	recorder    *Recorder
+	interaction *Interaction
+}
+
+// Read implements io.Reader.
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.recorder.mu.Lock()
+		b.interaction.Response.Body = append(b.interaction.Response.Body, p[:n]...)
+		b.recorder.mu.Unlock()
+	}
+
+	return n, err
+}
+
+// isStream returns true if the response is an event stream.
+func isStream(resp *http.Response) bool {
+	return strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream")
+}
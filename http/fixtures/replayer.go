@@ -0,0 +1,161 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Replayer is an HTTP transport that serves responses from recorded
+// interactions rather than making real requests.
+//
+// Requests are matched against interactions by method, path, query and body.
+// If the same request was recorded multiple times the responses are served in
+// the order in which they were recorded; once they are exhausted the last
+// response is served again, except for event streams which are left open
+// without further events so that clients do not see replayed events twice.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions map[string][]*Interaction
+	served       map[string]int
+}
+
+// NewReplayer creates a new replaying transport.
+func NewReplayer(params ...Parameter) (*Replayer, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	interactions := parameters.interactions
+	if interactions == nil {
+		if parameters.path == "" {
+			return nil, errors.New("problem with parameters: no path or interactions specified")
+		}
+		fixture, err := Load(parameters.path)
+		if err != nil {
+			return nil, err
+		}
+		interactions = fixture.Interactions
+	}
+
+	r := &Replayer{
+		interactions: make(map[string][]*Interaction),
+		served:       make(map[string]int),
+	}
+	for _, interaction := range interactions {
+		if interaction.Request == nil || interaction.Response == nil {
+			return nil, errors.New("interaction missing request or response")
+		}
+		key := interactionKey(interaction.Request.Method, interaction.Request.Path, interaction.Request.Query, interaction.Request.Body)
+		r.interactions[key] = append(r.interactions[key], interaction)
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		req.Body.Close()
+	}
+
+	key := interactionKey(req.Method, req.URL.Path, req.URL.RawQuery, reqBody)
+	r.mu.Lock()
+	interactions, exists := r.interactions[key]
+	if !exists {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+	}
+	served := r.served[key]
+	r.served[key]++
+	r.mu.Unlock()
+
+	var interaction *Interaction
+	var body []byte
+	if served < len(interactions) {
+		interaction = interactions[served]
+		body = interaction.Response.Body
+	} else {
+		interaction = interactions[len(interactions)-1]
+		if !interaction.Response.Stream {
+			body = interaction.Response.Body
+		}
+	}
+
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(interaction.Response.Headers).Clone(),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+	if interaction.Response.Stream {
+		resp.ContentLength = -1
+		resp.Body = &streamBody{
+			Reader: bytes.NewReader(body),
+			ctx:    req.Context(),
+		}
+	} else {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return resp, nil
+}
+
+// streamBody serves a recorded event stream and then blocks until the request
+// is cancelled, as a live event stream would.
+type streamBody struct {
+	*bytes.Reader
+	ctx context.Context
+}
+
+// Read implements io.Reader.
+func (b *streamBody) Read(p []byte) (int, error) {
+	if b.Reader.Len() > 0 {
+		return b.Reader.Read(p)
+	}
+	<-b.ctx.Done()
+
+	return 0, b.ctx.Err()
+}
+
+// Close implements io.Closer.
+func (*streamBody) Close() error {
+	return nil
+}
+
+// interactionKey provides the key used to match requests to interactions.
+func interactionKey(method string, path string, query string, body []byte) string {
+	return fmt.Sprintf("%s %s?%s %s", method, path, query, string(body))
+}
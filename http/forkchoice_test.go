@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkChoice(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// Create a chain with a block at slot 2 that is reorged out.
+	chain := srv.Chain()
+	genesisRoot := chain.Head()
+	root1, err := chain.ProposeBlock(1)
+	require.NoError(t, err)
+	root2, err := chain.ProposeBlock(2)
+	require.NoError(t, err)
+	require.NoError(t, chain.SetHead(root1))
+	root3, err := chain.ProposeBlock(3)
+	require.NoError(t, err)
+
+	forkChoice, err := service.(client.ForkChoiceProvider).ForkChoice(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, forkChoice)
+	require.Len(t, forkChoice.Nodes, 4)
+
+	head := forkChoice.Head()
+	require.NotNil(t, head)
+	require.Equal(t, root3, head.BlockRoot)
+
+	canonical := forkChoice.CanonicalChain()
+	require.Len(t, canonical, 3)
+	require.Equal(t, genesisRoot, canonical[0].BlockRoot)
+	require.Equal(t, root1, canonical[1].BlockRoot)
+	require.Equal(t, root3, canonical[2].BlockRoot)
+
+	branches := forkChoice.Branches()
+	require.Len(t, branches, 1)
+	require.Len(t, branches[0], 1)
+	require.Equal(t, root2, branches[0][0].BlockRoot)
+}
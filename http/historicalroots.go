@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HistoricalRoots fetches the historical roots of the given state.
+// There is no dedicated endpoint for historical roots, so they are extracted
+// from the full state if state fallback is enabled, otherwise api.ErrNotSupported
+// is returned.
+// N.B if the requested state is not available this will return nil without an error.
+func (s *Service) HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+	if !s.stateFallback {
+		return nil, errors.Wrap(api.ErrNotSupported, "historical roots")
+	}
+
+	state, err := s.BeaconState(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	return state.HistoricalRoots()
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+type historicalSummariesJSON struct {
+	Data *historicalSummariesDataJSON `json:"data"`
+}
+
+type historicalSummariesDataJSON struct {
+	HistoricalSummaries []*capella.HistoricalSummary `json:"historical_summaries"`
+}
+
+// HistoricalSummaries fetches the historical summaries of the given state.
+// The summaries are obtained from the light client endpoint where the beacon
+// node supports it.  Otherwise they are extracted from the full state if state
+// fallback is enabled, or api.ErrNotSupported is returned.
+// N.B if the requested state is not available this will return nil without an error.
+func (s *Service) HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	if s.getSupported("historical_summaries") {
+		respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/lightclient/historical_summaries/%s", stateID))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to request historical summaries")
+		}
+		if respBodyReader != nil {
+			var resp historicalSummariesJSON
+			if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+				return nil, errors.Wrap(err, "failed to parse historical summaries")
+			}
+			if resp.Data == nil || resp.Data.HistoricalSummaries == nil {
+				return nil, errors.New("historical summaries not returned")
+			}
+
+			return resp.Data.HistoricalSummaries, nil
+		}
+
+		// The beacon node does not have the endpoint, or does not have the state;
+		// use the state root to find out which.
+		stateRoot, err := s.BeaconStateRoot(ctx, stateID)
+		if err != nil {
+			return nil, err
+		}
+		if stateRoot == nil {
+			return nil, nil
+		}
+		s.setGetUnsupported("historical_summaries")
+	}
+
+	if !s.stateFallback {
+		return nil, errors.Wrap(api.ErrNotSupported, "historical summaries")
+	}
+
+	state, err := s.BeaconState(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	return state.HistoricalSummaries()
+}
@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalSummaries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/lightclient/historical_summaries/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		switch r.URL.Path {
+		case "/eth/v1/lightclient/historical_summaries/head":
+			_, _ = w.Write([]byte(`{"data":{"historical_summaries":[{"block_summary_root":"0x0100000000000000000000000000000000000000000000000000000000000000","state_summary_root":"0x0200000000000000000000000000000000000000000000000000000000000000"}]}}`))
+		case "/eth/v1/lightclient/historical_summaries/bad":
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		default:
+			nethttp.NotFound(w, r)
+		}
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		stateID  string
+		err      string
+		expected []*capella.HistoricalSummary
+	}{
+		{
+			name: "StateMissing",
+			err:  "no state ID specified",
+		},
+		{
+			name:    "Good",
+			stateID: "head",
+			expected: []*capella.HistoricalSummary{
+				{
+					BlockSummaryRoot: phase0.Root{0x01},
+					StateSummaryRoot: phase0.Root{0x02},
+				},
+			},
+		},
+		{
+			name:    "SummariesMissing",
+			stateID: "bad",
+			err:     "historical summaries not returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, test.stateID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestHistoricalSummariesUnsupported(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var summariesRequests int32
+	var stateRequests int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/eth/v1/lightclient/historical_summaries/"):
+			// Act as a node without the endpoint.
+			atomic.AddInt32(&summariesRequests, 1)
+			nethttp.NotFound(w, r)
+		case strings.HasPrefix(r.URL.Path, "/eth/v2/debug/beacon/states/"):
+			atomic.AddInt32(&stateRequests, 1)
+			nethttp.NotFound(w, r)
+		default:
+			srv.ServeHTTP(w, r)
+		}
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// A missing state does not mark the endpoint as unsupported.
+	res, err := service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, "100")
+	require.NoError(t, err)
+	require.Nil(t, res)
+	_, err = service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, "100")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&summariesRequests))
+
+	// A present state does, and without state fallback the call is not supported.
+	_, err = service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, "head")
+	require.ErrorIs(t, err, api.ErrNotSupported)
+	_, err = service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, "head")
+	require.ErrorIs(t, err, api.ErrNotSupported)
+	require.Equal(t, int32(3), atomic.LoadInt32(&summariesRequests))
+	_, err = service.(client.HistoricalRootsProvider).HistoricalRoots(ctx, "head")
+	require.ErrorIs(t, err, api.ErrNotSupported)
+	require.Equal(t, int32(0), atomic.LoadInt32(&stateRequests))
+
+	// With state fallback the full state is requested.
+	service, err = http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithStateFallback(true),
+	)
+	require.NoError(t, err)
+	res, err = service.(client.HistoricalSummariesProvider).HistoricalSummaries(ctx, "head")
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, int32(4), atomic.LoadInt32(&summariesRequests))
+	require.Equal(t, int32(1), atomic.LoadInt32(&stateRequests))
+	_, err = service.(client.HistoricalRootsProvider).HistoricalRoots(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&stateRequests))
+}
@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/jefmcl/go-eth2-client/internal/httpclient"
 	"github.com/jefmcl/go-eth2-client/spec"
 	"github.com/pkg/errors"
 )
@@ -42,57 +43,106 @@ func (e Error) Error() string {
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
 func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
+	var data []byte
+	var err error
+	if s.coalesceRequests {
+		data, err = s.coalescedGet(ctx, endpoint)
+	} else {
+		data, err = s.getData(ctx, endpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+// getData sends an HTTP get request and returns the body data.
+// If the response from the server is a 404 this will return nil for both the data and the error.
+func (s *Service) getData(ctx context.Context, endpoint string) ([]byte, error) {
+	statusCode, data, err := s.getResponse(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == http.StatusNotFound {
+		// Nothing found.  This is not an error, so we return nil on both counts.
+		return nil, nil
+	}
+
+	statusFamily := statusCode / 100
+	if statusFamily != 2 {
+		return nil, Error{
+			Method:     http.MethodGet,
+			StatusCode: statusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+
+	return data, nil
+}
+
+// getResponse sends an HTTP get request and returns the status code and body data.
+// Unlike getData it does not interpret the status code, leaving that to the caller.
+func (s *Service) getResponse(ctx context.Context, endpoint string) (int, []byte, error) {
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
 	log.Trace().Msg("GET request")
 
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid endpoint")
+		return 0, nil, errors.Wrap(err, "invalid endpoint")
 	}
 
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+		return 0, nil, errors.Wrap(err, "failed to create GET request")
 	}
 	s.addExtraHeaders(req)
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to call GET endpoint")
+		return 0, nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		// Nothing found.  This is not an error, so we return nil on both counts.
-		cancel()
-		return nil, nil
-	}
-
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to read GET response")
+		return 0, nil, errors.Wrap(err, "failed to read GET response")
 	}
 
-	statusFamily := resp.StatusCode / 100
-	if statusFamily != 2 {
-		cancel()
+	if resp.StatusCode/100 != 2 {
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
-		return nil, Error{
-			Method:     http.MethodGet,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       data,
-		}
+	} else {
+		log.Trace().Int("status_code", resp.StatusCode).Str("response", string(data)).Msg("GET response")
 	}
-	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("GET response")
+	return resp.StatusCode, data, nil
+}
+
+// postQuery sends an HTTP post request that obtains data from the node,
+// rather than altering its state, and returns the body.
+// Such requests are coalesced with concurrent identical requests if enabled.
+func (s *Service) postQuery(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	if !s.coalesceRequests {
+		return s.post(ctx, endpoint, body)
+	}
+
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read request body")
+	}
+	data, err := s.coalescedPost(ctx, endpoint, bodyBytes)
+	if err != nil {
+		return nil, err
+	}
 
 	return bytes.NewReader(data), nil
 }
@@ -158,9 +208,7 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 }
 
 func (s *Service) addExtraHeaders(req *http.Request) {
-	for k, v := range s.extraHeaders {
-		req.Header.Add(k, v)
-	}
+	httpclient.AddHeaders(req, s.extraHeaders)
 }
 
 // responseMetadata returns metadata related to responses.
@@ -0,0 +1,88 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type altairLightClientBootstrapJSON struct {
+	Data *altair.LightClientBootstrap `json:"data"`
+}
+
+type capellaLightClientBootstrapJSON struct {
+	Data *capella.LightClientBootstrap `json:"data"`
+}
+
+type denebLightClientBootstrapJSON struct {
+	Data *deneb.LightClientBootstrap `json:"data"`
+}
+
+// LightClientBootstrap fetches the light client bootstrap for the given block root.
+// N.B if a light client bootstrap is not available this will return nil without an error.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", blockRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client bootstrap")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var dataBodyReader bytes.Buffer
+	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
+	var metadata responseMetadata
+	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	res := &spec.VersionedLightClientBootstrap{
+		Version: metadata.Version,
+	}
+
+	switch metadata.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		var resp altairLightClientBootstrapJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse altair light client bootstrap")
+		}
+		res.Altair = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaLightClientBootstrapJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella light client bootstrap")
+		}
+		res.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebLightClientBootstrapJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb light client bootstrap")
+		}
+		res.Deneb = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled light client bootstrap version %s", metadata.Version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newLightClientService creates a service that serves the given data with the
+// given version from the given path, and everything else from a mock server.
+func newLightClientService(ctx context.Context, t *testing.T, path string, version spec.DataVersion, data interface{}) (client.Service, *string) {
+	t.Helper()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var query string
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/light_client/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path != path {
+			// Act as a node without the requested data.
+			nethttp.NotFound(w, r)
+			return
+		}
+		query = r.URL.RawQuery
+		var resp interface{} = map[string]interface{}{
+			"version": &version,
+			"data":    data,
+		}
+		if items, isItems := data.([]interface{}); isItems {
+			// Lists of items are versioned individually.
+			versionedItems := make([]map[string]interface{}, len(items))
+			for i := range items {
+				versionedItems[i] = map[string]interface{}{
+					"version": &version,
+					"data":    items[i],
+				}
+			}
+			resp = versionedItems
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(httpServer.Close)
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	return service, &query
+}
+
+func TestLightClientBootstrap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bootstrap := &altair.LightClientBootstrap{
+		Header: &altair.LightClientHeader{
+			Beacon: &phase0.BeaconBlockHeader{
+				Slot: 12345,
+			},
+		},
+		CurrentSyncCommittee: &altair.SyncCommittee{
+			Pubkeys: make([]phase0.BLSPubKey, 512),
+		},
+		CurrentSyncCommitteeBranch: make([]phase0.Root, 5),
+	}
+	blockRoot := phase0.Root{0x01}
+	service, _ := newLightClientService(ctx, t, "/eth/v1/beacon/light_client/bootstrap/0x0100000000000000000000000000000000000000000000000000000000000000", spec.DataVersionBellatrix, bootstrap)
+
+	res, err := service.(client.LightClientBootstrapProvider).LightClientBootstrap(ctx, blockRoot)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionBellatrix, res.Version)
+	header, err := res.Header()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12345), header.Slot)
+
+	// Unknown block root.
+	res, err = service.(client.LightClientBootstrapProvider).LightClientBootstrap(ctx, phase0.Root{0x02})
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type altairLightClientFinalityUpdateJSON struct {
+	Data *altair.LightClientFinalityUpdate `json:"data"`
+}
+
+type capellaLightClientFinalityUpdateJSON struct {
+	Data *capella.LightClientFinalityUpdate `json:"data"`
+}
+
+type denebLightClientFinalityUpdateJSON struct {
+	Data *deneb.LightClientFinalityUpdate `json:"data"`
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+// N.B if a light client finality update is not available this will return nil without an error.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/light_client/finality_update")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client finality update")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var dataBodyReader bytes.Buffer
+	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
+	var metadata responseMetadata
+	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	res := &spec.VersionedLightClientFinalityUpdate{
+		Version: metadata.Version,
+	}
+
+	switch metadata.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		var resp altairLightClientFinalityUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse altair light client finality update")
+		}
+		res.Altair = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaLightClientFinalityUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella light client finality update")
+		}
+		res.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebLightClientFinalityUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb light client finality update")
+		}
+		res.Deneb = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled light client finality update version %s", metadata.Version)
+	}
+
+	return res, nil
+}
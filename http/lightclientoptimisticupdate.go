@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type altairLightClientOptimisticUpdateJSON struct {
+	Data *altair.LightClientOptimisticUpdate `json:"data"`
+}
+
+type capellaLightClientOptimisticUpdateJSON struct {
+	Data *capella.LightClientOptimisticUpdate `json:"data"`
+}
+
+type denebLightClientOptimisticUpdateJSON struct {
+	Data *deneb.LightClientOptimisticUpdate `json:"data"`
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+// N.B if a light client optimistic update is not available this will return nil without an error.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/light_client/optimistic_update")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client optimistic update")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var dataBodyReader bytes.Buffer
+	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
+	var metadata responseMetadata
+	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	res := &spec.VersionedLightClientOptimisticUpdate{
+		Version: metadata.Version,
+	}
+
+	switch metadata.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		var resp altairLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse altair light client optimistic update")
+		}
+		res.Altair = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella light client optimistic update")
+		}
+		res.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb light client optimistic update")
+		}
+		res.Deneb = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled light client optimistic update version %s", metadata.Version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientOptimisticUpdate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	update := &altair.LightClientOptimisticUpdate{
+		AttestedHeader: &altair.LightClientHeader{
+			Beacon: &phase0.BeaconBlockHeader{
+				Slot: 100,
+			},
+		},
+		SyncAggregate: &altair.SyncAggregate{
+			SyncCommitteeBits: bitfield.NewBitvector512(),
+		},
+		SignatureSlot: 101,
+	}
+	service, _ := newLightClientService(ctx, t, "/eth/v1/beacon/light_client/optimistic_update", spec.DataVersionAltair, update)
+
+	res, err := service.(client.LightClientOptimisticUpdateProvider).LightClientOptimisticUpdate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionAltair, res.Version)
+	attestedHeader, err := res.AttestedHeader()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(100), attestedHeader.Slot)
+	signatureSlot, err := res.SignatureSlot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(101), signatureSlot)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type altairLightClientUpdateJSON struct {
+	Data *altair.LightClientUpdate `json:"data"`
+}
+
+type capellaLightClientUpdateJSON struct {
+	Data *capella.LightClientUpdate `json:"data"`
+}
+
+type denebLightClientUpdateJSON struct {
+	Data *deneb.LightClientUpdate `json:"data"`
+}
+
+// LightClientUpdates fetches light client updates for count sync committee
+// periods, starting with the given period.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error) {
+	if count == 0 {
+		return nil, errors.New("count must be greater than 0")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", startPeriod, count))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client updates")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	// Each element of the response carries its own version.
+	var items []json.RawMessage
+	if err := json.NewDecoder(respBodyReader).Decode(&items); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+
+	res := make([]*spec.VersionedLightClientUpdate, len(items))
+	for i := range items {
+		res[i], err = parseLightClientUpdate(items[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse light client update %d", i)
+		}
+	}
+
+	return res, nil
+}
+
+// parseLightClientUpdate parses a single versioned light client update.
+func parseLightClientUpdate(input []byte) (*spec.VersionedLightClientUpdate, error) {
+	var metadata responseMetadata
+	if err := json.Unmarshal(input, &metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse metadata")
+	}
+	res := &spec.VersionedLightClientUpdate{
+		Version: metadata.Version,
+	}
+
+	switch metadata.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		var resp altairLightClientUpdateJSON
+		if err := json.Unmarshal(input, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse altair light client update")
+		}
+		res.Altair = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaLightClientUpdateJSON
+		if err := json.Unmarshal(input, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella light client update")
+		}
+		res.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebLightClientUpdateJSON
+		if err := json.Unmarshal(input, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb light client update")
+		}
+		res.Deneb = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled light client update version %s", metadata.Version)
+	}
+
+	return res, nil
+}
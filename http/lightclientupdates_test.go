@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientUpdates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := make([]interface{}, 2)
+	for i := range updates {
+		updates[i] = &altair.LightClientUpdate{
+			AttestedHeader: &altair.LightClientHeader{
+				Beacon: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(8192*(i+10) + 1),
+				},
+			},
+			NextSyncCommittee: &altair.SyncCommittee{
+				Pubkeys: make([]phase0.BLSPubKey, 512),
+			},
+			NextSyncCommitteeBranch: make([]phase0.Root, 5),
+			FinalizedHeader: &altair.LightClientHeader{
+				Beacon: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(8192 * (i + 10)),
+				},
+			},
+			FinalityBranch: make([]phase0.Root, 6),
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			SignatureSlot: phase0.Slot(8192*(i+10) + 2),
+		}
+	}
+	service, query := newLightClientService(ctx, t, "/eth/v1/beacon/light_client/updates", spec.DataVersionAltair, updates)
+
+	tests := []struct {
+		name        string
+		startPeriod uint64
+		count       uint64
+		err         string
+	}{
+		{
+			name: "CountZero",
+			err:  "count must be greater than 0",
+		},
+		{
+			name:        "Good",
+			startPeriod: 10,
+			count:       2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.LightClientUpdatesProvider).LightClientUpdates(ctx, test.startPeriod, test.count)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "start_period=10&count=2", *query)
+			require.Len(t, res, len(updates))
+			for i := range res {
+				require.Equal(t, spec.DataVersionAltair, res[i].Version)
+				finalizedHeader, err := res[i].FinalizedHeader()
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(8192*(i+10)), finalizedHeader.Slot)
+				signatureSlot, err := res[i].SignatureSlot()
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(8192*(i+10)+2), signatureSlot)
+			}
+		})
+	}
+}
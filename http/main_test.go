@@ -26,7 +26,14 @@ var timeout = 60 * time.Second
 
 func TestMain(m *testing.M) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
-	if os.Getenv("HTTP_ADDRESS") != "" {
-		os.Exit(m.Run())
+	os.Exit(m.Run())
+}
+
+// requireLiveNode skips tests that require a beacon node if one is not available.
+func requireLiveNode(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("HTTP_ADDRESS") == "" {
+		t.Skip("HTTP_ADDRESS not set")
 	}
 }
@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var coalescedRequestsMetric *prometheus.CounterVec
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if coalescedRequestsMetric != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	coalescedRequestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http",
+		Name:      "coalesced_requests_total",
+		Help:      "Number of requests eligible for coalescing, by whether they were sent or shared an in-flight request",
+	}, []string{"result"})
+	if err := prometheus.Register(coalescedRequestsMetric); err != nil {
+		return errors.Wrap(err, "failed to register coalesced_requests_total")
+	}
+
+	return nil
+}
+
+func monitorRequestCoalesced(shared bool) {
+	if coalescedRequestsMetric != nil {
+		if shared {
+			coalescedRequestsMetric.WithLabelValues("shared").Inc()
+		} else {
+			coalescedRequestsMetric.WithLabelValues("sent").Inc()
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context) (api.NodeHealth, error) {
+	// The health endpoint conveys its information through the status code, so
+	// we cannot use the standard get call.
+	statusCode, data, err := s.getResponse(ctx, "/eth/v1/node/health")
+	if err != nil {
+		return api.NodeHealthUnknown, errors.Wrap(err, "failed to request node health")
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+		return api.NodeHealthReady, nil
+	case http.StatusPartialContent:
+		return api.NodeHealthSyncing, nil
+	case http.StatusServiceUnavailable:
+		return api.NodeHealthNotInitialized, nil
+	default:
+		return api.NodeHealthUnknown, Error{
+			Method:     http.MethodGet,
+			StatusCode: statusCode,
+			Endpoint:   "/eth/v1/node/health",
+			Data:       data,
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		syncDistance phase0.Slot
+		fault        *server.Fault
+		health       api.NodeHealth
+		err          string
+	}{
+		{
+			name:   "Ready",
+			health: api.NodeHealthReady,
+		},
+		{
+			name:         "Syncing",
+			syncDistance: 10,
+			health:       api.NodeHealthSyncing,
+		},
+		{
+			name:   "NotInitialized",
+			fault:  &server.Fault{StatusCode: nethttp.StatusServiceUnavailable},
+			health: api.NodeHealthNotInitialized,
+		},
+		{
+			name:   "NotFound",
+			fault:  &server.Fault{StatusCode: nethttp.StatusNotFound, Message: "not found"},
+			health: api.NodeHealthUnknown,
+			err:    "GET failed with status 404",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv.ClearFaults()
+			srv.Chain().SetSyncDistance(test.syncDistance)
+			if test.fault != nil {
+				srv.AddFault("/eth/v1/node/health", *test.fault)
+			}
+
+			health, err := service.(client.NodeHealthProvider).NodeHealth(ctx)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.health, health)
+		})
+	}
+}
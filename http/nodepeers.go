@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type nodePeersJSON struct {
+	Data []*api.Peer `json:"data"`
+}
+
+type nodePeerJSON struct {
+	Data *api.Peer `json:"data"`
+}
+
+// NodePeers provides the peers of the node.
+// states and directions are optional filters; if empty then peers in all states and directions are returned.
+func (s *Service) NodePeers(ctx context.Context, states []string, directions []string) ([]*api.Peer, error) {
+	for _, state := range states {
+		if !api.PeerStates[state] {
+			return nil, fmt.Errorf("invalid peer state %s", state)
+		}
+	}
+	for _, direction := range directions {
+		if !api.PeerDirections[direction] {
+			return nil, fmt.Errorf("invalid peer direction %s", direction)
+		}
+	}
+
+	filters := make([]string, 0, 2)
+	if len(states) > 0 {
+		filters = append(filters, fmt.Sprintf("state=%s", strings.Join(states, "&state=")))
+	}
+	if len(directions) > 0 {
+		filters = append(filters, fmt.Sprintf("direction=%s", strings.Join(directions, "&direction=")))
+	}
+	endpoint := "/eth/v1/node/peers"
+	if len(filters) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, strings.Join(filters, "&"))
+	}
+
+	respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request node peers")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain node peers")
+	}
+
+	var resp nodePeersJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse node peers")
+	}
+	return resp.Data, nil
+}
+
+// NodePeer provides a single peer of the node.
+// If the peer is not known to the node then nil is returned.
+func (s *Service) NodePeer(ctx context.Context, peerID string) (*api.Peer, error) {
+	if peerID == "" {
+		return nil, errors.New("no peer ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/node/peers/%s", url.PathEscape(peerID)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request node peer")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp nodePeerJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse node peer")
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePeers(t *testing.T) {
+	requireLiveNode(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		states     []string
+		directions []string
+		err        string
+	}{
+		{
+			name: "All",
+		},
+		{
+			name:   "Connected",
+			states: []string{"connected"},
+		},
+		{
+			name:       "ConnectedOutbound",
+			states:     []string{"connected"},
+			directions: []string{"outbound"},
+		},
+		{
+			name:   "StateInvalid",
+			states: []string{"invalid"},
+			err:    "invalid peer state invalid",
+		},
+		{
+			name:       "DirectionInvalid",
+			directions: []string{"invalid"},
+			err:        "invalid peer direction invalid",
+		},
+	}
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			peers, err := service.(client.NodePeersProvider).NodePeers(ctx, test.states, test.directions)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			for _, peer := range peers {
+				if len(test.states) > 0 {
+					require.Contains(t, test.states, peer.State)
+				}
+				if len(test.directions) > 0 {
+					require.Contains(t, test.directions, peer.Direction)
+				}
+			}
+		})
+	}
+}
+
+func TestNodePeer(t *testing.T) {
+	requireLiveNode(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	peers, err := service.(client.NodePeersProvider).NodePeers(ctx, []string{"connected"}, nil)
+	require.NoError(t, err)
+	if len(peers) == 0 {
+		t.Skip("no connected peers")
+	}
+
+	peer, err := service.(client.NodePeersProvider).NodePeer(ctx, peers[0].PeerID)
+	require.NoError(t, err)
+	require.NotNil(t, peer)
+	require.Equal(t, peers[0].PeerID, peer.PeerID)
+}
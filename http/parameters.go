@@ -14,19 +14,25 @@
 package http
 
 import (
+	"net/http"
 	"time"
 
+	"github.com/jefmcl/go-eth2-client/metrics"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
 	logLevel        zerolog.Level
+	monitor         metrics.Service
 	address         string
 	timeout         time.Duration
 	indexChunkSize  int
 	pubKeyChunkSize int
 	extraHeaders    map[string]string
+	transport       http.RoundTripper
+	coalesce        bool
+	stateFallback   bool
 }
 
 // Parameter is the interface for service parameters.
@@ -47,6 +53,13 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithMonitor sets the monitor for the service.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -82,6 +95,31 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithTransport sets the transport used for all HTTP requests, including the
+// events stream.  If not supplied a standard transport is used.
+func WithTransport(transport http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// WithRequestCoalescing sets whether concurrent identical GET requests are
+// coalesced, with all callers sharing the result of a single request.
+func WithRequestCoalescing(coalesce bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.coalesce = coalesce
+	})
+}
+
+// WithStateFallback sets whether data that the beacon node does not provide
+// through a dedicated endpoint is extracted from the full beacon state.
+// Each such request downloads the full state, which can be hundreds of megabytes.
+func WithStateFallback(stateFallback bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stateFallback = stateFallback
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -15,8 +15,6 @@ package http
 
 import (
 	"context"
-	"fmt"
-	"net"
 	"net/http"
 	"net/url"
 	"crypto/tls"
@@ -26,6 +24,7 @@ import (
 
 	eth2client "github.com/jefmcl/go-eth2-client"
 	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/internal/httpclient"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -42,12 +41,17 @@ type Service struct {
 	client  *http.Client
 	timeout time.Duration
 
+	// userTransport is the user-supplied transport, if any.
+	userTransport http.RoundTripper
+
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
 	genesis              *api.Genesis
 	genesisMutex         sync.RWMutex
 	spec                 map[string]interface{}
 	specMutex            sync.RWMutex
+	chainSpec            *api.ChainSpec
+	chainSpecMutex       sync.RWMutex
 	depositContract      *api.DepositContract
 	depositContractMutex sync.RWMutex
 	forkSchedule         []*phase0.Fork
@@ -62,6 +66,16 @@ type Service struct {
 
 	// Endpoint support.
 	connectedToDVTMiddleware bool
+	postUnsupported          map[string]bool
+	postUnsupportedMutex     sync.RWMutex
+	getUnsupported           map[string]bool
+	getUnsupportedMutex      sync.RWMutex
+	stateFallback            bool
+
+	// Coalescing of concurrent identical requests.
+	coalesceRequests bool
+	inflightMu       sync.Mutex
+	inflight         map[string]*inflightRequest
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -77,34 +91,26 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Wrap(err, "failed to register metrics")
+		}
+	}
+
 	// tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
 
     	// client := &http.Client{Transport: tr}
 
+	transport := httpclient.NewTransport(parameters.timeout, &tls.Config{InsecureSkipVerify: true})
+	if parameters.transport != nil {
+		transport = parameters.transport
+	}
 	client := &http.Client{
-		Timeout: parameters.timeout,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   parameters.timeout,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			MaxIdleConns:        64,
-			MaxConnsPerHost:     64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     600 * time.Second,
-		},
-	}
-
-	address := parameters.address
-	if !strings.HasPrefix(address, "http") {
-		address = fmt.Sprintf("http://%s", address)
-	}
-	if !strings.HasSuffix(address, "/") {
-		address = fmt.Sprintf("%s/", address)
-	}
-	base, err := url.Parse(address)
+		Timeout:   parameters.timeout,
+		Transport: transport,
+	}
+
+	base, err := httpclient.BaseURL(parameters.address)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
@@ -118,6 +124,12 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
 		extraHeaders:        parameters.extraHeaders,
+		userTransport:       parameters.transport,
+		coalesceRequests:    parameters.coalesce,
+		postUnsupported:     make(map[string]bool),
+		getUnsupported:      make(map[string]bool),
+		stateFallback:       parameters.stateFallback,
+		inflight:            make(map[string]*inflightRequest),
 	}
 
 	// Fetch static values to confirm the connection is good.
@@ -180,6 +192,9 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) {
 				s.specMutex.Lock()
 				s.spec = nil
 				s.specMutex.Unlock()
+				s.chainSpecMutex.Lock()
+				s.chainSpec = nil
+				s.chainSpecMutex.Unlock()
 				s.depositContractMutex.Lock()
 				s.depositContract = nil
 				s.depositContractMutex.Unlock()
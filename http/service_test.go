@@ -26,6 +26,8 @@ import (
 )
 
 func TestService(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -102,6 +104,8 @@ func TestService(t *testing.T) {
 }
 
 func TestInterfaces(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
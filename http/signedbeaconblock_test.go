@@ -24,6 +24,8 @@ import (
 )
 
 func TestSignedBeaconBlock(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
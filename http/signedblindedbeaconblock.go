@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1bellatrix "github.com/jefmcl/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1bellatrix.SignedBlindedBeaconBlock `json:"data"`
+}
+
+type capellaSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1capella.SignedBlindedBeaconBlock `json:"data"`
+}
+
+type denebSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1deneb.SignedBlindedBeaconBlock `json:"data"`
+}
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+// Blocks prior to bellatrix do not contain an execution payload and so have no
+// blinded form; for these api.ErrNotBlinded is returned, and SignedBeaconBlock
+// should be used to obtain them.
+// N.B if a signed blinded beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blinded_blocks/%s", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request signed blinded beacon block")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var dataBodyReader bytes.Buffer
+	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
+	var metadata responseMetadata
+	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	res := &api.VersionedSignedBlindedBeaconBlock{
+		Version: metadata.Version,
+	}
+
+	switch metadata.Version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair:
+		return nil, api.ErrNotBlinded
+	case spec.DataVersionBellatrix:
+		var resp bellatrixSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix signed blinded beacon block")
+		}
+		if resp.Data == nil {
+			return nil, errors.New("bellatrix signed blinded beacon block not returned")
+		}
+		res.Bellatrix = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella signed blinded beacon block")
+		}
+		if resp.Data == nil {
+			return nil, errors.New("capella signed blinded beacon block not returned")
+		}
+		res.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb signed blinded beacon block")
+		}
+		if resp.Data == nil {
+			return nil, errors.New("deneb signed blinded beacon block not returned")
+		}
+		res.Deneb = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", metadata.Version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBlindedBeaconBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == "/eth/v1/beacon/blinded_blocks/nodata" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"capella","data":null}`))
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	defer httpServer.Close()
+
+	root, err := srv.Chain().AddBlock(&spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Slot:       2,
+				ParentRoot: srv.Chain().Head(),
+				Body: &capella.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      []*phase0.Attestation{},
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: bitfield.NewBitvector512(),
+					},
+					ExecutionPayload: &capella.ExecutionPayload{
+						BlockNumber:  2,
+						ExtraData:    []byte{},
+						Transactions: []bellatrix.Transaction{{0x01, 0x02}, {0x03}},
+						Withdrawals: []*capella.Withdrawal{
+							{
+								Index:          1,
+								ValidatorIndex: 2,
+								Amount:         3,
+							},
+						},
+					},
+					BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		blockID string
+		err     string
+		errIs   error
+		root    *phase0.Root
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:    "Phase0",
+			blockID: "genesis",
+			errIs:   api.ErrNotBlinded,
+		},
+		{
+			name:    "NoData",
+			blockID: "nodata",
+			err:     "capella signed blinded beacon block not returned",
+		},
+		{
+			name:    "Capella",
+			blockID: root.String(),
+			root:    &root,
+		},
+		{
+			name:    "Unknown",
+			blockID: "100",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.SignedBlindedBeaconBlockProvider).SignedBlindedBeaconBlock(ctx, test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			if test.errIs != nil {
+				require.ErrorIs(t, err, test.errIs)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			if test.root == nil {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, spec.DataVersionCapella, res.Version)
+			// The blinded block must have the same root as the full block.
+			blockRoot, err := res.Root()
+			require.NoError(t, err)
+			require.Equal(t, *test.root, blockRoot)
+		})
+	}
+}
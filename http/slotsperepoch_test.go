@@ -24,6 +24,8 @@ import (
 )
 
 func TestSlotsPerEpoch(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -31,6 +31,8 @@ import (
 )
 
 func TestSpecConformance(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -25,6 +25,8 @@ import (
 )
 
 func TestSpec(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
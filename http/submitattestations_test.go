@@ -28,6 +28,8 @@ import (
 )
 
 func TestSubmitAttestations(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAttesterSlashing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	attestation := func(beaconBlockRoot phase0.Root) *phase0.IndexedAttestation {
+		return &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2, 3},
+			Data: &phase0.AttestationData{
+				Slot:            10,
+				Index:           0,
+				BeaconBlockRoot: beaconBlockRoot,
+				Source: &phase0.Checkpoint{
+					Epoch: 0,
+					Root:  phase0.Root{0x01},
+				},
+				Target: &phase0.Checkpoint{
+					Epoch: 1,
+					Root:  phase0.Root{0x02},
+				},
+			},
+			Signature: phase0.BLSSignature{0x03},
+		}
+	}
+	slashing := &phase0.AttesterSlashing{
+		Attestation1: attestation(phase0.Root{0x04}),
+		Attestation2: attestation(phase0.Root{0x05}),
+	}
+
+	require.NoError(t, service.(client.AttesterSlashingSubmitter).SubmitAttesterSlashing(ctx, slashing))
+
+	pool, err := service.(client.AttesterSlashingPoolProvider).AttesterSlashingPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*phase0.AttesterSlashing{slashing}, pool)
+}
@@ -30,6 +30,8 @@ import (
 )
 
 func TestSubmitBeaconBlock(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
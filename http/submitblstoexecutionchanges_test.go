@@ -27,6 +27,8 @@ import (
 )
 
 func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	requireLiveNode(t)
+
 	tests := []struct {
 		name string
 		ops  []*capella.SignedBLSToExecutionChange
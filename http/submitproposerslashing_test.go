@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitProposerSlashing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	slashing := &phase0.ProposerSlashing{
+		SignedHeader1: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          10,
+				ProposerIndex: 5,
+				ParentRoot:    phase0.Root{0x01},
+				StateRoot:     phase0.Root{0x02},
+				BodyRoot:      phase0.Root{0x03},
+			},
+			Signature: phase0.BLSSignature{0x04},
+		},
+		SignedHeader2: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          10,
+				ProposerIndex: 5,
+				ParentRoot:    phase0.Root{0x01},
+				StateRoot:     phase0.Root{0x02},
+				BodyRoot:      phase0.Root{0x05},
+			},
+			Signature: phase0.BLSSignature{0x06},
+		},
+	}
+
+	require.NoError(t, service.(client.ProposerSlashingSubmitter).SubmitProposerSlashing(ctx, slashing))
+
+	pool, err := service.(client.ProposerSlashingPoolProvider).ProposerSlashingPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*phase0.ProposerSlashing{slashing}, pool)
+}
@@ -29,6 +29,8 @@ import (
 )
 
 func TestSubmitValidatorRegistrations(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -26,6 +26,8 @@ import (
 )
 
 func TestSyncCommittee(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -56,6 +58,8 @@ func TestSyncCommittee(t *testing.T) {
 }
 
 func TestSyncCommitteeAtEpoch(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
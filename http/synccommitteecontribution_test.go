@@ -26,6 +26,8 @@ import (
 )
 
 func TestSyncCommitteeContribution(t *testing.T) {
+	requireLiveNode(t)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
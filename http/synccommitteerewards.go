@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type syncCommitteeRewardsJSON struct {
+	Data []*api.SyncCommitteeReward `json:"data"`
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given block.
+// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeReward, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	if len(validatorIndices) > s.indexChunkSize(ctx) {
+		return s.chunkedSyncCommitteeRewards(ctx, blockID, validatorIndices)
+	}
+
+	reqBody, err := validatorIndicesBody(validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/sync_committee/%s", blockID), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request sync committee rewards")
+	}
+
+	var resp syncCommitteeRewardsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sync committee rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no sync committee rewards returned")
+	}
+
+	return resp.Data, nil
+}
+
+// chunkedSyncCommitteeRewards obtains the sync committee rewards a chunk at a time.
+func (s *Service) chunkedSyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeReward, error) {
+	res := make([]*api.SyncCommitteeReward, 0)
+	indexChunkSize := s.indexChunkSize(ctx)
+	for i := 0; i < len(validatorIndices); i += indexChunkSize {
+		chunkStart := i
+		chunkEnd := i + indexChunkSize
+		if len(validatorIndices) < chunkEnd {
+			chunkEnd = len(validatorIndices)
+		}
+		chunk := validatorIndices[chunkStart:chunkEnd]
+		chunkRes, err := s.SyncCommitteeRewards(ctx, blockID, chunk)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chunk")
+		}
+		res = append(res, chunkRes...)
+	}
+	return res, nil
+}
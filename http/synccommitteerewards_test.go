@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeRewards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var requests int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/rewards/sync_committee/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		var ids []string
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+			return
+		}
+		rewards := make([]string, len(ids))
+		for i := range ids {
+			rewards[i] = fmt.Sprintf(`{"validator_index":"%s","reward":"-100"}`, ids[i])
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(rewards, ","))
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithIndexChunkSize(2),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		blockID          string
+		validatorIndices []phase0.ValidatorIndex
+		requests         int32
+		err              string
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:             "Single",
+			blockID:          "head",
+			validatorIndices: []phase0.ValidatorIndex{1},
+			requests:         1,
+		},
+		{
+			name:             "Chunked",
+			blockID:          "head",
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3, 4, 5},
+			requests:         3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			atomic.StoreInt32(&requests, 0)
+			rewards, err := service.(client.SyncCommitteeRewardsProvider).SyncCommitteeRewards(ctx, test.blockID, test.validatorIndices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.requests, atomic.LoadInt32(&requests))
+			require.Len(t, rewards, len(test.validatorIndices))
+			for i := range test.validatorIndices {
+				require.Equal(t, test.validatorIndices[i], rewards[i].ValidatorIndex)
+				require.Equal(t, int64(-100), rewards[i].Reward)
+			}
+		})
+	}
+}
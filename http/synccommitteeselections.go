@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type syncCommitteeSelectionsJSON struct {
+	Data []*api.SyncCommitteeSelection `json:"data"`
+}
+
+// SyncCommitteeSelections submits partial sync committee selection proofs to distributed validator
+// middleware and returns the aggregated selection proofs.
+// If not connected to distributed validator middleware the supplied selections are returned unaltered.
+func (s *Service) SyncCommitteeSelections(ctx context.Context, selections []*api.SyncCommitteeSelection) ([]*api.SyncCommitteeSelection, error) {
+	if !s.connectedToDVTMiddleware {
+		// Without middleware the selection proof from the validator is complete.
+		return selections, nil
+	}
+
+	reqBodyReader := new(bytes.Buffer)
+	if err := json.NewEncoder(reqBodyReader).Encode(selections); err != nil {
+		return nil, errors.Wrap(err, "failed to encode sync committee selections")
+	}
+
+	respBodyReader, err := s.post(ctx, "/eth/v1/validator/sync_committee_selections", reqBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request sync committee selections")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain sync committee selections")
+	}
+
+	var resp syncCommitteeSelectionsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sync committee selections")
+	}
+	if len(resp.Data) != len(selections) {
+		return nil, errors.New("incorrect number of sync committee selections returned")
+	}
+
+	return resp.Data, nil
+}
@@ -14,9 +14,11 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	api "github.com/jefmcl/go-eth2-client/api/v1"
@@ -37,16 +39,71 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.New("no state ID specified")
 	}
 
-	if len(validatorIndices) > s.indexChunkSize(ctx) {
-		return s.chunkedValidatorBalances(ctx, stateID, validatorIndices)
+	ids := validatorIndexIDs(validatorIndices)
+
+	var postErr error
+	if s.postSupported("validator_balances") {
+		res, err := s.postValidatorBalances(ctx, stateID, ids)
+		if err == nil {
+			return res, nil
+		}
+		if !s.checkPostSupport("validator_balances", err) {
+			return nil, err
+		}
+		postErr = err
 	}
 
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", stateID)
-	if len(validatorIndices) != 0 {
-		ids := make([]string, len(validatorIndices))
-		for i := range validatorIndices {
-			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	chunks := chunkIDs(ids, s.indexChunkSize(ctx))
+	chunkRes := make([][]*api.ValidatorBalance, len(chunks))
+	err := fetchChunks(ctx, len(chunks), func(ctx context.Context, chunk int) error {
+		var err error
+		chunkRes[chunk], err = s.getValidatorBalances(ctx, stateID, chunks[chunk])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if postErr != nil {
+		s.confirmPostUnsupported("validator_balances", postErr)
+	}
+
+	res := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	for _, validatorBalances := range chunkRes {
+		for _, validatorBalance := range validatorBalances {
+			res[validatorBalance.Index] = validatorBalance.Balance
 		}
+	}
+	return res, nil
+}
+
+// postValidatorBalances obtains validator balances using a POST request.
+func (s *Service) postValidatorBalances(ctx context.Context, stateID string, ids []string) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	reqBodyReader := new(bytes.Buffer)
+	if err := json.NewEncoder(reqBodyReader).Encode(ids); err != nil {
+		return nil, errors.Wrap(err, "failed to encode request")
+	}
+
+	respBodyReader, err := s.postQuery(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", stateID), reqBodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorBalances, err := decodeValidatorBalances(respBodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[phase0.ValidatorIndex]phase0.Gwei, len(validatorBalances))
+	for _, validatorBalance := range validatorBalances {
+		res[validatorBalance.Index] = validatorBalance.Balance
+	}
+	return res, nil
+}
+
+// getValidatorBalances obtains validator balances using a GET request.
+func (s *Service) getValidatorBalances(ctx context.Context, stateID string, ids []string) ([]*api.ValidatorBalance, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", stateID)
+	if len(ids) != 0 {
 		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
 	}
 
@@ -58,6 +115,11 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.New("failed to obtain validator balances")
 	}
 
+	return decodeValidatorBalances(respBodyReader)
+}
+
+// decodeValidatorBalances decodes a validator balances response.
+func decodeValidatorBalances(respBodyReader io.Reader) ([]*api.ValidatorBalance, error) {
 	var validatorBalancesJSON validatorBalancesJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&validatorBalancesJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validator balances")
@@ -66,31 +128,5 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.New("no validator balances returned")
 	}
 
-	res := make(map[phase0.ValidatorIndex]phase0.Gwei)
-	for _, validatorBalance := range validatorBalancesJSON.Data {
-		res[validatorBalance.Index] = validatorBalance.Balance
-	}
-	return res, nil
-}
-
-// chunkedValidatorBalances obtains the validator balances a chunk at a time.
-func (s *Service) chunkedValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
-	res := make(map[phase0.ValidatorIndex]phase0.Gwei)
-	indexChunkSize := s.indexChunkSize(ctx)
-	for i := 0; i < len(validatorIndices); i += indexChunkSize {
-		chunkStart := i
-		chunkEnd := i + indexChunkSize
-		if len(validatorIndices) < chunkEnd {
-			chunkEnd = len(validatorIndices)
-		}
-		chunk := validatorIndices[chunkStart:chunkEnd]
-		chunkRes, err := s.ValidatorBalances(ctx, stateID, chunk)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
-	}
-	return res, nil
+	return validatorBalancesJSON.Data, nil
 }
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorLivenessJSON struct {
+	Data []*api.ValidatorLiveness `json:"data"`
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Service) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+	if len(validatorIndices) == 0 {
+		return nil, errors.New("no validator indices specified")
+	}
+
+	if len(validatorIndices) > s.indexChunkSize(ctx) {
+		return s.chunkedValidatorLiveness(ctx, epoch, validatorIndices)
+	}
+
+	reqBody, err := validatorIndicesBody(validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator liveness")
+	}
+
+	var resp validatorLivenessJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator liveness")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no validator liveness returned")
+	}
+
+	return resp.Data, nil
+}
+
+// chunkedValidatorLiveness obtains the validator liveness in chunks, fetching
+// the chunks concurrently.
+func (s *Service) chunkedValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+	indexChunkSize := s.indexChunkSize(ctx)
+	chunks := make([][]phase0.ValidatorIndex, 0, (len(validatorIndices)+indexChunkSize-1)/indexChunkSize)
+	for i := 0; i < len(validatorIndices); i += indexChunkSize {
+		chunkEnd := i + indexChunkSize
+		if len(validatorIndices) < chunkEnd {
+			chunkEnd = len(validatorIndices)
+		}
+		chunks = append(chunks, validatorIndices[i:chunkEnd])
+	}
+
+	chunkRes := make([][]*api.ValidatorLiveness, len(chunks))
+	err := fetchChunks(ctx, len(chunks), func(ctx context.Context, chunk int) error {
+		var err error
+		chunkRes[chunk], err = s.ValidatorLiveness(ctx, epoch, chunks[chunk])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*api.ValidatorLiveness, 0, len(validatorIndices))
+	for _, liveness := range chunkRes {
+		res = append(res, liveness...)
+	}
+	return res, nil
+}
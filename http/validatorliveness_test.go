@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorLiveness(t *testing.T) {
+	requireLiveNode(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name             string
+		validatorIndices []phase0.ValidatorIndex
+		err              string
+	}{
+		{
+			name: "IndicesMissing",
+			err:  "no validator indices specified",
+		},
+		{
+			name:             "Single",
+			validatorIndices: []phase0.ValidatorIndex{1},
+		},
+		{
+			name:             "Multiple",
+			validatorIndices: []phase0.ValidatorIndex{1, 2, 3},
+		},
+	}
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	genesis, err := service.(client.GenesisProvider).Genesis(ctx)
+	require.NoError(t, err)
+	slotsPerEpoch, err := service.(client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	slotDuration, err := service.(client.SlotDurationProvider).SlotDuration(ctx)
+	require.NoError(t, err)
+	epoch := phase0.Epoch(uint64(time.Since(genesis.GenesisTime)/slotDuration) / slotsPerEpoch)
+	if epoch > 0 {
+		epoch--
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			liveness, err := service.(client.ValidatorLivenessProvider).ValidatorLiveness(ctx, epoch, test.validatorIndices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, liveness, len(test.validatorIndices))
+		})
+	}
+}
+
+func TestValidatorLivenessChunked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	var inflight int32
+	var maxInflight int32
+	httpServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/validator/liveness/") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		current := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			highest := atomic.LoadInt32(&maxInflight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInflight, highest, current) {
+				break
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		var ids []string
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+			return
+		}
+		data := make([]map[string]interface{}, len(ids))
+		for i := range ids {
+			data[i] = map[string]interface{}{"index": ids[i], "is_live": true}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer httpServer.Close()
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(timeout),
+		http.WithIndexChunkSize(2),
+	)
+	require.NoError(t, err)
+
+	validatorIndices := []phase0.ValidatorIndex{0, 1, 2, 3, 4, 5, 6}
+	res, err := service.(client.ValidatorLivenessProvider).ValidatorLiveness(ctx, 1, validatorIndices)
+	require.NoError(t, err)
+	require.Len(t, res, len(validatorIndices))
+	for i := range validatorIndices {
+		require.Equal(t, validatorIndices[i], res[i].Index)
+	}
+	// Chunks are fetched concurrently.
+	require.Greater(t, atomic.LoadInt32(&maxInflight), int32(1))
+}
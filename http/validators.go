@@ -14,9 +14,11 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	api "github.com/jefmcl/go-eth2-client/api/v1"
@@ -75,17 +77,106 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return nil, errors.New("no state ID specified")
 	}
 
-	if len(validatorIndices) > s.indexChunkSize(ctx) {
-		return s.chunkedValidators(ctx, stateID, validatorIndices)
+	return s.validators(ctx, stateID, validatorIndexIDs(validatorIndices), nil, s.indexChunkSize(ctx))
+}
+
+// validatorsRequestJSON is the body of a POST request for validators.
+type validatorsRequestJSON struct {
+	IDs      []string `json:"ids,omitempty"`
+	Statuses []string `json:"statuses,omitempty"`
+}
+
+// validators obtains the validators matching the given IDs and states.
+// POST is used if the node supports it, otherwise the IDs are split in to
+// chunks of the given size and requested concurrently using GET.
+func (s *Service) validators(ctx context.Context,
+	stateID string,
+	ids []string,
+	validatorStates []api.ValidatorState,
+	chunkSize int,
+) (
+	map[phase0.ValidatorIndex]*api.Validator,
+	error,
+) {
+	statuses := make([]string, len(validatorStates))
+	for i := range validatorStates {
+		statuses[i] = validatorStates[i].String()
 	}
 
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
-	if len(validatorIndices) != 0 {
-		ids := make([]string, len(validatorIndices))
-		for i := range validatorIndices {
-			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	var postErr error
+	if s.postSupported("validators") {
+		res, err := s.postValidators(ctx, stateID, ids, statuses)
+		if err == nil {
+			return res, nil
+		}
+		if !s.checkPostSupport("validators", err) {
+			return nil, err
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
+		postErr = err
+	}
+
+	chunks := chunkIDs(ids, chunkSize)
+	chunkRes := make([][]*api.Validator, len(chunks))
+	err := fetchChunks(ctx, len(chunks), func(ctx context.Context, chunk int) error {
+		var err error
+		chunkRes[chunk], err = s.getValidators(ctx, stateID, chunks[chunk], statuses)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if postErr != nil {
+		s.confirmPostUnsupported("validators", postErr)
+	}
+
+	res := make(map[phase0.ValidatorIndex]*api.Validator)
+	for _, validators := range chunkRes {
+		for _, validator := range validators {
+			res[validator.Index] = validator
+		}
+	}
+	return res, nil
+}
+
+// postValidators obtains validators using a POST request.
+func (s *Service) postValidators(ctx context.Context, stateID string, ids []string, statuses []string) (map[phase0.ValidatorIndex]*api.Validator, error) {
+	reqBodyReader := new(bytes.Buffer)
+	if err := json.NewEncoder(reqBodyReader).Encode(&validatorsRequestJSON{
+		IDs:      ids,
+		Statuses: statuses,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to encode request")
+	}
+
+	respBodyReader, err := s.postQuery(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID), reqBodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := decodeValidators(respBodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[phase0.ValidatorIndex]*api.Validator, len(validators))
+	for _, validator := range validators {
+		res[validator.Index] = validator
+	}
+	return res, nil
+}
+
+// getValidators obtains validators using a GET request.
+func (s *Service) getValidators(ctx context.Context, stateID string, ids []string, statuses []string) ([]*api.Validator, error) {
+	filters := make([]string, 0, 2)
+	if len(ids) > 0 {
+		filters = append(filters, fmt.Sprintf("id=%s", strings.Join(ids, ",")))
+	}
+	if len(statuses) > 0 {
+		filters = append(filters, fmt.Sprintf("status=%s", strings.Join(statuses, ",")))
+	}
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
 	}
 
 	respBodyReader, err := s.get(ctx, url)
@@ -96,6 +187,11 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return nil, errors.New("failed to obtain validators")
 	}
 
+	return decodeValidators(respBodyReader)
+}
+
+// decodeValidators decodes a validators response.
+func decodeValidators(respBodyReader io.Reader) ([]*api.Validator, error) {
 	var validatorsJSON validatorsJSON
 	if err := json.NewDecoder(respBodyReader).Decode(&validatorsJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
@@ -104,31 +200,23 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return nil, errors.New("no validators returned")
 	}
 
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	for _, validator := range validatorsJSON.Data {
-		res[validator.Index] = validator
+	return validatorsJSON.Data, nil
+}
+
+// validatorIndexIDs converts validator indices to IDs for requests.
+func validatorIndexIDs(validatorIndices []phase0.ValidatorIndex) []string {
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
 	}
-	return res, nil
+	return ids
 }
 
-// chunkedValidators obtains the validators a chunk at a time.
-func (s *Service) chunkedValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*api.Validator, error) {
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	indexChunkSize := s.indexChunkSize(ctx)
-	for i := 0; i < len(validatorIndices); i += indexChunkSize {
-		chunkStart := i
-		chunkEnd := i + indexChunkSize
-		if len(validatorIndices) < chunkEnd {
-			chunkEnd = len(validatorIndices)
-		}
-		chunk := validatorIndices[chunkStart:chunkEnd]
-		chunkRes, err := s.Validators(ctx, stateID, chunk)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
+// validatorPubKeyIDs converts validator public keys to IDs for requests.
+func validatorPubKeyIDs(validatorPubKeys []phase0.BLSPubKey) []string {
+	ids := make([]string, len(validatorPubKeys))
+	for i := range validatorPubKeys {
+		ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
 	}
-	return res, nil
+	return ids
 }
@@ -15,8 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"strings"
 
 	api "github.com/jefmcl/go-eth2-client/api/v1"
@@ -24,10 +22,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-type validatorsByPubKeyJSON struct {
-	Data []*api.Validator `json:"data"`
-}
-
 // pubKeyChunkSizes defines the per-beacon-node size of a public key chunk.
 // A request should be no more than 8,000 bytes to work with all currently-supported clients.
 // A public key, including 0x header and comma separator, takes up 99 bytes.
@@ -76,60 +70,5 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 		return nil, errors.New("no state ID specified")
 	}
 
-	if len(validatorPubKeys) > s.pubKeyChunkSize(ctx) {
-		return s.chunkedValidatorsByPubKey(ctx, stateID, validatorPubKeys)
-	}
-
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
-	if len(validatorPubKeys) != 0 {
-		ids := make([]string, len(validatorPubKeys))
-		for i := range validatorPubKeys {
-			ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
-		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
-	}
-
-	respBodyReader, err := s.get(ctx, url)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to request validators")
-	}
-	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
-	}
-
-	var validatorsByPubKeyJSON validatorsByPubKeyJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsByPubKeyJSON); err != nil {
-		return nil, errors.Wrap(err, "failed to parse validators")
-	}
-	if validatorsByPubKeyJSON.Data == nil {
-		return nil, errors.New("no validators returned")
-	}
-
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	for _, validator := range validatorsByPubKeyJSON.Data {
-		res[validator.Index] = validator
-	}
-	return res, nil
-}
-
-// chunkedValidatorsByPubKey obtains the validators a chunk at a time.
-func (s *Service) chunkedValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	pubKeyChunkSize := s.pubKeyChunkSize(ctx)
-	for i := 0; i < len(validatorPubKeys); i += pubKeyChunkSize {
-		chunkStart := i
-		chunkEnd := i + pubKeyChunkSize
-		if len(validatorPubKeys) < chunkEnd {
-			chunkEnd = len(validatorPubKeys)
-		}
-		chunk := validatorPubKeys[chunkStart:chunkEnd]
-		chunkRes, err := s.ValidatorsByPubKey(ctx, stateID, chunk)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
-	}
-	return res, nil
+	return s.validators(ctx, stateID, validatorPubKeyIDs(validatorPubKeys), nil, s.pubKeyChunkSize(ctx))
 }
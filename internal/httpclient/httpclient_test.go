@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package httpclient_test
+
+import (
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/internal/httpclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		expected string
+	}{
+		{
+			name:     "HostPort",
+			address:  "localhost:5052",
+			expected: "http://localhost:5052/",
+		},
+		{
+			name:     "HTTPS",
+			address:  "https://localhost:5052",
+			expected: "https://localhost:5052/",
+		},
+		{
+			name:     "TrailingSlash",
+			address:  "http://localhost:5052/",
+			expected: "http://localhost:5052/",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			base, err := httpclient.BaseURL(test.address)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, base.String())
+		})
+	}
+}
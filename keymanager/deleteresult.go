@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DeleteStatus defines the result of deleting an individual key.
+type DeleteStatus int
+
+const (
+	// DeleteStatusDeleted means the key was deleted.
+	DeleteStatusDeleted DeleteStatus = iota
+	// DeleteStatusNotActive means the key was not present, but slashing
+	// protection data for it was.
+	DeleteStatusNotActive
+	// DeleteStatusNotFound means neither the key nor slashing protection
+	// data for it was present.
+	DeleteStatusNotFound
+	// DeleteStatusError means the key could not be deleted.
+	DeleteStatusError
+)
+
+var deleteStatusStrings = [...]string{
+	"deleted",
+	"not_active",
+	"not_found",
+	"error",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DeleteStatus) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", deleteStatusStrings[*d])), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeleteStatus) UnmarshalJSON(input []byte) error {
+	var err error
+	switch strings.ToLower(string(input)) {
+	case `"deleted"`:
+		*d = DeleteStatusDeleted
+	case `"not_active"`:
+		*d = DeleteStatusNotActive
+	case `"not_found"`:
+		*d = DeleteStatusNotFound
+	case `"error"`:
+		*d = DeleteStatusError
+	default:
+		err = fmt.Errorf("unrecognised delete status %s", string(input))
+	}
+	return err
+}
+
+func (d DeleteStatus) String() string {
+	return deleteStatusStrings[d]
+}
+
+// DeleteResult is the result of deleting an individual key.
+type DeleteResult struct {
+	// Status is the status of the deletion.
+	Status DeleteStatus
+	// Message is additional information about the status, if any.
+	Message string
+}
+
+// deleteResultJSON is the spec representation of the struct.
+type deleteResultJSON struct {
+	Status  *DeleteStatus `json:"status"`
+	Message string        `json:"message,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DeleteResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&deleteResultJSON{
+		Status:  &d.Status,
+		Message: d.Message,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeleteResult) UnmarshalJSON(input []byte) error {
+	var data deleteResultJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Status == nil {
+		return errors.New("status missing")
+	}
+	d.Status = *data.Status
+	d.Message = data.Message
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DeleteResult) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// DeleteKeystoresResult is the result of deleting keystores.
+type DeleteKeystoresResult struct {
+	// Results are the results of deleting each keystore, in the order requested.
+	Results []*DeleteResult
+	// SlashingProtection is the slashing protection data for the requested
+	// keys in EIP-3076 interchange format.
+	SlashingProtection string
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DeleteGasLimit deletes the gas limit of the given validator,
+// returning it to the validator client's default.
+func (s *Service) DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	if _, err := s.delete(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), nil); err != nil {
+		return errors.Wrap(err, "failed to delete gas limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type deleteKeysRequestJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type deleteKeystoresJSON struct {
+	Data               []*keymanager.DeleteResult `json:"data"`
+	SlashingProtection string                     `json:"slashing_protection"`
+}
+
+// DeleteKeystores deletes the keystores for the given public keys, returning the
+// slashing protection data for the keys in EIP-3076 interchange format.
+func (s *Service) DeleteKeystores(ctx context.Context, pubKeys []phase0.BLSPubKey) (*keymanager.DeleteKeystoresResult, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys supplied")
+	}
+
+	reqBody, err := deleteKeysRequest(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := s.delete(ctx, "/eth/v1/keystores", reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete keystores")
+	}
+
+	var resp deleteKeystoresJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse delete results")
+	}
+	if len(resp.Data) != len(pubKeys) {
+		return nil, errors.New("incorrect number of delete results returned")
+	}
+	if resp.SlashingProtection == "" {
+		return nil, errors.New("slashing protection data not returned")
+	}
+
+	return &keymanager.DeleteKeystoresResult{
+		Results:            resp.Data,
+		SlashingProtection: resp.SlashingProtection,
+	}, nil
+}
+
+// deleteKeysRequest creates the body of a request to delete keys.
+func deleteKeysRequest(pubKeys []phase0.BLSPubKey) ([]byte, error) {
+	req := &deleteKeysRequestJSON{
+		Pubkeys: make([]string, len(pubKeys)),
+	}
+	for i := range pubKeys {
+		req.Pubkeys[i] = fmt.Sprintf("%#x", pubKeys[i])
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return reqBody, nil
+}
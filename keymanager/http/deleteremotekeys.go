@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type deleteResultsJSON struct {
+	Data []*keymanager.DeleteResult `json:"data"`
+}
+
+// DeleteRemoteKeys deletes the remote keys for the given public keys.
+func (s *Service) DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*keymanager.DeleteResult, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys supplied")
+	}
+
+	reqBody, err := deleteKeysRequest(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := s.delete(ctx, "/eth/v1/remotekeys", reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete remote keys")
+	}
+
+	var resp deleteResultsJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse delete results")
+	}
+	if len(resp.Data) != len(pubKeys) {
+		return nil, errors.New("incorrect number of delete results returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type feeRecipientJSON struct {
+	Pubkey       string `json:"pubkey"`
+	FeeRecipient string `json:"ethaddress"`
+}
+
+type feeRecipientResponseJSON struct {
+	Data *feeRecipientJSON `json:"data"`
+}
+
+// FeeRecipient provides the fee recipient of the given validator.
+func (s *Service) FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (bellatrix.ExecutionAddress, error) {
+	respBody, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey))
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "failed to request fee recipient")
+	}
+
+	var resp feeRecipientResponseJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "failed to parse fee recipient")
+	}
+	if resp.Data == nil {
+		return bellatrix.ExecutionAddress{}, errors.New("fee recipient not returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if !strings.EqualFold(resp.Data.Pubkey, fmt.Sprintf("%#x", pubKey)) {
+		return bellatrix.ExecutionAddress{}, errors.New("fee recipient not for requested public key")
+	}
+
+	if resp.Data.FeeRecipient == "" {
+		return bellatrix.ExecutionAddress{}, errors.New("fee recipient not returned")
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(resp.Data.FeeRecipient, "0x"))
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "invalid value for fee recipient")
+	}
+	if len(data) != bellatrix.ExecutionAddressLength {
+		return bellatrix.ExecutionAddress{}, fmt.Errorf("incorrect length %d for fee recipient", len(data))
+	}
+	var feeRecipient bellatrix.ExecutionAddress
+	copy(feeRecipient[:], data)
+
+	return feeRecipient, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type gasLimitJSON struct {
+	Pubkey   string `json:"pubkey"`
+	GasLimit string `json:"gas_limit"`
+}
+
+type gasLimitResponseJSON struct {
+	Data *gasLimitJSON `json:"data"`
+}
+
+// GasLimit provides the gas limit of the given validator.
+func (s *Service) GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (uint64, error) {
+	respBody, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to request gas limit")
+	}
+
+	var resp gasLimitResponseJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return 0, errors.Wrap(err, "failed to parse gas limit")
+	}
+	if resp.Data == nil {
+		return 0, errors.New("gas limit not returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if !strings.EqualFold(resp.Data.Pubkey, fmt.Sprintf("%#x", pubKey)) {
+		return 0, errors.New("gas limit not for requested public key")
+	}
+
+	if resp.Data.GasLimit == "" {
+		return 0, errors.New("gas limit not returned")
+	}
+	gasLimit, err := strconv.ParseUint(resp.Data.GasLimit, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	return gasLimit, nil
+}
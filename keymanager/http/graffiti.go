@@ -0,0 +1,56 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type graffitiJSON struct {
+	Pubkey   string `json:"pubkey"`
+	Graffiti string `json:"graffiti"`
+}
+
+type graffitiResponseJSON struct {
+	Data *graffitiJSON `json:"data"`
+}
+
+// Graffiti provides the graffiti of the given validator.
+func (s *Service) Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (string, error) {
+	respBody, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to request graffiti")
+	}
+
+	var resp graffitiResponseJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to parse graffiti")
+	}
+	if resp.Data == nil {
+		return "", errors.New("graffiti not returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if !strings.EqualFold(resp.Data.Pubkey, fmt.Sprintf("%#x", pubKey)) {
+		return "", errors.New("graffiti not for requested public key")
+	}
+
+	return resp.Data.Graffiti, nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/internal/httpclient"
+	"github.com/pkg/errors"
+)
+
+// Error represents an http error.
+type Error struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Data       []byte
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// get sends an HTTP get request and returns the body.
+func (s *Service) get(ctx context.Context, endpoint string) ([]byte, error) {
+	return s.do(ctx, http.MethodGet, endpoint, nil)
+}
+
+// post sends an HTTP post request and returns the body.
+func (s *Service) post(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
+	return s.do(ctx, http.MethodPost, endpoint, body)
+}
+
+// delete sends an HTTP delete request and returns the body.
+func (s *Service) delete(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
+	return s.do(ctx, http.MethodDelete, endpoint, body)
+}
+
+// do sends an authorized HTTP request and returns the body.
+// Responses with a status code outside of the 2xx family are returned as an Error.
+func (s *Service) do(ctx context.Context, method string, endpoint string, body []byte) ([]byte, error) {
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	// Bodies can contain keystores and their passwords, so are not logged.
+	log.Trace().Int("body_len", len(body)).Msgf("%s request", method)
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create %s request", method))
+	}
+	httpclient.AddHeaders(req, s.extraHeaders)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.token))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to call %s endpoint", method))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to read %s response", method))
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msgf("%s failed", method)
+		return nil, Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Int("response_len", len(data)).Msgf("%s response", method)
+
+	return data, nil
+}
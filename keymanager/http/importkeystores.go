@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/pkg/errors"
+)
+
+type importKeystoresRequestJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type importResultsJSON struct {
+	Data []*keymanager.ImportResult `json:"data"`
+}
+
+// ImportKeystores imports EIP-2335 keystores, each decrypted by the password at the same index.
+// Slashing protection data in EIP-3076 interchange format is optional; if supplied it is
+// imported before the keystores.
+func (s *Service) ImportKeystores(ctx context.Context,
+	keystores []string,
+	passwords []string,
+	slashingProtection string,
+) (
+	[]*keymanager.ImportResult,
+	error,
+) {
+	if len(keystores) == 0 {
+		return nil, errors.New("no keystores supplied")
+	}
+	if len(passwords) != len(keystores) {
+		return nil, errors.New("number of passwords does not match number of keystores")
+	}
+
+	reqBody, err := json.Marshal(&importKeystoresRequestJSON{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	respBody, err := s.post(ctx, "/eth/v1/keystores", reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import keystores")
+	}
+
+	var resp importResultsJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse import results")
+	}
+	if len(resp.Data) != len(keystores) {
+		return nil, errors.New("incorrect number of import results returned")
+	}
+
+	return resp.Data, nil
+}
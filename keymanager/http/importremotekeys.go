@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/pkg/errors"
+)
+
+type importRemoteKeyJSON struct {
+	Pubkey string `json:"pubkey"`
+	URL    string `json:"url,omitempty"`
+}
+
+type importRemoteKeysRequestJSON struct {
+	RemoteKeys []*importRemoteKeyJSON `json:"remote_keys"`
+}
+
+// ImportRemoteKeys imports keys for which signing is carried out by a remote signer.
+func (s *Service) ImportRemoteKeys(ctx context.Context, keys []*keymanager.RemoteKey) ([]*keymanager.ImportResult, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no remote keys supplied")
+	}
+
+	req := &importRemoteKeysRequestJSON{
+		RemoteKeys: make([]*importRemoteKeyJSON, len(keys)),
+	}
+	for i, key := range keys {
+		if key == nil {
+			return nil, errors.New("nil remote key supplied")
+		}
+		req.RemoteKeys[i] = &importRemoteKeyJSON{
+			Pubkey: fmt.Sprintf("%#x", key.Pubkey),
+			URL:    key.URL,
+		}
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	respBody, err := s.post(ctx, "/eth/v1/remotekeys", reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import remote keys")
+	}
+
+	var resp importResultsJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse import results")
+	}
+	if len(resp.Data) != len(keys) {
+		return nil, errors.New("incorrect number of import results returned")
+	}
+
+	return resp.Data, nil
+}
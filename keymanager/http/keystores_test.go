@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystores(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	keystores, err := s.Keystores(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keystores)
+
+	pubKey1 := testPubKey(0x01)
+	pubKey2 := testPubKey(0x02)
+
+	// Import.
+	_, err = s.ImportKeystores(ctx, nil, nil, "")
+	require.EqualError(t, err, "no keystores supplied")
+	_, err = s.ImportKeystores(ctx, []string{testKeystore(pubKey1)}, nil, "")
+	require.EqualError(t, err, "number of passwords does not match number of keystores")
+
+	results, err := s.ImportKeystores(ctx,
+		[]string{testKeystore(pubKey1), testKeystore(pubKey1), "bad"},
+		[]string{"secret", "secret", "secret"},
+		"",
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, keymanager.ImportStatusImported, results[0].Status)
+	require.Equal(t, keymanager.ImportStatusDuplicate, results[1].Status)
+	require.Equal(t, keymanager.ImportStatusError, results[2].Status)
+
+	keystores, err = s.Keystores(ctx)
+	require.NoError(t, err)
+	require.Len(t, keystores, 1)
+	require.Equal(t, pubKey1, keystores[0].ValidatingPubkey)
+	require.Equal(t, "m/12381/3600/0/0/0", keystores[0].DerivationPath)
+
+	// Delete.
+	_, err = s.DeleteKeystores(ctx, nil)
+	require.EqualError(t, err, "no public keys supplied")
+
+	deleted, err := s.DeleteKeystores(ctx, []phase0.BLSPubKey{pubKey1, pubKey2})
+	require.NoError(t, err)
+	require.Len(t, deleted.Results, 2)
+	require.Equal(t, keymanager.DeleteStatusDeleted, deleted.Results[0].Status)
+	require.Equal(t, keymanager.DeleteStatusNotFound, deleted.Results[1].Status)
+	var interchange map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(deleted.SlashingProtection), &interchange))
+	require.Len(t, interchange["data"], 1)
+
+	// Deleting again finds the slashing protection data but not the key.
+	deleted, err = s.DeleteKeystores(ctx, []phase0.BLSPubKey{pubKey1})
+	require.NoError(t, err)
+	require.Equal(t, keymanager.DeleteStatusNotActive, deleted.Results[0].Status)
+
+	keystores, err = s.Keystores(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keystores)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/pkg/errors"
+)
+
+type remoteKeysJSON struct {
+	Data []*keymanager.RemoteKey `json:"data"`
+}
+
+// RemoteKeys provides the keys for which signing is carried out by a remote signer.
+func (s *Service) RemoteKeys(ctx context.Context) ([]*keymanager.RemoteKey, error) {
+	respBody, err := s.get(ctx, "/eth/v1/remotekeys")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request remote keys")
+	}
+
+	var resp remoteKeysJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse remote keys")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("remote keys not returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	remoteKeys, err := s.RemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, remoteKeys)
+
+	pubKey1 := testPubKey(0x01)
+	pubKey2 := testPubKey(0x02)
+
+	// Import.
+	_, err = s.ImportRemoteKeys(ctx, nil)
+	require.EqualError(t, err, "no remote keys supplied")
+	_, err = s.ImportRemoteKeys(ctx, []*keymanager.RemoteKey{nil})
+	require.EqualError(t, err, "nil remote key supplied")
+
+	results, err := s.ImportRemoteKeys(ctx, []*keymanager.RemoteKey{
+		{Pubkey: pubKey1, URL: "https://signer.example.com"},
+		{Pubkey: pubKey1, URL: "https://signer.example.com"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, keymanager.ImportStatusImported, results[0].Status)
+	require.Equal(t, keymanager.ImportStatusDuplicate, results[1].Status)
+
+	remoteKeys, err = s.RemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, remoteKeys, 1)
+	require.Equal(t, pubKey1, remoteKeys[0].Pubkey)
+	require.Equal(t, "https://signer.example.com", remoteKeys[0].URL)
+
+	// Delete.
+	_, err = s.DeleteRemoteKeys(ctx, nil)
+	require.EqualError(t, err, "no public keys supplied")
+
+	deleted, err := s.DeleteRemoteKeys(ctx, []phase0.BLSPubKey{pubKey1, pubKey2})
+	require.NoError(t, err)
+	require.Len(t, deleted, 2)
+	require.Equal(t, keymanager.DeleteStatusDeleted, deleted[0].Status)
+	require.Equal(t, keymanager.DeleteStatusNotFound, deleted[1].Status)
+
+	remoteKeys, err = s.RemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, remoteKeys)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/internal/httpclient"
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a keymanager client service.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	extraHeaders map[string]string
+	token        string
+}
+
+// New creates a new keymanager client service, connecting with a standard HTTP.
+func New(_ context.Context, params ...Parameter) (keymanager.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "keymanager").Str("impl", "http").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	// Unlike the beacon node client this keeps TLS verification enabled, as
+	// requests carry the API token and keystore passwords.
+	transport := httpclient.NewTransport(parameters.timeout, nil)
+	if parameters.transport != nil {
+		transport = parameters.transport
+	}
+	client := &http.Client{
+		Timeout:   parameters.timeout,
+		Transport: transport,
+	}
+
+	base, err := httpclient.BaseURL(parameters.address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL")
+	}
+
+	return &Service{
+		log:          log,
+		base:         base,
+		address:      parameters.address,
+		client:       client,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+		token:        parameters.token,
+	}, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Keymanager (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
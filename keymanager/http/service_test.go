@@ -0,0 +1,148 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	keymanagerhttp "github.com/jefmcl/go-eth2-client/keymanager/http"
+	"github.com/jefmcl/go-eth2-client/keymanager/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testToken is the bearer token used by tests.
+const testToken = "api-token-0x1234"
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		parameters []keymanagerhttp.Parameter
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []keymanagerhttp.Parameter{
+				keymanagerhttp.WithAddress("localhost:7500"),
+				keymanagerhttp.WithTimeout(0),
+				keymanagerhttp.WithToken(testToken),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "TokenMissing",
+			parameters: []keymanagerhttp.Parameter{
+				keymanagerhttp.WithAddress("localhost:7500"),
+			},
+			err: "problem with parameters: no token specified",
+		},
+		{
+			name: "AddressInvalid",
+			parameters: []keymanagerhttp.Parameter{
+				keymanagerhttp.WithAddress(string([]byte{0x01})),
+				keymanagerhttp.WithToken(testToken),
+			},
+			err: `invalid URL: parse "http://\x01/": net/url: invalid control character in URL`,
+		},
+		{
+			name: "Good",
+			parameters: []keymanagerhttp.Parameter{
+				keymanagerhttp.WithLogLevel(zerolog.Disabled),
+				keymanagerhttp.WithAddress("localhost:7500"),
+				keymanagerhttp.WithTimeout(5 * time.Second),
+				keymanagerhttp.WithToken(testToken),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := keymanagerhttp.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Keymanager (HTTP)", s.Name())
+				require.Equal(t, "localhost:7500", s.Address())
+			}
+		})
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(server.WithLogLevel(zerolog.Disabled), server.WithToken(testToken))
+	require.NoError(t, err)
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	s, err := keymanagerhttp.New(ctx,
+		keymanagerhttp.WithLogLevel(zerolog.Disabled),
+		keymanagerhttp.WithAddress(httpSrv.URL),
+		keymanagerhttp.WithToken("wrong"),
+	)
+	require.NoError(t, err)
+
+	_, err = s.(*keymanagerhttp.Service).Keystores(ctx)
+	require.EqualError(t, err, `failed to request keystores: GET failed with status 401: {"code":401,"message":"unauthorized"}`)
+}
+
+// newTestService creates a keymanager client connected to a new fake server.
+func newTestService(ctx context.Context, t *testing.T) (*keymanagerhttp.Service, *server.Server) {
+	t.Helper()
+
+	srv, err := server.New(server.WithLogLevel(zerolog.Disabled), server.WithToken(testToken))
+	require.NoError(t, err)
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+
+	s, err := keymanagerhttp.New(ctx,
+		keymanagerhttp.WithLogLevel(zerolog.Disabled),
+		keymanagerhttp.WithAddress(httpSrv.URL),
+		keymanagerhttp.WithTimeout(5*time.Second),
+		keymanagerhttp.WithToken(testToken),
+	)
+	require.NoError(t, err)
+
+	return s.(*keymanagerhttp.Service), srv
+}
+
+// testPubKey creates a public key for tests.
+func testPubKey(i byte) phase0.BLSPubKey {
+	var pubKey phase0.BLSPubKey
+	for j := range pubKey {
+		pubKey[j] = i
+	}
+
+	return pubKey
+}
+
+// testKeystore creates a keystore containing the given public key.
+func testKeystore(pubKey phase0.BLSPubKey) string {
+	return fmt.Sprintf(`{"crypto":{},"path":"m/12381/3600/0/0/0","pubkey":"%x","uuid":"00000000-0000-0000-0000-000000000000","version":4}`, pubKey)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type setGasLimitRequestJSON struct {
+	GasLimit string `json:"gas_limit"`
+}
+
+// SetGasLimit sets the gas limit of the given validator.
+func (s *Service) SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error {
+	reqBody, err := json.Marshal(&setGasLimitRequestJSON{
+		GasLimit: fmt.Sprintf("%d", gasLimit),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	if _, err := s.post(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), reqBody); err != nil {
+		return errors.Wrap(err, "failed to set gas limit")
+	}
+
+	return nil
+}
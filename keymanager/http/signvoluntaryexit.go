@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type signedVoluntaryExitJSON struct {
+	Data *phase0.SignedVoluntaryExit `json:"data"`
+}
+
+// SignVoluntaryExit signs a voluntary exit for the given validator.
+// If epoch is nil the validator client uses the current epoch.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	epoch *phase0.Epoch,
+) (
+	*phase0.SignedVoluntaryExit,
+	error,
+) {
+	url := fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit", pubKey)
+	if epoch != nil {
+		url = fmt.Sprintf("%s?epoch=%d", url, *epoch)
+	}
+	respBody, err := s.post(ctx, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request signed voluntary exit")
+	}
+
+	var resp signedVoluntaryExitJSON
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse signed voluntary exit")
+	}
+	if resp.Data == nil || resp.Data.Message == nil {
+		return nil, errors.New("signed voluntary exit not returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if epoch != nil && resp.Data.Message.Epoch != *epoch {
+		return nil, errors.New("signed voluntary exit not for requested epoch")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVoluntaryExit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, srv := newTestService(ctx, t)
+
+	pubKey := testPubKey(0x01)
+	_, err := s.ImportKeystores(ctx, []string{testKeystore(pubKey)}, []string{"secret"}, "")
+	require.NoError(t, err)
+
+	_, err = s.SignVoluntaryExit(ctx, pubKey, nil)
+	require.EqualError(t, err, `failed to request signed voluntary exit: POST failed with status 400: {"code":400,"message":"validator index not known"}`)
+
+	srv.SetValidatorIndex(pubKey, 12345)
+
+	exit, err := s.SignVoluntaryExit(ctx, pubKey, nil)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(12345), exit.Message.ValidatorIndex)
+
+	epoch := phase0.Epoch(100)
+	exit, err = s.SignVoluntaryExit(ctx, pubKey, &epoch)
+	require.NoError(t, err)
+	require.Equal(t, epoch, exit.Message.Epoch)
+	require.Equal(t, phase0.ValidatorIndex(12345), exit.Message.ValidatorIndex)
+}
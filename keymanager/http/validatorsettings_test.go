@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/keymanager/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorSettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, _ := newTestService(ctx, t)
+
+	pubKey := testPubKey(0x01)
+	unknownPubKey := testPubKey(0x02)
+	_, err := s.ImportKeystores(ctx, []string{testKeystore(pubKey)}, []string{"secret"}, "")
+	require.NoError(t, err)
+
+	// Fee recipient.
+	_, err = s.FeeRecipient(ctx, unknownPubKey)
+	require.EqualError(t, err, `failed to request fee recipient: GET failed with status 404: {"code":404,"message":"validator not found"}`)
+	feeRecipient := bellatrix.ExecutionAddress{0x01, 0x02}
+	require.NoError(t, s.SetFeeRecipient(ctx, pubKey, feeRecipient))
+	res, err := s.FeeRecipient(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, feeRecipient, res)
+	require.NoError(t, s.DeleteFeeRecipient(ctx, pubKey))
+	res, err = s.FeeRecipient(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, bellatrix.ExecutionAddress{}, res)
+
+	// Gas limit.
+	require.EqualError(t, s.SetGasLimit(ctx, unknownPubKey, 1), `failed to set gas limit: POST failed with status 404: {"code":404,"message":"validator not found"}`)
+	gasLimit, err := s.GasLimit(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, server.DefaultGasLimit, gasLimit)
+	require.NoError(t, s.SetGasLimit(ctx, pubKey, 36000000))
+	gasLimit, err = s.GasLimit(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(36000000), gasLimit)
+	require.NoError(t, s.DeleteGasLimit(ctx, pubKey))
+	gasLimit, err = s.GasLimit(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, server.DefaultGasLimit, gasLimit)
+
+	// Graffiti.
+	require.EqualError(t, s.DeleteGraffiti(ctx, unknownPubKey), `failed to delete graffiti: DELETE failed with status 404: {"code":404,"message":"validator not found"}`)
+	require.NoError(t, s.SetGraffiti(ctx, pubKey, "hello"))
+	graffiti, err := s.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "hello", graffiti)
+	require.NoError(t, s.DeleteGraffiti(ctx, pubKey))
+	graffiti, err = s.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "", graffiti)
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ImportStatus defines the result of importing an individual key.
+type ImportStatus int
+
+const (
+	// ImportStatusImported means the key was imported.
+	ImportStatusImported ImportStatus = iota
+	// ImportStatusDuplicate means the key was already present.
+	ImportStatusDuplicate
+	// ImportStatusError means the key could not be imported.
+	ImportStatusError
+)
+
+var importStatusStrings = [...]string{
+	"imported",
+	"duplicate",
+	"error",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *ImportStatus) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", importStatusStrings[*i])), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *ImportStatus) UnmarshalJSON(input []byte) error {
+	var err error
+	switch strings.ToLower(string(input)) {
+	case `"imported"`:
+		*i = ImportStatusImported
+	case `"duplicate"`:
+		*i = ImportStatusDuplicate
+	case `"error"`:
+		*i = ImportStatusError
+	default:
+		err = fmt.Errorf("unrecognised import status %s", string(input))
+	}
+	return err
+}
+
+func (i ImportStatus) String() string {
+	return importStatusStrings[i]
+}
+
+// ImportResult is the result of importing an individual key.
+type ImportResult struct {
+	// Status is the status of the import.
+	Status ImportStatus
+	// Message is additional information about the status, if any.
+	Message string
+}
+
+// importResultJSON is the spec representation of the struct.
+type importResultJSON struct {
+	Status  *ImportStatus `json:"status"`
+	Message string        `json:"message,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *ImportResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&importResultJSON{
+		Status:  &i.Status,
+		Message: i.Message,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *ImportResult) UnmarshalJSON(input []byte) error {
+	var data importResultJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Status == nil {
+		return errors.New("status missing")
+	}
+	i.Status = *data.Status
+	i.Message = data.Message
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *ImportResult) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
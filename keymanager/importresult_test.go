@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestImportResultJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type keymanager.importResultJSON",
+		},
+		{
+			name:  "StatusMissing",
+			input: []byte(`{"message":"bad keystore"}`),
+			err:   "status missing",
+		},
+		{
+			name:  "StatusInvalid",
+			input: []byte(`{"status":"invalid"}`),
+			err:   "invalid JSON: unrecognised import status \"invalid\"",
+		},
+		{
+			name:  "GoodImported",
+			input: []byte(`{"status":"imported"}`),
+		},
+		{
+			name:  "GoodDuplicate",
+			input: []byte(`{"status":"duplicate"}`),
+		},
+		{
+			name:  "GoodError",
+			input: []byte(`{"status":"error","message":"bad keystore"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res keymanager.ImportResult
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
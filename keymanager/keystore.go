@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Keystore is a keystore held locally by a validator client.
+type Keystore struct {
+	// ValidatingPubkey is the public key of the validator.
+	ValidatingPubkey phase0.BLSPubKey
+	// DerivationPath is the path from which the key was derived, if known.
+	DerivationPath string
+	// ReadOnly is true if the key cannot be deleted by the keymanager API.
+	ReadOnly bool
+}
+
+// keystoreJSON is the spec representation of the struct.
+type keystoreJSON struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path,omitempty"`
+	ReadOnly         bool   `json:"readonly"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k *Keystore) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&keystoreJSON{
+		ValidatingPubkey: fmt.Sprintf("%#x", k.ValidatingPubkey),
+		DerivationPath:   k.DerivationPath,
+		ReadOnly:         k.ReadOnly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *Keystore) UnmarshalJSON(input []byte) error {
+	var data keystoreJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ValidatingPubkey == "" {
+		return errors.New("validating public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(data.ValidatingPubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validating public key")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return fmt.Errorf("incorrect length %d for validating public key", len(pubKey))
+	}
+	copy(k.ValidatingPubkey[:], pubKey)
+	k.DerivationPath = data.DerivationPath
+	k.ReadOnly = data.ReadOnly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (k *Keystore) String() string {
+	data, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestKeystoreJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type keymanager.keystoreJSON",
+		},
+		{
+			name:  "ValidatingPubkeyMissing",
+			input: []byte(`{"derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+			err:   "validating public key missing",
+		},
+		{
+			name:  "ValidatingPubkeyWrongType",
+			input: []byte(`{"validating_pubkey":true,"derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field keystoreJSON.validating_pubkey of type string",
+		},
+		{
+			name:  "ValidatingPubkeyInvalid",
+			input: []byte(`{"validating_pubkey":"invalid","derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+			err:   "invalid value for validating public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ValidatingPubkeyShort",
+			input: []byte(`{"validating_pubkey":"0x101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e","derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+			err:   "incorrect length 47 for validating public key",
+		},
+		{
+			name:  "ValidatingPubkeyLong",
+			input: []byte(`{"validating_pubkey":"0x101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f40","derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+			err:   "incorrect length 49 for validating public key",
+		},
+		{
+			name:  "ReadOnlyWrongType",
+			input: []byte(`{"validating_pubkey":"0x101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","derivation_path":"m/12381/3600/0/0/0","readonly":"false"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field keystoreJSON.readonly of type bool",
+		},
+		{
+			name:  "NoDerivationPath",
+			input: []byte(`{"validating_pubkey":"0x101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","readonly":true}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validating_pubkey":"0x101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","derivation_path":"m/12381/3600/0/0/0","readonly":false}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res keymanager.Keystore
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
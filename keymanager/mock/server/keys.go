@@ -0,0 +1,271 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// keystoreFileJSON contains the parts of an EIP-2335 keystore used by the server.
+type keystoreFileJSON struct {
+	Pubkey string `json:"pubkey"`
+	Path   string `json:"path"`
+}
+
+type importKeystoresRequestJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+
+type deleteKeysRequestJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type deleteKeystoresResponseJSON struct {
+	Data               []*keymanager.DeleteResult `json:"data"`
+	SlashingProtection string                     `json:"slashing_protection"`
+}
+
+type remoteKeyJSON struct {
+	Pubkey string `json:"pubkey"`
+	URL    string `json:"url"`
+}
+
+type importRemoteKeysRequestJSON struct {
+	RemoteKeys []*remoteKeyJSON `json:"remote_keys"`
+}
+
+// interchangeJSON is the EIP-3076 slashing protection interchange format.
+type interchangeJSON struct {
+	Metadata *interchangeMetadataJSON `json:"metadata"`
+	Data     []*interchangeDataJSON   `json:"data"`
+}
+
+type interchangeMetadataJSON struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+type interchangeDataJSON struct {
+	Pubkey             string        `json:"pubkey"`
+	SignedBlocks       []interface{} `json:"signed_blocks"`
+	SignedAttestations []interface{} `json:"signed_attestations"`
+}
+
+func (s *Server) handleKeystores(w http.ResponseWriter, _ *http.Request, _ []string) {
+	s.mu.RLock()
+	keystores := make([]*keymanager.Keystore, 0, len(s.keystores))
+	for _, keystore := range s.keystores {
+		keystores = append(keystores, keystore)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(keystores, func(i, j int) bool {
+		return fmt.Sprintf("%#x", keystores[i].ValidatingPubkey) < fmt.Sprintf("%#x", keystores[j].ValidatingPubkey)
+	})
+	writeData(w, keystores)
+}
+
+func (s *Server) handleImportKeystores(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req importKeystoresRequestJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		writeError(w, http.StatusBadRequest, "mismatched keystores and passwords")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.SlashingProtection != "" {
+		var interchange interchangeJSON
+		if err := json.Unmarshal([]byte(req.SlashingProtection), &interchange); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid slashing protection data")
+			return
+		}
+		for _, data := range interchange.Data {
+			pubKey, err := parsePubKey(data.Pubkey)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			s.protected[pubKey] = true
+		}
+	}
+
+	results := make([]*keymanager.ImportResult, len(req.Keystores))
+	for i := range req.Keystores {
+		var keystore keystoreFileJSON
+		if err := json.Unmarshal([]byte(req.Keystores[i]), &keystore); err != nil {
+			results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusError, Message: "invalid keystore"}
+			continue
+		}
+		pubKey, err := parsePubKey(keystore.Pubkey)
+		if err != nil {
+			results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusError, Message: err.Error()}
+			continue
+		}
+		if _, exists := s.keystores[pubKey]; exists {
+			results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusDuplicate}
+			continue
+		}
+		s.keystores[pubKey] = &keymanager.Keystore{
+			ValidatingPubkey: pubKey,
+			DerivationPath:   keystore.Path,
+		}
+		s.protected[pubKey] = true
+		results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusImported}
+	}
+
+	writeData(w, results)
+}
+
+func (s *Server) handleDeleteKeystores(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req deleteKeysRequestJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results := make([]*keymanager.DeleteResult, len(req.Pubkeys))
+	interchange := &interchangeJSON{
+		Metadata: &interchangeMetadataJSON{
+			InterchangeFormatVersion: "5",
+			GenesisValidatorsRoot:    fmt.Sprintf("%#x", phase0.Root{}),
+		},
+		Data: make([]*interchangeDataJSON, 0),
+	}
+	for i := range req.Pubkeys {
+		pubKey, err := parsePubKey(req.Pubkeys[i])
+		if err != nil {
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusError, Message: err.Error()}
+			continue
+		}
+		keystore, exists := s.keystores[pubKey]
+		switch {
+		case exists && keystore.ReadOnly:
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusError, Message: "key is read only"}
+			continue
+		case exists:
+			delete(s.keystores, pubKey)
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusDeleted}
+		case s.protected[pubKey]:
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusNotActive}
+		default:
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusNotFound}
+			continue
+		}
+		interchange.Data = append(interchange.Data, &interchangeDataJSON{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       make([]interface{}, 0),
+			SignedAttestations: make([]interface{}, 0),
+		})
+	}
+
+	slashingProtection, err := json.Marshal(interchange)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, &deleteKeystoresResponseJSON{
+		Data:               results,
+		SlashingProtection: string(slashingProtection),
+	})
+}
+
+func (s *Server) handleRemoteKeys(w http.ResponseWriter, _ *http.Request, _ []string) {
+	s.mu.RLock()
+	remoteKeys := make([]*keymanager.RemoteKey, 0, len(s.remoteKeys))
+	for _, remoteKey := range s.remoteKeys {
+		remoteKeys = append(remoteKeys, remoteKey)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(remoteKeys, func(i, j int) bool {
+		return fmt.Sprintf("%#x", remoteKeys[i].Pubkey) < fmt.Sprintf("%#x", remoteKeys[j].Pubkey)
+	})
+	writeData(w, remoteKeys)
+}
+
+func (s *Server) handleImportRemoteKeys(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req importRemoteKeysRequestJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results := make([]*keymanager.ImportResult, len(req.RemoteKeys))
+	for i, remoteKey := range req.RemoteKeys {
+		pubKey, err := parsePubKey(remoteKey.Pubkey)
+		if err != nil {
+			results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusError, Message: err.Error()}
+			continue
+		}
+		if _, exists := s.remoteKeys[pubKey]; exists {
+			results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusDuplicate}
+			continue
+		}
+		s.remoteKeys[pubKey] = &keymanager.RemoteKey{
+			Pubkey: pubKey,
+			URL:    remoteKey.URL,
+		}
+		results[i] = &keymanager.ImportResult{Status: keymanager.ImportStatusImported}
+	}
+
+	writeData(w, results)
+}
+
+func (s *Server) handleDeleteRemoteKeys(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req deleteKeysRequestJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results := make([]*keymanager.DeleteResult, len(req.Pubkeys))
+	for i := range req.Pubkeys {
+		pubKey, err := parsePubKey(req.Pubkeys[i])
+		if err != nil {
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusError, Message: err.Error()}
+			continue
+		}
+		if _, exists := s.remoteKeys[pubKey]; !exists {
+			results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusNotFound}
+			continue
+		}
+		delete(s.remoteKeys, pubKey)
+		results[i] = &keymanager.DeleteResult{Status: keymanager.DeleteStatusDeleted}
+	}
+
+	writeData(w, results)
+}
@@ -0,0 +1,220 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server provides a fake validator client that serves the keymanager
+// API over HTTP, backed by in-memory state.  It implements http.Handler so can
+// be used directly with httptest.NewServer().
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jefmcl/go-eth2-client/keymanager"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// DefaultGasLimit is the gas limit of validators without their own gas limit.
+const DefaultGasLimit = uint64(30000000)
+
+// Server is a fake keymanager HTTP server.
+type Server struct {
+	log    zerolog.Logger
+	token  string
+	routes []*route
+
+	mu         sync.RWMutex
+	keystores  map[phase0.BLSPubKey]*keymanager.Keystore
+	remoteKeys map[phase0.BLSPubKey]*keymanager.RemoteKey
+	// protected are the keys for which slashing protection data is held.
+	protected        map[phase0.BLSPubKey]bool
+	feeRecipients    map[phase0.BLSPubKey]bellatrix.ExecutionAddress
+	gasLimits        map[phase0.BLSPubKey]uint64
+	graffiti         map[phase0.BLSPubKey]string
+	validatorIndices map[phase0.BLSPubKey]phase0.ValidatorIndex
+}
+
+// handlerFunc is the function that handles a route.
+// params contains the values of the route pattern's capture groups.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// New creates a new fake keymanager server.
+func New(params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "keymanager").Str("impl", "mock").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Server{
+		log:              log,
+		token:            parameters.token,
+		keystores:        make(map[phase0.BLSPubKey]*keymanager.Keystore),
+		remoteKeys:       make(map[phase0.BLSPubKey]*keymanager.RemoteKey),
+		protected:        make(map[phase0.BLSPubKey]bool),
+		feeRecipients:    make(map[phase0.BLSPubKey]bellatrix.ExecutionAddress),
+		gasLimits:        make(map[phase0.BLSPubKey]uint64),
+		graffiti:         make(map[phase0.BLSPubKey]string),
+		validatorIndices: make(map[phase0.BLSPubKey]phase0.ValidatorIndex),
+	}
+	s.registerRoutes()
+
+	return s, nil
+}
+
+// SetValidatorIndex sets the index of the validator with the given public key,
+// used when signing voluntary exits.
+func (s *Server) SetValidatorIndex(pubKey phase0.BLSPubKey, index phase0.ValidatorIndex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.validatorIndices[pubKey] = index
+}
+
+func (s *Server) registerRoutes() {
+	pubKey := `(0x[0-9a-fA-F]{96})`
+	s.handle(http.MethodGet, `/eth/v1/keystores`, s.handleKeystores)
+	s.handle(http.MethodPost, `/eth/v1/keystores`, s.handleImportKeystores)
+	s.handle(http.MethodDelete, `/eth/v1/keystores`, s.handleDeleteKeystores)
+	s.handle(http.MethodGet, `/eth/v1/remotekeys`, s.handleRemoteKeys)
+	s.handle(http.MethodPost, `/eth/v1/remotekeys`, s.handleImportRemoteKeys)
+	s.handle(http.MethodDelete, `/eth/v1/remotekeys`, s.handleDeleteRemoteKeys)
+	s.handle(http.MethodGet, `/eth/v1/validator/`+pubKey+`/feerecipient`, s.handleFeeRecipient)
+	s.handle(http.MethodPost, `/eth/v1/validator/`+pubKey+`/feerecipient`, s.handleSetFeeRecipient)
+	s.handle(http.MethodDelete, `/eth/v1/validator/`+pubKey+`/feerecipient`, s.handleDeleteFeeRecipient)
+	s.handle(http.MethodGet, `/eth/v1/validator/`+pubKey+`/gas_limit`, s.handleGasLimit)
+	s.handle(http.MethodPost, `/eth/v1/validator/`+pubKey+`/gas_limit`, s.handleSetGasLimit)
+	s.handle(http.MethodDelete, `/eth/v1/validator/`+pubKey+`/gas_limit`, s.handleDeleteGasLimit)
+	s.handle(http.MethodGet, `/eth/v1/validator/`+pubKey+`/graffiti`, s.handleGraffiti)
+	s.handle(http.MethodPost, `/eth/v1/validator/`+pubKey+`/graffiti`, s.handleSetGraffiti)
+	s.handle(http.MethodDelete, `/eth/v1/validator/`+pubKey+`/graffiti`, s.handleDeleteGraffiti)
+	s.handle(http.MethodPost, `/eth/v1/validator/`+pubKey+`/voluntary_exit`, s.handleSignVoluntaryExit)
+}
+
+// handle registers a handler for the given method and path pattern.
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, &route{
+		method:  method,
+		pattern: regexp.MustCompile(fmt.Sprintf("^%s$", pattern)),
+		handler: handler,
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := s.log.With().Str("method", r.Method).Str("path", r.URL.Path).Logger()
+	log.Trace().Msg("Request received")
+
+	if r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", s.token) {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	pathMatched := false
+	for _, route := range s.routes {
+		matches := route.pattern.FindStringSubmatch(r.URL.Path)
+		if matches == nil {
+			continue
+		}
+		pathMatched = true
+		if route.method != r.Method {
+			continue
+		}
+		route.handler(w, r, matches[1:])
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "endpoint not found")
+}
+
+// knownKey returns true if the key is held either locally or remotely.
+// This assumes that the caller holds the lock.
+func (s *Server) knownKey(pubKey phase0.BLSPubKey) bool {
+	if _, exists := s.keystores[pubKey]; exists {
+		return true
+	}
+	_, exists := s.remoteKeys[pubKey]
+
+	return exists
+}
+
+// dataResponse is the standard wrapper for responses.
+type dataResponse struct {
+	Data interface{} `json:"data"`
+}
+
+// errorResponse is the standard error response.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeData writes a standard data response.
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, &dataResponse{Data: data})
+}
+
+// writeError writes a standard error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, &errorResponse{Code: statusCode, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(fmt.Sprintf(`{"code":500,"message":%q}`, err.Error()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// parsePubKey parses a 0x-prefixed hex public key.
+func parsePubKey(input string) (phase0.BLSPubKey, error) {
+	var pubKey phase0.BLSPubKey
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return pubKey, errors.Wrap(err, "invalid public key")
+	}
+	if len(data) != phase0.PublicKeyLength {
+		return pubKey, fmt.Errorf("incorrect length %d for public key", len(data))
+	}
+	copy(pubKey[:], data)
+
+	return pubKey, nil
+}
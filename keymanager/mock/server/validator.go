@@ -0,0 +1,259 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+type feeRecipientJSON struct {
+	Pubkey       string `json:"pubkey,omitempty"`
+	FeeRecipient string `json:"ethaddress"`
+}
+
+type gasLimitJSON struct {
+	Pubkey   string `json:"pubkey,omitempty"`
+	GasLimit string `json:"gas_limit"`
+}
+
+type graffitiJSON struct {
+	Pubkey   string `json:"pubkey,omitempty"`
+	Graffiti string `json:"graffiti"`
+}
+
+// validatorKey parses the public key from the route parameters, writing an
+// error and returning false if it is not known.
+func (s *Server) validatorKey(w http.ResponseWriter, params []string) (phase0.BLSPubKey, bool) {
+	pubKey, err := parsePubKey(params[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return pubKey, false
+	}
+
+	s.mu.RLock()
+	known := s.knownKey(pubKey)
+	s.mu.RUnlock()
+	if !known {
+		writeError(w, http.StatusNotFound, "validator not found")
+		return pubKey, false
+	}
+
+	return pubKey, true
+}
+
+func (s *Server) handleFeeRecipient(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.RLock()
+	feeRecipient := s.feeRecipients[pubKey]
+	s.mu.RUnlock()
+
+	writeData(w, &feeRecipientJSON{
+		Pubkey:       fmt.Sprintf("%#x", pubKey),
+		FeeRecipient: fmt.Sprintf("%#x", feeRecipient),
+	})
+}
+
+func (s *Server) handleSetFeeRecipient(w http.ResponseWriter, r *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	var req feeRecipientJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(req.FeeRecipient, "0x"))
+	if err != nil || len(data) != bellatrix.ExecutionAddressLength {
+		writeError(w, http.StatusBadRequest, "invalid fee recipient")
+		return
+	}
+	var feeRecipient bellatrix.ExecutionAddress
+	copy(feeRecipient[:], data)
+
+	s.mu.Lock()
+	s.feeRecipients[pubKey] = feeRecipient
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleDeleteFeeRecipient(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.feeRecipients, pubKey)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleGasLimit(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.RLock()
+	gasLimit, exists := s.gasLimits[pubKey]
+	s.mu.RUnlock()
+	if !exists {
+		gasLimit = DefaultGasLimit
+	}
+
+	writeData(w, &gasLimitJSON{
+		Pubkey:   fmt.Sprintf("%#x", pubKey),
+		GasLimit: fmt.Sprintf("%d", gasLimit),
+	})
+}
+
+func (s *Server) handleSetGasLimit(w http.ResponseWriter, r *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	var req gasLimitJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	gasLimit, err := strconv.ParseUint(req.GasLimit, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid gas limit")
+		return
+	}
+
+	s.mu.Lock()
+	s.gasLimits[pubKey] = gasLimit
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleDeleteGasLimit(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.gasLimits, pubKey)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleGraffiti(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.RLock()
+	graffiti := s.graffiti[pubKey]
+	s.mu.RUnlock()
+
+	writeData(w, &graffitiJSON{
+		Pubkey:   fmt.Sprintf("%#x", pubKey),
+		Graffiti: graffiti,
+	})
+}
+
+func (s *Server) handleSetGraffiti(w http.ResponseWriter, r *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	var req graffitiJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(req.Graffiti) > 32 {
+		writeError(w, http.StatusBadRequest, "graffiti too long")
+		return
+	}
+
+	s.mu.Lock()
+	s.graffiti[pubKey] = req.Graffiti
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleDeleteGraffiti(w http.ResponseWriter, _ *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.graffiti, pubKey)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSignVoluntaryExit returns a voluntary exit for the validator.
+// The server holds no private keys, so the signature is a placeholder.
+func (s *Server) handleSignVoluntaryExit(w http.ResponseWriter, r *http.Request, params []string) {
+	pubKey, ok := s.validatorKey(w, params)
+	if !ok {
+		return
+	}
+
+	epoch := uint64(0)
+	if epochStr := r.URL.Query().Get("epoch"); epochStr != "" {
+		var err error
+		epoch, err = strconv.ParseUint(epochStr, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid epoch")
+			return
+		}
+	}
+
+	s.mu.RLock()
+	validatorIndex, exists := s.validatorIndices[pubKey]
+	s.mu.RUnlock()
+	if !exists {
+		writeError(w, http.StatusBadRequest, "validator index not known")
+		return
+	}
+
+	writeData(w, &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          phase0.Epoch(epoch),
+			ValidatorIndex: validatorIndex,
+		},
+		Signature: phase0.BLSSignature{0xc0},
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RemoteKey is a key for which signing is carried out by a remote signer.
+type RemoteKey struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// URL is the URL of the remote signer.
+	URL string
+	// ReadOnly is true if the key cannot be deleted by the keymanager API.
+	ReadOnly bool
+}
+
+// remoteKeyJSON is the spec representation of the struct.
+type remoteKeyJSON struct {
+	Pubkey   string `json:"pubkey"`
+	URL      string `json:"url,omitempty"`
+	ReadOnly bool   `json:"readonly"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *RemoteKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&remoteKeyJSON{
+		Pubkey:   fmt.Sprintf("%#x", r.Pubkey),
+		URL:      r.URL,
+		ReadOnly: r.ReadOnly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RemoteKey) UnmarshalJSON(input []byte) error {
+	var data remoteKeyJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Pubkey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(data.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return fmt.Errorf("incorrect length %d for public key", len(pubKey))
+	}
+	copy(r.Pubkey[:], pubKey)
+	r.URL = data.URL
+	r.ReadOnly = data.ReadOnly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (r *RemoteKey) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package keymanager provides interfaces for managing the keys and per-validator
+// settings of a validator client using the standard keymanager API.
+package keymanager
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// Service is the service providing a connection to a keymanager.
+type Service interface {
+	// Name returns the name of the keymanager implementation.
+	Name() string
+
+	// Address returns the address of the keymanager.
+	Address() string
+}
+
+// KeystoresProvider is the interface for listing local keystores.
+type KeystoresProvider interface {
+	// Keystores provides the keystores held locally by the validator client.
+	Keystores(ctx context.Context) ([]*Keystore, error)
+}
+
+// KeystoresImporter is the interface for importing local keystores.
+type KeystoresImporter interface {
+	// ImportKeystores imports EIP-2335 keystores, each decrypted by the password at the same index.
+	// Slashing protection data in EIP-3076 interchange format is optional; if supplied it is
+	// imported before the keystores.
+	ImportKeystores(ctx context.Context, keystores []string, passwords []string, slashingProtection string) ([]*ImportResult, error)
+}
+
+// KeystoresDeleter is the interface for deleting local keystores.
+type KeystoresDeleter interface {
+	// DeleteKeystores deletes the keystores for the given public keys, returning the
+	// slashing protection data for the keys in EIP-3076 interchange format.
+	DeleteKeystores(ctx context.Context, pubKeys []phase0.BLSPubKey) (*DeleteKeystoresResult, error)
+}
+
+// RemoteKeysProvider is the interface for listing remote keys.
+type RemoteKeysProvider interface {
+	// RemoteKeys provides the keys for which signing is carried out by a remote signer.
+	RemoteKeys(ctx context.Context) ([]*RemoteKey, error)
+}
+
+// RemoteKeysImporter is the interface for importing remote keys.
+type RemoteKeysImporter interface {
+	// ImportRemoteKeys imports keys for which signing is carried out by a remote signer.
+	ImportRemoteKeys(ctx context.Context, keys []*RemoteKey) ([]*ImportResult, error)
+}
+
+// RemoteKeysDeleter is the interface for deleting remote keys.
+type RemoteKeysDeleter interface {
+	// DeleteRemoteKeys deletes the remote keys for the given public keys.
+	DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*DeleteResult, error)
+}
+
+// FeeRecipientProvider is the interface for obtaining the fee recipient of a validator.
+type FeeRecipientProvider interface {
+	// FeeRecipient provides the fee recipient of the given validator.
+	FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (bellatrix.ExecutionAddress, error)
+}
+
+// FeeRecipientSetter is the interface for setting the fee recipient of a validator.
+type FeeRecipientSetter interface {
+	// SetFeeRecipient sets the fee recipient of the given validator.
+	SetFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey, feeRecipient bellatrix.ExecutionAddress) error
+}
+
+// FeeRecipientDeleter is the interface for deleting the fee recipient of a validator.
+type FeeRecipientDeleter interface {
+	// DeleteFeeRecipient deletes the fee recipient of the given validator,
+	// returning it to the validator client's default.
+	DeleteFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// GasLimitProvider is the interface for obtaining the gas limit of a validator.
+type GasLimitProvider interface {
+	// GasLimit provides the gas limit of the given validator.
+	GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (uint64, error)
+}
+
+// GasLimitSetter is the interface for setting the gas limit of a validator.
+type GasLimitSetter interface {
+	// SetGasLimit sets the gas limit of the given validator.
+	SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error
+}
+
+// GasLimitDeleter is the interface for deleting the gas limit of a validator.
+type GasLimitDeleter interface {
+	// DeleteGasLimit deletes the gas limit of the given validator,
+	// returning it to the validator client's default.
+	DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// GraffitiProvider is the interface for obtaining the graffiti of a validator.
+type GraffitiProvider interface {
+	// Graffiti provides the graffiti of the given validator.
+	Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (string, error)
+}
+
+// GraffitiSetter is the interface for setting the graffiti of a validator.
+type GraffitiSetter interface {
+	// SetGraffiti sets the graffiti of the given validator.
+	SetGraffiti(ctx context.Context, pubKey phase0.BLSPubKey, graffiti string) error
+}
+
+// GraffitiDeleter is the interface for deleting the graffiti of a validator.
+type GraffitiDeleter interface {
+	// DeleteGraffiti deletes the graffiti of the given validator,
+	// returning it to the validator client's default.
+	DeleteGraffiti(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// VoluntaryExitSigner is the interface for signing voluntary exits.
+type VoluntaryExitSigner interface {
+	// SignVoluntaryExit signs a voluntary exit for the given validator.
+	// If epoch is nil the validator client uses the current epoch.
+	SignVoluntaryExit(ctx context.Context, pubKey phase0.BLSPubKey, epoch *phase0.Epoch) (*phase0.SignedVoluntaryExit, error)
+}
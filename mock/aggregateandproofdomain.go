@@ -20,6 +20,13 @@ import (
 )
 
 // AggregateAndProofDomain provides the aggregate and proof domain.
-func (s *Service) AggregateAndProofDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "AggregateAndProofDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.AggregateAndProofDomainFunc != nil {
+		return s.AggregateAndProofDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x06, 0x00, 0x00, 0x00}, nil
 }
@@ -20,7 +20,14 @@ import (
 )
 
 // AttestationPool fetches the attestation pool for the given slot.
-func (s *Service) AttestationPool(_ context.Context, _ spec.Slot) ([]*spec.Attestation, error) {
+func (s *Service) AttestationPool(ctx context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
+	if err := s.call(ctx, "AttestationPool", slot); err != nil {
+		return nil, err
+	}
+	if s.AttestationPoolFunc != nil {
+		return s.AttestationPoolFunc(ctx, slot)
+	}
+
 	res := make([]*spec.Attestation, 5)
 	for i := 0; i < 5; i++ {
 		res[i] = &spec.Attestation{
@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// AttestationPoolWithOpts fetches the attestations in the pool matching the given options.
+func (s *Service) AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error) {
+	if err := s.call(ctx, "AttestationPoolWithOpts", opts); err != nil {
+		return nil, err
+	}
+	if s.AttestationPoolWithOptsFunc != nil {
+		return s.AttestationPoolWithOptsFunc(ctx, opts)
+	}
+
+	res := make([]*phase0.Attestation, 5)
+	for i := 0; i < 5; i++ {
+		res[i] = &phase0.Attestation{
+			Data: &phase0.AttestationData{
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		}
+		if opts != nil && opts.Slot != nil {
+			res[i].Data.Slot = *opts.Slot
+		}
+		if opts != nil && opts.CommitteeIndex != nil {
+			res[i].Data.Index = *opts.CommitteeIndex
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,43 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	if err := s.call(ctx, "AttestationRewards", epoch, validatorIndices); err != nil {
+		return nil, err
+	}
+	if s.AttestationRewardsFunc != nil {
+		return s.AttestationRewardsFunc(ctx, epoch, validatorIndices)
+	}
+
+	totalRewards := make([]*api.ValidatorAttestationRewards, len(validatorIndices))
+	for i := range validatorIndices {
+		totalRewards[i] = &api.ValidatorAttestationRewards{
+			ValidatorIndex: validatorIndices[i],
+		}
+	}
+
+	return &api.AttestationRewards{
+		IdealRewards: []*api.IdealAttestationRewards{},
+		TotalRewards: totalRewards,
+	}, nil
+}
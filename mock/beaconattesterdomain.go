@@ -20,6 +20,13 @@ import (
 )
 
 // BeaconAttesterDomain provides the beacon attester domain.
-func (s *Service) BeaconAttesterDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "BeaconAttesterDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.BeaconAttesterDomainFunc != nil {
+		return s.BeaconAttesterDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x01, 0x00, 0x00, 0x00}, nil
 }
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+func (s *Service) BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error) {
+	if err := s.call(ctx, "BeaconBlockAttestations", blockID); err != nil {
+		return nil, err
+	}
+	if s.BeaconBlockAttestationsFunc != nil {
+		return s.BeaconBlockAttestationsFunc(ctx, blockID)
+	}
+
+	return []*phase0.Attestation{
+		{
+			AggregationBits: []byte{0x01},
+			Data: &phase0.AttestationData{
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+	}, nil
+}
@@ -21,7 +21,14 @@ import (
 )
 
 // BeaconBlockHeader provides the block header of a given block ID.
-func (s *Service) BeaconBlockHeader(_ context.Context, _ string) (*api.BeaconBlockHeader, error) {
+func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
+	if err := s.call(ctx, "BeaconBlockHeader", blockID); err != nil {
+		return nil, err
+	}
+	if s.BeaconBlockHeaderFunc != nil {
+		return s.BeaconBlockHeaderFunc(ctx, blockID)
+	}
+
 	return &api.BeaconBlockHeader{
 		Header: &spec.SignedBeaconBlockHeader{
 			Message: &spec.BeaconBlockHeader{},
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Service) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*api.BeaconBlockHeader, error) {
+	if err := s.call(ctx, "BeaconBlockHeaders", slot, parentRoot); err != nil {
+		return nil, err
+	}
+	if s.BeaconBlockHeadersFunc != nil {
+		return s.BeaconBlockHeadersFunc(ctx, slot, parentRoot)
+	}
+
+	return []*api.BeaconBlockHeader{
+		{
+			Canonical: true,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{},
+			},
+		},
+	}, nil
+}
@@ -22,7 +22,14 @@ import (
 )
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
-func (s *Service) BeaconBlockProposal(_ context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
+	if err := s.call(ctx, "BeaconBlockProposal", slot, randaoReveal, graffiti); err != nil {
+		return nil, err
+	}
+	if s.BeaconBlockProposalFunc != nil {
+		return s.BeaconBlockProposalFunc(ctx, slot, randaoReveal, graffiti)
+	}
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := [32]byte{}
 	copy(fixedGraffiti[:], graffiti)
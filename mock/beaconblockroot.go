@@ -20,7 +20,14 @@ import (
 )
 
 // BeaconBlockRoot fetches a block's root given a block ID.
-func (s *Service) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
+	if err := s.call(ctx, "BeaconBlockRoot", blockID); err != nil {
+		return nil, err
+	}
+	if s.BeaconBlockRootFunc != nil {
+		return s.BeaconBlockRootFunc(ctx, blockID)
+	}
+
 	root := phase0.Root([32]byte{
 		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
 		0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// BeaconCommitteesWithOpts fetches the beacon committees matching the given options.
+func (s *Service) BeaconCommitteesWithOpts(ctx context.Context, opts *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error) {
+	if err := s.call(ctx, "BeaconCommitteesWithOpts", opts); err != nil {
+		return nil, err
+	}
+	if s.BeaconCommitteesWithOptsFunc != nil {
+		return s.BeaconCommitteesWithOptsFunc(ctx, opts)
+	}
+
+	res := make([]*apiv1.BeaconCommittee, 0, 5)
+	for i := 0; i < 5; i++ {
+		committee := &apiv1.BeaconCommittee{
+			Index: phase0.CommitteeIndex(i),
+		}
+		if opts != nil && opts.Slot != nil {
+			committee.Slot = *opts.Slot
+		}
+		if opts != nil && opts.Index != nil && committee.Index != *opts.Index {
+			continue
+		}
+		res = append(res, committee)
+	}
+
+	return res, nil
+}
@@ -20,6 +20,13 @@ import (
 )
 
 // BeaconProposerDomain provides the beacon proposer domain.
-func (s *Service) BeaconProposerDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "BeaconProposerDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.BeaconProposerDomainFunc != nil {
+		return s.BeaconProposerDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x00, 0x00, 0x00, 0x00}, nil
 }
@@ -24,7 +24,14 @@ import (
 )
 
 // BlindedBeaconBlockProposal fetches a blinded proposed beacon block for signing.
-func (s *Service) BlindedBeaconBlockProposal(_ context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
+func (s *Service) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
+	if err := s.call(ctx, "BlindedBeaconBlockProposal", slot, randaoReveal, graffiti); err != nil {
+		return nil, err
+	}
+	if s.BlindedBeaconBlockProposalFunc != nil {
+		return s.BlindedBeaconBlockProposalFunc(ctx, slot, randaoReveal, graffiti)
+	}
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := [32]byte{}
 	copy(fixedGraffiti[:], graffiti)
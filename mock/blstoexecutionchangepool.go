@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+)
+
+// BLSToExecutionChangePool provides the BLS to execution address change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	if err := s.call(ctx, "BLSToExecutionChangePool"); err != nil {
+		return nil, err
+	}
+	if s.BLSToExecutionChangePoolFunc != nil {
+		return s.BLSToExecutionChangePoolFunc(ctx)
+	}
+
+	return []*capella.SignedBLSToExecutionChange{}, nil
+}
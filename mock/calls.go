@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"time"
+)
+
+// Call is a recorded call to a method of the service.
+type Call struct {
+	// Method is the name of the method called.
+	Method string
+	// Args are the arguments supplied to the method, excluding the context.
+	Args []interface{}
+}
+
+// Calls returns the calls made to the service, in the order in which they
+// were made.  If methods are supplied then only calls to those methods are
+// returned.
+func (s *Service) Calls(methods ...string) []*Call {
+	s.callsMu.Lock()
+	defer s.callsMu.Unlock()
+
+	filter := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		filter[method] = true
+	}
+
+	res := make([]*Call, 0, len(s.calls))
+	for _, call := range s.calls {
+		if len(filter) > 0 && !filter[call.Method] {
+			continue
+		}
+		res = append(res, call)
+	}
+
+	return res
+}
+
+// ResetCalls clears the recorded calls.
+func (s *Service) ResetCalls() {
+	s.callsMu.Lock()
+	s.calls = make([]*Call, 0)
+	s.callsMu.Unlock()
+}
+
+// SetError sets the error to be returned by the named method.
+// A nil error clears any existing error.
+func (s *Service) SetError(method string, err error) {
+	s.callsMu.Lock()
+	defer s.callsMu.Unlock()
+
+	if err == nil {
+		delete(s.callErrors, method)
+	} else {
+		s.callErrors[method] = err
+	}
+}
+
+// SetDelay sets the delay before the named method returns.
+// A zero delay clears any existing delay.
+func (s *Service) SetDelay(method string, delay time.Duration) {
+	s.callsMu.Lock()
+	defer s.callsMu.Unlock()
+
+	if delay == 0 {
+		delete(s.callDelays, method)
+	} else {
+		s.callDelays[method] = delay
+	}
+}
+
+// call records a call to a method, then applies any delay and error
+// configured for it.
+func (s *Service) call(ctx context.Context, method string, args ...interface{}) error {
+	s.callsMu.Lock()
+	s.calls = append(s.calls, &Call{
+		Method: method,
+		Args:   args,
+	})
+	delay := s.callDelays[method]
+	err := s.callErrors[method]
+	s.callsMu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
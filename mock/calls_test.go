@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/mock"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalls(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	// Calls made during setup are not recorded.
+	require.Empty(t, service.Calls())
+
+	_, err = service.AttestationData(ctx, 1, 2)
+	require.NoError(t, err)
+	_, err = service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.NoError(t, service.SubmitAttestations(ctx, []*phase0.Attestation{}))
+
+	calls := service.Calls()
+	require.Len(t, calls, 3)
+	require.Equal(t, "AttestationData", calls[0].Method)
+	require.Equal(t, []interface{}{phase0.Slot(1), phase0.CommitteeIndex(2)}, calls[0].Args)
+	require.Equal(t, "NodeVersion", calls[1].Method)
+	require.Empty(t, calls[1].Args)
+
+	calls = service.Calls("SubmitAttestations")
+	require.Len(t, calls, 1)
+	require.Equal(t, []interface{}{[]*phase0.Attestation{}}, calls[0].Args)
+
+	service.ResetCalls()
+	require.Empty(t, service.Calls())
+}
+
+func TestFuncs(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	service.NodeVersionFunc = func(_ context.Context) (string, error) {
+		return "custom", nil
+	}
+	nodeVersion, err := service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "custom", nodeVersion)
+
+	service.AttestationDataFunc = func(_ context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+		return &phase0.AttestationData{Slot: slot, Index: committeeIndex}, nil
+	}
+	attestationData, err := service.AttestationData(ctx, 5, 6)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), attestationData.Slot)
+	require.Equal(t, phase0.CommitteeIndex(6), attestationData.Index)
+
+	// Calls are recorded even when a function is set.
+	require.Len(t, service.Calls(), 2)
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	service.SetError("NodeVersion", errors.New("mock error"))
+	_, err = service.NodeVersion(ctx)
+	require.EqualError(t, err, "mock error")
+
+	// Other methods are unaffected.
+	_, err = service.NodeSyncing(ctx)
+	require.NoError(t, err)
+
+	service.SetError("NodeVersion", nil)
+	_, err = service.NodeVersion(ctx)
+	require.NoError(t, err)
+}
+
+func TestDelays(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	service.SetDelay("NodeVersion", 100*time.Millisecond)
+	started := time.Now()
+	_, err = service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), 100*time.Millisecond)
+
+	// Delays honour the context.
+	service.SetDelay("NodeVersion", time.Minute)
+	opCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = service.NodeVersion(opCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	service.SetDelay("NodeVersion", 0)
+	started = time.Now()
+	_, err = service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), time.Minute)
+}
@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ChainHeads provides the heads of all chains known to the node.
+func (s *Service) ChainHeads(ctx context.Context) ([]*apiv1.ChainHead, error) {
+	if err := s.call(ctx, "ChainHeads"); err != nil {
+		return nil, err
+	}
+	if s.ChainHeadsFunc != nil {
+		return s.ChainHeadsFunc(ctx)
+	}
+
+	return []*apiv1.ChainHead{
+		{
+			Root: phase0.Root{0x01},
+		},
+	}, nil
+}
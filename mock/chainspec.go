@@ -0,0 +1,36 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// ChainSpec provides the spec information of the chain.
+func (s *Service) ChainSpec(ctx context.Context) (*apiv1.ChainSpec, error) {
+	if err := s.call(ctx, "ChainSpec"); err != nil {
+		return nil, err
+	}
+	if s.ChainSpecFunc != nil {
+		return s.ChainSpecFunc(ctx)
+	}
+
+	return apiv1.ChainSpecFromMap(map[string]string{
+		"GENESIS_FORK_VERSION": "0x00000000",
+		"SECONDS_PER_SLOT":     "12",
+		"SLOTS_PER_EPOCH":      "32",
+	})
+}
@@ -20,6 +20,13 @@ import (
 )
 
 // DepositDomain provides the deposit domain.
-func (s *Service) DepositDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "DepositDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.DepositDomainFunc != nil {
+		return s.DepositDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x03, 0x00, 0x00, 0x00}, nil
 }
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+func (s *Service) DepositSnapshot(ctx context.Context) (*apiv1.DepositSnapshot, error) {
+	if err := s.call(ctx, "DepositSnapshot"); err != nil {
+		return nil, err
+	}
+	if s.DepositSnapshotFunc != nil {
+		return s.DepositSnapshotFunc(ctx)
+	}
+
+	return &apiv1.DepositSnapshot{
+		Finalized: []phase0.Root{},
+		// Root of the empty deposit tree.
+		DepositRoot: phase0.Root{
+			0xd7, 0x0a, 0x23, 0x47, 0x31, 0x28, 0x5c, 0x68, 0x04, 0xc2, 0xa4, 0xf5, 0x67, 0x11, 0xdd, 0xb8,
+			0xc8, 0x2c, 0x99, 0x74, 0x0f, 0x20, 0x78, 0x54, 0x89, 0x10, 0x28, 0xaf, 0x34, 0xe2, 0x7e, 0x5e,
+		},
+	}, nil
+}
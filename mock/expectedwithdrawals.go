@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ExpectedWithdrawals provides the withdrawals that will be included in the next
+// block built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error) {
+	if err := s.call(ctx, "ExpectedWithdrawals", stateID, proposalSlot); err != nil {
+		return nil, err
+	}
+	if s.ExpectedWithdrawalsFunc != nil {
+		return s.ExpectedWithdrawalsFunc(ctx, stateID, proposalSlot)
+	}
+
+	return []*capella.Withdrawal{
+		{
+			Index:          1,
+			ValidatorIndex: 2,
+			Amount:         32000000000,
+		},
+	}, nil
+}
@@ -20,6 +20,13 @@ import (
 )
 
 // FarFutureEpoch provides the values for FAR_FUTURE_EOPCH of the chain.
-func (s *Service) FarFutureEpoch(_ context.Context) (spec.Epoch, error) {
+func (s *Service) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	if err := s.call(ctx, "FarFutureEpoch"); err != nil {
+		return 0, err
+	}
+	if s.FarFutureEpochFunc != nil {
+		return s.FarFutureEpochFunc(ctx)
+	}
+
 	return spec.Epoch(0xffffffffffffffff), nil
 }
@@ -0,0 +1,33 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// FilteredValidators provides the validators, with their balance and status, for a given state.
+func (s *Service) FilteredValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	if err := s.call(ctx, "FilteredValidators", stateID, validatorIndices, validatorPubKeys, validatorStates); err != nil {
+		return nil, err
+	}
+	if s.FilteredValidatorsFunc != nil {
+		return s.FilteredValidatorsFunc(ctx, stateID, validatorIndices, validatorPubKeys, validatorStates)
+	}
+
+	return map[phase0.ValidatorIndex]*apiv1.Validator{}, nil
+}
@@ -21,7 +21,14 @@ import (
 )
 
 // Finality provides the finality given a state ID.
-func (s *Service) Finality(_ context.Context, _ string) (*api.Finality, error) {
+func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
+	if err := s.call(ctx, "Finality", stateID); err != nil {
+		return nil, err
+	}
+	if s.FinalityFunc != nil {
+		return s.FinalityFunc(ctx, stateID)
+	}
+
 	return &api.Finality{
 		Finalized: &spec.Checkpoint{
 			Epoch: 6,
@@ -20,6 +20,13 @@ import (
 )
 
 // Fork fetches fork information for the given state.
-func (s *Service) Fork(ctx context.Context, _ string) (*spec.Fork, error) {
+func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error) {
+	if err := s.call(ctx, "Fork", stateID); err != nil {
+		return nil, err
+	}
+	if s.ForkFunc != nil {
+		return s.ForkFunc(ctx, stateID)
+	}
+
 	return s.forkAtEpoch(ctx, 1)
 }
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// ForkChoice provides a dump of the fork choice store of the node.
+func (s *Service) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
+	if err := s.call(ctx, "ForkChoice"); err != nil {
+		return nil, err
+	}
+	if s.ForkChoiceFunc != nil {
+		return s.ForkChoiceFunc(ctx)
+	}
+
+	root := phase0.Root{0x01}
+
+	return &apiv1.ForkChoice{
+		JustifiedCheckpoint: &phase0.Checkpoint{Root: root},
+		FinalizedCheckpoint: &phase0.Checkpoint{Root: root},
+		Nodes: []*apiv1.ForkChoiceNode{
+			{
+				BlockRoot: root,
+				Validity:  apiv1.ForkChoiceNodeValidityValid,
+			},
+		},
+	}, nil
+}
@@ -20,6 +20,13 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	if err := s.call(ctx, "GenesisTime"); err != nil {
+		return time.Time{}, err
+	}
+	if s.GenesisTimeFunc != nil {
+		return s.GenesisTimeFunc(ctx)
+	}
+
 	genesis, err := s.Genesis(ctx)
 	if err != nil {
 		return time.Time{}, err
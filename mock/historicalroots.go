@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// HistoricalRoots fetches the historical roots of the given state.
+func (s *Service) HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error) {
+	if err := s.call(ctx, "HistoricalRoots", stateID); err != nil {
+		return nil, err
+	}
+	if s.HistoricalRootsFunc != nil {
+		return s.HistoricalRootsFunc(ctx, stateID)
+	}
+
+	return []phase0.Root{{0x01}, {0x02}}, nil
+}
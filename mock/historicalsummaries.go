@@ -0,0 +1,38 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// HistoricalSummaries fetches the historical summaries of the given state.
+func (s *Service) HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error) {
+	if err := s.call(ctx, "HistoricalSummaries", stateID); err != nil {
+		return nil, err
+	}
+	if s.HistoricalSummariesFunc != nil {
+		return s.HistoricalSummariesFunc(ctx, stateID)
+	}
+
+	return []*capella.HistoricalSummary{
+		{
+			BlockSummaryRoot: phase0.Root{0x01},
+			StateSummaryRoot: phase0.Root{0x02},
+		},
+	}, nil
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	if err := s.call(ctx, "LightClientOptimisticUpdate"); err != nil {
+		return nil, err
+	}
+	if s.LightClientOptimisticUpdateFunc != nil {
+		return s.LightClientOptimisticUpdateFunc(ctx)
+	}
+
+	return &spec.VersionedLightClientOptimisticUpdate{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.LightClientOptimisticUpdate{
+			AttestedHeader: &altair.LightClientHeader{
+				Beacon: &phase0.BeaconBlockHeader{},
+			},
+		},
+	}, nil
+}
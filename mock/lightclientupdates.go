@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// LightClientUpdates fetches light client updates for count sync committee
+// periods, starting with the given period.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error) {
+	if err := s.call(ctx, "LightClientUpdates", startPeriod, count); err != nil {
+		return nil, err
+	}
+	if s.LightClientUpdatesFunc != nil {
+		return s.LightClientUpdatesFunc(ctx, startPeriod, count)
+	}
+
+	updates := make([]*spec.VersionedLightClientUpdate, count)
+	for i := range updates {
+		updates[i] = &spec.VersionedLightClientUpdate{
+			Version: spec.DataVersionAltair,
+			Altair: &altair.LightClientUpdate{
+				AttestedHeader: &altair.LightClientHeader{
+					Beacon: &phase0.BeaconBlockHeader{},
+				},
+				FinalizedHeader: &altair.LightClientHeader{
+					Beacon: &phase0.BeaconBlockHeader{},
+				},
+			},
+		}
+	}
+
+	return updates, nil
+}
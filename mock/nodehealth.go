@@ -0,0 +1,35 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context) (api.NodeHealth, error) {
+	if err := s.call(ctx, "NodeHealth"); err != nil {
+		return api.NodeHealthUnknown, err
+	}
+	if s.NodeHealthFunc != nil {
+		return s.NodeHealthFunc(ctx)
+	}
+
+	if s.SyncDistance > 0 {
+		return api.NodeHealthSyncing, nil
+	}
+	return api.NodeHealthReady, nil
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// NodePeerCount provides the number of peers of the node in each state.
+func (s *Service) NodePeerCount(ctx context.Context) (*api.PeerCount, error) {
+	if err := s.call(ctx, "NodePeerCount"); err != nil {
+		return nil, err
+	}
+	if s.NodePeerCountFunc != nil {
+		return s.NodePeerCountFunc(ctx)
+	}
+
+	return &api.PeerCount{}, nil
+}
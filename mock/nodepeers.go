@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// NodePeers provides the peers of the node.
+func (s *Service) NodePeers(ctx context.Context, states []string, directions []string) ([]*api.Peer, error) {
+	if err := s.call(ctx, "NodePeers", states, directions); err != nil {
+		return nil, err
+	}
+	if s.NodePeersFunc != nil {
+		return s.NodePeersFunc(ctx, states, directions)
+	}
+
+	return []*api.Peer{}, nil
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Service) NodePeer(ctx context.Context, peerID string) (*api.Peer, error) {
+	if err := s.call(ctx, "NodePeer", peerID); err != nil {
+		return nil, err
+	}
+	if s.NodePeerFunc != nil {
+		return s.NodePeerFunc(ctx, peerID)
+	}
+
+	return &api.Peer{
+		PeerID:             peerID,
+		LastSeenP2PAddress: "/ip4/127.0.0.1/tcp/9000",
+		State:              "connected",
+		Direction:          "outbound",
+	}, nil
+}
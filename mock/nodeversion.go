@@ -18,6 +18,13 @@ import (
 )
 
 // NodeVersion returns a free-text string with the node version.
-func (s *Service) NodeVersion(_ context.Context) (string, error) {
+func (s *Service) NodeVersion(ctx context.Context) (string, error) {
+	if err := s.call(ctx, "NodeVersion"); err != nil {
+		return "", err
+	}
+	if s.NodeVersionFunc != nil {
+		return s.NodeVersionFunc(ctx)
+	}
+
 	return s.nodeVersion, nil
 }
@@ -20,6 +20,13 @@ import (
 )
 
 // RANDAODomain provides the RANDAO domain.
-func (s *Service) RANDAODomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "RANDAODomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.RANDAODomainFunc != nil {
+		return s.RANDAODomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x02, 0x00, 0x00, 0x00}, nil
 }
@@ -20,6 +20,13 @@ import (
 )
 
 // SelectionProofDomain provides the selection proof domain.
-func (s *Service) SelectionProofDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "SelectionProofDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.SelectionProofDomainFunc != nil {
+		return s.SelectionProofDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x05, 0x00, 0x00, 0x00}, nil
 }
@@ -0,0 +1,242 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	apiv1bellatrix "github.com/jefmcl/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/jefmcl/go-eth2-client/api/v1/deneb"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/jefmcl/go-eth2-client/util/bellatrix"
+	utilcapella "github.com/jefmcl/go-eth2-client/util/capella"
+)
+
+// handleBeaconBlockAttestations serves the attestations included in a beacon block.
+func (s *Server) handleBeaconBlockAttestations(w http.ResponseWriter, _ *http.Request, params []string) {
+	block := s.resolveBlock(w, params[0])
+	if block == nil {
+		return
+	}
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if attestations == nil {
+		attestations = make([]*phase0.Attestation, 0)
+	}
+
+	writeData(w, attestations)
+}
+
+// handleSignedBlindedBeaconBlock serves a signed blinded beacon block, as JSON or SSZ.
+// Blocks prior to bellatrix have no execution payload, so are served unaltered.
+func (s *Server) handleSignedBlindedBeaconBlock(w http.ResponseWriter, r *http.Request, params []string) {
+	block := s.resolveBlock(w, params[0])
+	if block == nil {
+		return
+	}
+
+	data, err := blindedBlockData(block)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if wantsSSZ(r) {
+		ssz, err := data.MarshalSSZ()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeSSZ(w, block.Version.String(), ssz)
+		return
+	}
+
+	writeVersionedData(w, block.Version.String(), data)
+}
+
+// blindedBlockData provides the versioned data of a signed beacon block with
+// its execution payload replaced by the payload header.
+func blindedBlockData(block *spec.VersionedSignedBeaconBlock) (sszMarshaler, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair:
+		return blockData(block)
+	case spec.DataVersionBellatrix:
+		return blindBellatrixBlock(block.Bellatrix)
+	case spec.DataVersionCapella:
+		return blindCapellaBlock(block.Capella)
+	case spec.DataVersionDeneb:
+		return blindDenebBlock(block.Deneb)
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", block.Version)
+	}
+}
+
+func blindBellatrixBlock(block *bellatrix.SignedBeaconBlock) (*apiv1bellatrix.SignedBlindedBeaconBlock, error) {
+	payload := block.Message.Body.ExecutionPayload
+	transactionsRoot, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: payload.Transactions}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1bellatrix.SignedBlindedBeaconBlock{
+		Message: &apiv1bellatrix.BlindedBeaconBlock{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+				RANDAOReveal:      block.Message.Body.RANDAOReveal,
+				ETH1Data:          block.Message.Body.ETH1Data,
+				Graffiti:          block.Message.Body.Graffiti,
+				ProposerSlashings: block.Message.Body.ProposerSlashings,
+				AttesterSlashings: block.Message.Body.AttesterSlashings,
+				Attestations:      block.Message.Body.Attestations,
+				Deposits:          block.Message.Body.Deposits,
+				VoluntaryExits:    block.Message.Body.VoluntaryExits,
+				SyncAggregate:     block.Message.Body.SyncAggregate,
+				ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{
+					ParentHash:       payload.ParentHash,
+					FeeRecipient:     payload.FeeRecipient,
+					StateRoot:        payload.StateRoot,
+					ReceiptsRoot:     payload.ReceiptsRoot,
+					LogsBloom:        payload.LogsBloom,
+					PrevRandao:       payload.PrevRandao,
+					BlockNumber:      payload.BlockNumber,
+					GasLimit:         payload.GasLimit,
+					GasUsed:          payload.GasUsed,
+					Timestamp:        payload.Timestamp,
+					ExtraData:        payload.ExtraData,
+					BaseFeePerGas:    payload.BaseFeePerGas,
+					BlockHash:        payload.BlockHash,
+					TransactionsRoot: transactionsRoot,
+				},
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
+
+func blindCapellaBlock(block *capella.SignedBeaconBlock) (*apiv1capella.SignedBlindedBeaconBlock, error) {
+	payload := block.Message.Body.ExecutionPayload
+	transactionsRoot, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: payload.Transactions}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	withdrawalsRoot, err := (&utilcapella.ExecutionPayloadWithdrawals{Withdrawals: payload.Withdrawals}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1capella.SignedBlindedBeaconBlock{
+		Message: &apiv1capella.BlindedBeaconBlock{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &apiv1capella.BlindedBeaconBlockBody{
+				RANDAOReveal:      block.Message.Body.RANDAOReveal,
+				ETH1Data:          block.Message.Body.ETH1Data,
+				Graffiti:          block.Message.Body.Graffiti,
+				ProposerSlashings: block.Message.Body.ProposerSlashings,
+				AttesterSlashings: block.Message.Body.AttesterSlashings,
+				Attestations:      block.Message.Body.Attestations,
+				Deposits:          block.Message.Body.Deposits,
+				VoluntaryExits:    block.Message.Body.VoluntaryExits,
+				SyncAggregate:     block.Message.Body.SyncAggregate,
+				ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{
+					ParentHash:       payload.ParentHash,
+					FeeRecipient:     payload.FeeRecipient,
+					StateRoot:        payload.StateRoot,
+					ReceiptsRoot:     payload.ReceiptsRoot,
+					LogsBloom:        payload.LogsBloom,
+					PrevRandao:       payload.PrevRandao,
+					BlockNumber:      payload.BlockNumber,
+					GasLimit:         payload.GasLimit,
+					GasUsed:          payload.GasUsed,
+					Timestamp:        payload.Timestamp,
+					ExtraData:        payload.ExtraData,
+					BaseFeePerGas:    payload.BaseFeePerGas,
+					BlockHash:        payload.BlockHash,
+					TransactionsRoot: transactionsRoot,
+					WithdrawalsRoot:  withdrawalsRoot,
+				},
+				BLSToExecutionChanges: block.Message.Body.BLSToExecutionChanges,
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
+
+func blindDenebBlock(block *deneb.SignedBeaconBlock) (*apiv1deneb.SignedBlindedBeaconBlock, error) {
+	payload := block.Message.Body.ExecutionPayload
+	transactionsRoot, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: payload.Transactions}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	withdrawalsRoot, err := (&utilcapella.ExecutionPayloadWithdrawals{Withdrawals: payload.Withdrawals}).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1deneb.SignedBlindedBeaconBlock{
+		Message: &apiv1deneb.BlindedBeaconBlock{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &apiv1deneb.BlindedBeaconBlockBody{
+				RANDAOReveal:      block.Message.Body.RANDAOReveal,
+				ETH1Data:          block.Message.Body.ETH1Data,
+				Graffiti:          block.Message.Body.Graffiti,
+				ProposerSlashings: block.Message.Body.ProposerSlashings,
+				AttesterSlashings: block.Message.Body.AttesterSlashings,
+				Attestations:      block.Message.Body.Attestations,
+				Deposits:          block.Message.Body.Deposits,
+				VoluntaryExits:    block.Message.Body.VoluntaryExits,
+				SyncAggregate:     block.Message.Body.SyncAggregate,
+				ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+					ParentHash:       payload.ParentHash,
+					FeeRecipient:     payload.FeeRecipient,
+					StateRoot:        payload.StateRoot,
+					ReceiptsRoot:     payload.ReceiptsRoot,
+					LogsBloom:        payload.LogsBloom,
+					PrevRandao:       payload.PrevRandao,
+					BlockNumber:      payload.BlockNumber,
+					GasLimit:         payload.GasLimit,
+					GasUsed:          payload.GasUsed,
+					Timestamp:        payload.Timestamp,
+					ExtraData:        payload.ExtraData,
+					BaseFeePerGas:    payload.BaseFeePerGas,
+					BlockHash:        payload.BlockHash,
+					TransactionsRoot: transactionsRoot,
+					WithdrawalsRoot:  withdrawalsRoot,
+					ExcessDataGas:    payload.ExcessDataGas,
+				},
+				BLSToExecutionChanges: block.Message.Body.BLSToExecutionChanges,
+				BlobKzgCommitments:    block.Message.Body.BlobKzgCommitments,
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
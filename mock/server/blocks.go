@@ -0,0 +1,301 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// sszMarshaler is the interface for objects that can be marshaled to SSZ.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type rootJSON struct {
+	Root string `json:"root"`
+}
+
+// handleSignedBeaconBlock serves a signed beacon block, as JSON or SSZ.
+func (s *Server) handleSignedBeaconBlock(w http.ResponseWriter, r *http.Request, params []string) {
+	block := s.resolveBlock(w, params[0])
+	if block == nil {
+		return
+	}
+
+	data, err := blockData(block)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if wantsSSZ(r) {
+		ssz, err := data.MarshalSSZ()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeSSZ(w, block.Version.String(), ssz)
+		return
+	}
+
+	writeVersionedData(w, block.Version.String(), data)
+}
+
+// handleBeaconBlockRoot serves the root of a beacon block.
+func (s *Server) handleBeaconBlockRoot(w http.ResponseWriter, _ *http.Request, params []string) {
+	root, exists := s.chain.BlockRoot(params[0])
+	if !exists {
+		writeError(w, http.StatusNotFound, "block not found")
+		return
+	}
+
+	writeData(w, &rootJSON{Root: fmt.Sprintf("%#x", root)})
+}
+
+// handleBeaconBlockHeader serves the header of a beacon block.
+func (s *Server) handleBeaconBlockHeader(w http.ResponseWriter, _ *http.Request, params []string) {
+	root, exists := s.chain.BlockRoot(params[0])
+	if !exists {
+		writeError(w, http.StatusNotFound, "block not found")
+		return
+	}
+	block := s.chain.Block(root)
+	if block == nil {
+		writeError(w, http.StatusNotFound, "block not found")
+		return
+	}
+
+	header, err := blockHeader(block)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeData(w, &apiv1.BeaconBlockHeader{
+		Root:      root,
+		Canonical: s.chain.IsCanonical(root),
+		Header:    header,
+	})
+}
+
+// handleBeaconBlockHeaders serves the headers of beacon blocks matching the query.
+func (s *Server) handleBeaconBlockHeaders(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query()
+	slotFilter := query.Get("slot")
+	parentRootFilter := strings.ToLower(query.Get("parent_root"))
+
+	var roots []phase0.Root
+	if slotFilter == "" && parentRootFilter == "" {
+		roots = []phase0.Root{s.chain.Head()}
+	} else {
+		roots = s.chain.BlockRoots()
+	}
+
+	headers := make([]*apiv1.BeaconBlockHeader, 0)
+	for _, root := range roots {
+		header, err := blockHeader(s.chain.Block(root))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if slotFilter != "" && slotFilter != fmt.Sprintf("%d", header.Message.Slot) {
+			continue
+		}
+		if parentRootFilter != "" && parentRootFilter != fmt.Sprintf("%#x", header.Message.ParentRoot) {
+			continue
+		}
+		headers = append(headers, &apiv1.BeaconBlockHeader{
+			Root:      root,
+			Canonical: s.chain.IsCanonical(root),
+			Header:    header,
+		})
+	}
+
+	writeData(w, headers)
+}
+
+// handleSubmitBeaconBlock accepts a signed beacon block and makes it the head of the chain.
+func (s *Server) handleSubmitBeaconBlock(w http.ResponseWriter, r *http.Request, _ []string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read body")
+		return
+	}
+
+	block, err := decodeSignedBeaconBlock(r.Header.Get("Eth-Consensus-Version"), body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	root, err := s.chain.AddBlock(block)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := s.chain.SetHead(root); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// resolveBlock resolves a block ID to a block, writing an error response if not found.
+func (s *Server) resolveBlock(w http.ResponseWriter, blockID string) *spec.VersionedSignedBeaconBlock {
+	root, exists := s.chain.BlockRoot(blockID)
+	if !exists {
+		writeError(w, http.StatusNotFound, "block not found")
+		return nil
+	}
+	block := s.chain.Block(root)
+	if block == nil {
+		writeError(w, http.StatusNotFound, "block not found")
+		return nil
+	}
+
+	return block
+}
+
+// blockData provides the versioned data of a signed beacon block.
+func blockData(block *spec.VersionedSignedBeaconBlock) (sszMarshaler, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		return block.Phase0, nil
+	case spec.DataVersionAltair:
+		return block.Altair, nil
+	case spec.DataVersionBellatrix:
+		return block.Bellatrix, nil
+	case spec.DataVersionCapella:
+		return block.Capella, nil
+	case spec.DataVersionDeneb:
+		return block.Deneb, nil
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", block.Version)
+	}
+}
+
+// blockHeader creates a signed header for a signed beacon block.
+func blockHeader(block *spec.VersionedSignedBeaconBlock) (*phase0.SignedBeaconBlockHeader, error) {
+	var proposerIndex phase0.ValidatorIndex
+	var signature phase0.BLSSignature
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		proposerIndex = block.Phase0.Message.ProposerIndex
+		signature = block.Phase0.Signature
+	case spec.DataVersionAltair:
+		proposerIndex = block.Altair.Message.ProposerIndex
+		signature = block.Altair.Signature
+	case spec.DataVersionBellatrix:
+		proposerIndex = block.Bellatrix.Message.ProposerIndex
+		signature = block.Bellatrix.Signature
+	case spec.DataVersionCapella:
+		proposerIndex = block.Capella.Message.ProposerIndex
+		signature = block.Capella.Signature
+	case spec.DataVersionDeneb:
+		proposerIndex = block.Deneb.Message.ProposerIndex
+		signature = block.Deneb.Signature
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", block.Version)
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := block.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := block.BodyRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		Signature: signature,
+	}, nil
+}
+
+// decodeSignedBeaconBlock decodes a signed beacon block.
+// If the version is not supplied each version is tried in turn, latest first.
+func decodeSignedBeaconBlock(version string, data []byte) (*spec.VersionedSignedBeaconBlock, error) {
+	versions := []spec.DataVersion{
+		spec.DataVersionDeneb,
+		spec.DataVersionCapella,
+		spec.DataVersionBellatrix,
+		spec.DataVersionAltair,
+		spec.DataVersionPhase0,
+	}
+	if version != "" {
+		var dataVersion spec.DataVersion
+		if err := dataVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", version))); err != nil {
+			return nil, errors.Wrap(err, "invalid consensus version")
+		}
+		versions = []spec.DataVersion{dataVersion}
+	}
+
+	for _, dataVersion := range versions {
+		res := &spec.VersionedSignedBeaconBlock{
+			Version: dataVersion,
+		}
+		var err error
+		switch dataVersion {
+		case spec.DataVersionPhase0:
+			res.Phase0 = &phase0.SignedBeaconBlock{}
+			err = json.Unmarshal(data, res.Phase0)
+		case spec.DataVersionAltair:
+			res.Altair = &altair.SignedBeaconBlock{}
+			err = json.Unmarshal(data, res.Altair)
+		case spec.DataVersionBellatrix:
+			res.Bellatrix = &bellatrix.SignedBeaconBlock{}
+			err = json.Unmarshal(data, res.Bellatrix)
+		case spec.DataVersionCapella:
+			res.Capella = &capella.SignedBeaconBlock{}
+			err = json.Unmarshal(data, res.Capella)
+		case spec.DataVersionDeneb:
+			res.Deneb = &deneb.SignedBeaconBlock{}
+			err = json.Unmarshal(data, res.Deneb)
+		}
+		if err == nil {
+			return res, nil
+		}
+	}
+
+	return nil, errors.New("failed to decode block")
+}
@@ -0,0 +1,950 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Chain is a scriptable in-memory beacon chain.
+// All data served by the mock server comes from its chain, and tests can
+// alter the chain at any time to set up the scenario they require.
+type Chain struct {
+	mu sync.RWMutex
+
+	genesis         *apiv1.Genesis
+	slotDuration    time.Duration
+	slotsPerEpoch   uint64
+	config          map[string]string
+	forkSchedule    []*phase0.Fork
+	depositContract *apiv1.DepositContract
+	syncDistance    phase0.Slot
+
+	genesisRoot    phase0.Root
+	headRoot       phase0.Root
+	blocks         map[phase0.Root]*spec.VersionedSignedBeaconBlock
+	canonical      map[phase0.Slot]phase0.Root
+	finality       *apiv1.Finality
+	states         map[phase0.Slot]*spec.VersionedBeaconState
+	validators     []*apiv1.Validator
+	committees     map[phase0.Epoch][]*apiv1.BeaconCommittee
+	proposerDuties map[phase0.Epoch][]*apiv1.ProposerDuty
+	attestations   []*phase0.Attestation
+	syncCommittees map[uint64]*apiv1.SyncCommittee
+	randaos        map[phase0.Epoch]phase0.Root
+
+	subscribersMu sync.RWMutex
+	subscribers   map[chan *apiv1.Event]map[string]bool
+}
+
+// NewChain creates a new chain containing only a genesis block.
+func NewChain(genesisTime time.Time, slotDuration time.Duration, slotsPerEpoch uint64) (*Chain, error) {
+	if slotDuration == 0 {
+		return nil, errors.New("no slot duration specified")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("no slots per epoch specified")
+	}
+
+	genesisForkVersion := phase0.Version{0x01, 0x02, 0x03, 0x04}
+	c := &Chain{
+		genesis: &apiv1.Genesis{
+			GenesisTime: genesisTime,
+			GenesisValidatorsRoot: phase0.Root{
+				0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+				0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+			},
+			GenesisForkVersion: genesisForkVersion,
+		},
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+		forkSchedule: []*phase0.Fork{
+			{
+				PreviousVersion: genesisForkVersion,
+				CurrentVersion:  genesisForkVersion,
+				Epoch:           0,
+			},
+		},
+		depositContract: &apiv1.DepositContract{
+			ChainID: 1,
+			Address: make([]byte, 20),
+		},
+		blocks:         make(map[phase0.Root]*spec.VersionedSignedBeaconBlock),
+		canonical:      make(map[phase0.Slot]phase0.Root),
+		states:         make(map[phase0.Slot]*spec.VersionedBeaconState),
+		committees:     make(map[phase0.Epoch][]*apiv1.BeaconCommittee),
+		proposerDuties: make(map[phase0.Epoch][]*apiv1.ProposerDuty),
+		syncCommittees: make(map[uint64]*apiv1.SyncCommittee),
+		randaos:        make(map[phase0.Epoch]phase0.Root),
+		subscribers:    make(map[chan *apiv1.Event]map[string]bool),
+	}
+	c.config = defaultConfig(slotDuration, slotsPerEpoch, genesisForkVersion)
+
+	genesisBlock := emptyBlock(0, 0, phase0.Root{})
+	genesisRoot, err := genesisBlock.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate genesis block root")
+	}
+	c.blocks[genesisRoot] = genesisBlock
+	c.canonical[0] = genesisRoot
+	c.genesisRoot = genesisRoot
+	c.headRoot = genesisRoot
+	c.finality = &apiv1.Finality{
+		Finalized:         &phase0.Checkpoint{Root: genesisRoot},
+		Justified:         &phase0.Checkpoint{Root: genesisRoot},
+		PreviousJustified: &phase0.Checkpoint{Root: genesisRoot},
+	}
+
+	return c, nil
+}
+
+// defaultConfig provides a minimal configuration sufficient for the http client.
+func defaultConfig(slotDuration time.Duration, slotsPerEpoch uint64, genesisForkVersion phase0.Version) map[string]string {
+	// The configuration can only express whole seconds, so sub-second slots report as 1 second.
+	secondsPerSlot := uint64(slotDuration.Seconds())
+	if secondsPerSlot == 0 {
+		secondsPerSlot = 1
+	}
+
+	return map[string]string{
+		"CONFIG_NAME":                           "mock",
+		"PRESET_BASE":                           "mainnet",
+		"SECONDS_PER_SLOT":                      fmt.Sprintf("%d", secondsPerSlot),
+		"SLOTS_PER_EPOCH":                       fmt.Sprintf("%d", slotsPerEpoch),
+		"TARGET_AGGREGATORS_PER_COMMITTEE":      "16",
+		"MAX_COMMITTEES_PER_SLOT":               "64",
+		"TARGET_COMMITTEE_SIZE":                 "128",
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":      "256",
+		"SYNC_COMMITTEE_SIZE":                   "512",
+		"GENESIS_FORK_VERSION":                  fmt.Sprintf("%#x", genesisForkVersion),
+		"DEPOSIT_CHAIN_ID":                      "1",
+		"DEPOSIT_CONTRACT_ADDRESS":              "0x0000000000000000000000000000000000000000",
+		"DOMAIN_BEACON_PROPOSER":                "0x00000000",
+		"DOMAIN_BEACON_ATTESTER":                "0x01000000",
+		"DOMAIN_RANDAO":                         "0x02000000",
+		"DOMAIN_DEPOSIT":                        "0x03000000",
+		"DOMAIN_VOLUNTARY_EXIT":                 "0x04000000",
+		"DOMAIN_SELECTION_PROOF":                "0x05000000",
+		"DOMAIN_AGGREGATE_AND_PROOF":            "0x06000000",
+		"DOMAIN_SYNC_COMMITTEE":                 "0x07000000",
+		"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF": "0x08000000",
+		"DOMAIN_CONTRIBUTION_AND_PROOF":         "0x09000000",
+	}
+}
+
+// emptyBlock creates an empty phase 0 block.
+func emptyBlock(slot phase0.Slot, proposerIndex phase0.ValidatorIndex, parentRoot phase0.Root) *spec.VersionedSignedBeaconBlock {
+	// The state root is not calculated, but needs to be unique per block.
+	seed := make([]byte, 40)
+	copy(seed[0:32], parentRoot[:])
+	binary.LittleEndian.PutUint64(seed[32:40], uint64(slot))
+	stateRoot := phase0.Root(sha256.Sum256(seed))
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+				ParentRoot:    parentRoot,
+				StateRoot:     stateRoot,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      []*phase0.Attestation{},
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				},
+			},
+		},
+	}
+}
+
+// Genesis provides the genesis information of the chain.
+func (c *Chain) Genesis() *apiv1.Genesis {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.genesis
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (c *Chain) SlotDuration() time.Duration {
+	return c.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in each epoch of the chain.
+func (c *Chain) SlotsPerEpoch() uint64 {
+	return c.slotsPerEpoch
+}
+
+// Config provides a copy of the chain's configuration, as served by the spec endpoint.
+func (c *Chain) Config() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res := make(map[string]string, len(c.config))
+	for k, v := range c.config {
+		res[k] = v
+	}
+
+	return res
+}
+
+// SetConfig sets a configuration value.
+func (c *Chain) SetConfig(key string, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.config[key] = value
+}
+
+// ForkSchedule provides the fork schedule of the chain.
+func (c *Chain) ForkSchedule() []*phase0.Fork {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.forkSchedule
+}
+
+// SetForkSchedule sets the fork schedule of the chain.
+func (c *Chain) SetForkSchedule(forkSchedule []*phase0.Fork) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forkSchedule = forkSchedule
+}
+
+// DepositContract provides the deposit contract of the chain.
+func (c *Chain) DepositContract() *apiv1.DepositContract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.depositContract
+}
+
+// SetDepositContract sets the deposit contract of the chain.
+func (c *Chain) SetDepositContract(depositContract *apiv1.DepositContract) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.depositContract = depositContract
+}
+
+// SyncState provides the synchronization state of the chain.
+func (c *Chain) SyncState() *apiv1.SyncState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &apiv1.SyncState{
+		HeadSlot:     c.headSlot(),
+		SyncDistance: c.syncDistance,
+		IsSyncing:    c.syncDistance > 0,
+	}
+}
+
+// SetSyncDistance sets the distance the node reports it is behind the chain.
+func (c *Chain) SetSyncDistance(syncDistance phase0.Slot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncDistance = syncDistance
+}
+
+// Head provides the root of the head block.
+func (c *Chain) Head() phase0.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.headRoot
+}
+
+// headSlot provides the slot of the head block.
+// The caller must hold the lock.
+func (c *Chain) headSlot() phase0.Slot {
+	slot, _ := c.blocks[c.headRoot].Slot()
+
+	return slot
+}
+
+// AddBlock adds a block to the chain without altering the head.
+func (c *Chain) AddBlock(block *spec.VersionedSignedBeaconBlock) (phase0.Root, error) {
+	if block == nil {
+		return phase0.Root{}, errors.New("no block supplied")
+	}
+	root, err := block.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate block root")
+	}
+	slot, err := block.Slot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain block slot")
+	}
+
+	c.mu.Lock()
+	c.blocks[root] = block
+	c.mu.Unlock()
+
+	c.publish(&apiv1.Event{
+		Topic: "block",
+		Data: &apiv1.BlockEvent{
+			Slot:  slot,
+			Block: root,
+		},
+	})
+
+	return root, nil
+}
+
+// ProposeBlock creates an empty block at the given slot on top of the current head,
+// and makes it the new head.
+func (c *Chain) ProposeBlock(slot phase0.Slot) (phase0.Root, error) {
+	c.mu.RLock()
+	parentRoot := c.headRoot
+	if c.headSlot() >= slot {
+		c.mu.RUnlock()
+		return phase0.Root{}, fmt.Errorf("slot %d is not after head slot %d", slot, c.headSlot())
+	}
+	proposerIndex := phase0.ValidatorIndex(0)
+	if len(c.validators) > 0 {
+		proposerIndex = c.validators[uint64(slot)%uint64(len(c.validators))].Index
+	}
+	c.mu.RUnlock()
+
+	root, err := c.AddBlock(emptyBlock(slot, proposerIndex, parentRoot))
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	if err := c.SetHead(root); err != nil {
+		return phase0.Root{}, err
+	}
+
+	return root, nil
+}
+
+// SetHead sets the head of the chain to the given block, which must already be present.
+// If the current head is not an ancestor of the new head a chain reorganisation is signalled.
+func (c *Chain) SetHead(root phase0.Root) error {
+	c.mu.Lock()
+	newHead, exists := c.blocks[root]
+	if !exists {
+		c.mu.Unlock()
+		return fmt.Errorf("block %#x not found", root)
+	}
+	oldHeadRoot := c.headRoot
+	oldHeadBlock := c.blocks[oldHeadRoot]
+	oldHeadSlot, _ := oldHeadBlock.Slot()
+	oldHeadState, _ := oldHeadBlock.StateRoot()
+
+	// Rebuild the canonical chain from the new head.
+	canonical := make(map[phase0.Slot]phase0.Root)
+	for blockRoot, block := root, newHead; block != nil; {
+		slot, _ := block.Slot()
+		canonical[slot] = blockRoot
+		if slot == 0 {
+			break
+		}
+		blockRoot, _ = block.ParentRoot()
+		block = c.blocks[blockRoot]
+	}
+	c.canonical = canonical
+	c.headRoot = root
+
+	// Find the common ancestor to decide if this is a reorg.
+	var reorg *apiv1.ChainReorgEvent
+	if oldHeadRoot != root {
+		ancestorSlot := oldHeadSlot
+		for blockRoot, block := oldHeadRoot, oldHeadBlock; block != nil; {
+			slot, _ := block.Slot()
+			if canonical[slot] == blockRoot {
+				ancestorSlot = slot
+				break
+			}
+			blockRoot, _ = block.ParentRoot()
+			block = c.blocks[blockRoot]
+		}
+		if canonical[oldHeadSlot] != oldHeadRoot {
+			newHeadSlot, _ := newHead.Slot()
+			newHeadState, _ := newHead.StateRoot()
+			reorg = &apiv1.ChainReorgEvent{
+				Slot:         newHeadSlot,
+				Depth:        uint64(oldHeadSlot - ancestorSlot),
+				OldHeadBlock: oldHeadRoot,
+				NewHeadBlock: root,
+				OldHeadState: oldHeadState,
+				NewHeadState: newHeadState,
+				Epoch:        phase0.Epoch(uint64(newHeadSlot) / c.slotsPerEpoch),
+			}
+		}
+	}
+	headEvent := c.headEvent()
+	c.mu.Unlock()
+
+	if reorg != nil {
+		c.publish(&apiv1.Event{
+			Topic: "chain_reorg",
+			Data:  reorg,
+		})
+	}
+	c.publish(&apiv1.Event{
+		Topic: "head",
+		Data:  headEvent,
+	})
+
+	return nil
+}
+
+// headEvent creates a head event for the current head.
+// The caller must hold the lock.
+func (c *Chain) headEvent() *apiv1.HeadEvent {
+	block := c.blocks[c.headRoot]
+	slot, _ := block.Slot()
+	stateRoot, _ := block.StateRoot()
+	epoch := uint64(slot) / c.slotsPerEpoch
+
+	event := &apiv1.HeadEvent{
+		Slot:            slot,
+		Block:           c.headRoot,
+		State:           stateRoot,
+		EpochTransition: uint64(slot)%c.slotsPerEpoch == 0,
+	}
+	if epoch > 0 {
+		event.CurrentDutyDependentRoot = c.canonicalRootAt(phase0.Slot(epoch*c.slotsPerEpoch - 1))
+	} else {
+		event.CurrentDutyDependentRoot = c.genesisRoot
+	}
+	if epoch > 1 {
+		event.PreviousDutyDependentRoot = c.canonicalRootAt(phase0.Slot((epoch-1)*c.slotsPerEpoch - 1))
+	} else {
+		event.PreviousDutyDependentRoot = c.genesisRoot
+	}
+
+	return event
+}
+
+// canonicalRootAt provides the root of the latest canonical block at or before the given slot.
+// The caller must hold the lock.
+func (c *Chain) canonicalRootAt(slot phase0.Slot) phase0.Root {
+	for {
+		if root, exists := c.canonical[slot]; exists {
+			return root
+		}
+		if slot == 0 {
+			return c.genesisRoot
+		}
+		slot--
+	}
+}
+
+// CanonicalRootAt provides the root of the latest canonical block at or before the given slot.
+func (c *Chain) CanonicalRootAt(slot phase0.Slot) phase0.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.canonicalRootAt(slot)
+}
+
+// Block provides the block with the given root, or nil if not present.
+func (c *Chain) Block(root phase0.Root) *spec.VersionedSignedBeaconBlock {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.blocks[root]
+}
+
+// BlockRoots provides the roots of all blocks in the chain, canonical or not,
+// ordered by slot.
+func (c *Chain) BlockRoots() []phase0.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	type blockInfo struct {
+		root phase0.Root
+		slot phase0.Slot
+	}
+	infos := make([]blockInfo, 0, len(c.blocks))
+	for root, block := range c.blocks {
+		slot, _ := block.Slot()
+		infos = append(infos, blockInfo{root: root, slot: slot})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].slot != infos[j].slot {
+			return infos[i].slot < infos[j].slot
+		}
+		return bytes.Compare(infos[i].root[:], infos[j].root[:]) < 0
+	})
+
+	roots := make([]phase0.Root, len(infos))
+	for i := range infos {
+		roots[i] = infos[i].root
+	}
+
+	return roots
+}
+
+// IsCanonical returns true if the block with the given root is on the canonical chain.
+func (c *Chain) IsCanonical(root phase0.Root) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	block, exists := c.blocks[root]
+	if !exists {
+		return false
+	}
+	slot, _ := block.Slot()
+
+	return c.canonical[slot] == root
+}
+
+// ForkChoice provides a fork choice dump of all blocks in the chain.
+// Canonical blocks are given a higher weight than non-canonical blocks, so the
+// head obtained from the dump matches the head of the chain.
+func (c *Chain) ForkChoice() *apiv1.ForkChoice {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	nodes := make([]*apiv1.ForkChoiceNode, 0, len(c.blocks))
+	for root, block := range c.blocks {
+		slot, _ := block.Slot()
+		parentRoot, _ := block.ParentRoot()
+		weight := uint64(1)
+		if c.canonical[slot] == root {
+			weight = 2
+		}
+		nodes = append(nodes, &apiv1.ForkChoiceNode{
+			Slot:           slot,
+			BlockRoot:      root,
+			ParentRoot:     parentRoot,
+			JustifiedEpoch: c.finality.Justified.Epoch,
+			FinalizedEpoch: c.finality.Finalized.Epoch,
+			Weight:         weight,
+			Validity:       apiv1.ForkChoiceNodeValidityValid,
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Slot != nodes[j].Slot {
+			return nodes[i].Slot < nodes[j].Slot
+		}
+		return bytes.Compare(nodes[i].BlockRoot[:], nodes[j].BlockRoot[:]) < 0
+	})
+
+	return &apiv1.ForkChoice{
+		JustifiedCheckpoint: c.finality.Justified,
+		FinalizedCheckpoint: c.finality.Finalized,
+		Nodes:               nodes,
+	}
+}
+
+// ChainHeads provides the heads of all branches of the chain, highest slot first.
+func (c *Chain) ChainHeads() []*apiv1.ChainHead {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	parents := make(map[phase0.Root]bool, len(c.blocks))
+	for root, block := range c.blocks {
+		parentRoot, _ := block.ParentRoot()
+		if parentRoot != root {
+			parents[parentRoot] = true
+		}
+	}
+
+	heads := make([]*apiv1.ChainHead, 0)
+	for root, block := range c.blocks {
+		if parents[root] {
+			continue
+		}
+		slot, _ := block.Slot()
+		heads = append(heads, &apiv1.ChainHead{
+			Root: root,
+			Slot: slot,
+		})
+	}
+	sort.Slice(heads, func(i, j int) bool {
+		if heads[i].Slot != heads[j].Slot {
+			return heads[i].Slot > heads[j].Slot
+		}
+		return bytes.Compare(heads[i].Root[:], heads[j].Root[:]) < 0
+	})
+
+	return heads
+}
+
+// Finality provides the finality of the chain.
+func (c *Chain) Finality() *apiv1.Finality {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.finality
+}
+
+// SetFinality sets the finality of the chain.
+// A finalized checkpoint event is signalled if the finalized checkpoint changes.
+func (c *Chain) SetFinality(finality *apiv1.Finality) error {
+	if finality == nil || finality.Finalized == nil || finality.Justified == nil || finality.PreviousJustified == nil {
+		return errors.New("finality incomplete")
+	}
+
+	c.mu.Lock()
+	changed := c.finality.Finalized.Epoch != finality.Finalized.Epoch || c.finality.Finalized.Root != finality.Finalized.Root
+	c.finality = finality
+	var stateRoot phase0.Root
+	if block, exists := c.blocks[finality.Finalized.Root]; exists {
+		stateRoot, _ = block.StateRoot()
+	}
+	c.mu.Unlock()
+
+	if changed {
+		c.publish(&apiv1.Event{
+			Topic: "finalized_checkpoint",
+			Data: &apiv1.FinalizedCheckpointEvent{
+				Block: finality.Finalized.Root,
+				State: stateRoot,
+				Epoch: finality.Finalized.Epoch,
+			},
+		})
+	}
+
+	return nil
+}
+
+// BeaconState provides the beacon state at the given slot, or nil if not present.
+func (c *Chain) BeaconState(slot phase0.Slot) *spec.VersionedBeaconState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.states[slot]
+}
+
+// SetBeaconState sets the beacon state for its slot.
+func (c *Chain) SetBeaconState(state *spec.VersionedBeaconState) error {
+	if state == nil {
+		return errors.New("no state supplied")
+	}
+	slot, err := state.Slot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain state slot")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states[slot] = state
+
+	return nil
+}
+
+// Validators provides the validators of the chain, ordered by index.
+func (c *Chain) Validators() []*apiv1.Validator {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.validators
+}
+
+// SetValidators sets the validators of the chain.
+func (c *Chain) SetValidators(validators []*apiv1.Validator) {
+	res := make([]*apiv1.Validator, len(validators))
+	copy(res, validators)
+	sort.Slice(res, func(i int, j int) bool {
+		return res[i].Index < res[j].Index
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validators = res
+}
+
+// BeaconCommittees provides the beacon committees for the given epoch.
+func (c *Chain) BeaconCommittees(epoch phase0.Epoch) []*apiv1.BeaconCommittee {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.committees[epoch]
+}
+
+// SetBeaconCommittees sets the beacon committees for the given epoch.
+func (c *Chain) SetBeaconCommittees(epoch phase0.Epoch, committees []*apiv1.BeaconCommittee) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.committees[epoch] = committees
+}
+
+// ProposerDuties provides the proposer duties for the given epoch.
+func (c *Chain) ProposerDuties(epoch phase0.Epoch) []*apiv1.ProposerDuty {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.proposerDuties[epoch]
+}
+
+// SetProposerDuties sets the proposer duties for the given epoch.
+func (c *Chain) SetProposerDuties(epoch phase0.Epoch, duties []*apiv1.ProposerDuty) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.proposerDuties[epoch] = duties
+}
+
+// Attestations provides the attestations in the pool for the given slot.
+func (c *Chain) Attestations(slot phase0.Slot) []*phase0.Attestation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res := make([]*phase0.Attestation, 0)
+	for _, attestation := range c.attestations {
+		if attestation.Data != nil && attestation.Data.Slot == slot {
+			res = append(res, attestation)
+		}
+	}
+
+	return res
+}
+
+// AddAttestations adds attestations to the pool.
+func (c *Chain) AddAttestations(attestations []*phase0.Attestation) {
+	c.mu.Lock()
+	c.attestations = append(c.attestations, attestations...)
+	c.mu.Unlock()
+
+	for _, attestation := range attestations {
+		c.publish(&apiv1.Event{
+			Topic: "attestation",
+			Data:  attestation,
+		})
+	}
+}
+
+// SyncCommittee provides the sync committee for the period containing the given epoch.
+func (c *Chain) SyncCommittee(epoch phase0.Epoch) *apiv1.SyncCommittee {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.syncCommittees[c.syncCommitteePeriod(epoch)]
+}
+
+// SetSyncCommittee sets the sync committee for the period containing the given epoch.
+func (c *Chain) SetSyncCommittee(epoch phase0.Epoch, committee *apiv1.SyncCommittee) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.syncCommittees[c.syncCommitteePeriod(epoch)] = committee
+}
+
+// syncCommitteePeriod provides the sync committee period for the given epoch.
+func (c *Chain) syncCommitteePeriod(epoch phase0.Epoch) uint64 {
+	epochsPerPeriod, err := strconv.ParseUint(c.config["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"], 10, 64)
+	if err != nil || epochsPerPeriod == 0 {
+		epochsPerPeriod = 256
+	}
+
+	return uint64(epoch) / epochsPerPeriod
+}
+
+// Randao provides the RANDAO mix for the given epoch.
+// If no mix has been set then a value derived from the epoch is returned.
+func (c *Chain) Randao(epoch phase0.Epoch) phase0.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if randao, exists := c.randaos[epoch]; exists {
+		return randao
+	}
+	seed := make([]byte, 8)
+	binary.LittleEndian.PutUint64(seed, uint64(epoch))
+
+	return phase0.Root(sha256.Sum256(seed))
+}
+
+// SetRandao sets the RANDAO mix for the given epoch.
+func (c *Chain) SetRandao(epoch phase0.Epoch, randao phase0.Root) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.randaos[epoch] = randao
+}
+
+// BlockRoot resolves a block ID to a block root.
+// The block ID can be a slot, a 0x-prefixed root, or one of "head", "genesis", "finalized" or "justified".
+func (c *Chain) BlockRoot(blockID string) (phase0.Root, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.blockRoot(blockID)
+}
+
+// blockRoot resolves a block ID to a block root.
+// The caller must hold the lock.
+func (c *Chain) blockRoot(blockID string) (phase0.Root, bool) {
+	switch {
+	case blockID == "head":
+		return c.headRoot, true
+	case blockID == "genesis":
+		return c.genesisRoot, true
+	case blockID == "finalized":
+		return c.finality.Finalized.Root, true
+	case blockID == "justified":
+		return c.finality.Justified.Root, true
+	case strings.HasPrefix(blockID, "0x"):
+		root, err := parseRoot(blockID)
+		if err != nil {
+			return phase0.Root{}, false
+		}
+		_, exists := c.blocks[root]
+		return root, exists
+	default:
+		slot, err := strconv.ParseUint(blockID, 10, 64)
+		if err != nil {
+			return phase0.Root{}, false
+		}
+		root, exists := c.canonical[phase0.Slot(slot)]
+		return root, exists
+	}
+}
+
+// StateSlot resolves a state ID to a slot.
+// The state ID can be a slot, a 0x-prefixed state root, or one of "head", "genesis", "finalized" or "justified".
+func (c *Chain) StateSlot(stateID string) (phase0.Slot, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch {
+	case stateID == "finalized":
+		return phase0.Slot(uint64(c.finality.Finalized.Epoch) * c.slotsPerEpoch), true
+	case stateID == "justified":
+		return phase0.Slot(uint64(c.finality.Justified.Epoch) * c.slotsPerEpoch), true
+	case strings.HasPrefix(stateID, "0x"):
+		stateRoot, err := parseRoot(stateID)
+		if err != nil {
+			return 0, false
+		}
+		for _, block := range c.blocks {
+			blockStateRoot, _ := block.StateRoot()
+			if blockStateRoot == stateRoot {
+				slot, _ := block.Slot()
+				return slot, true
+			}
+		}
+		return 0, false
+	case stateID == "head", stateID == "genesis":
+		root, _ := c.blockRoot(stateID)
+		slot, _ := c.blocks[root].Slot()
+		return slot, true
+	default:
+		slot, err := strconv.ParseUint(stateID, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		if phase0.Slot(slot) > c.headSlot() {
+			return 0, false
+		}
+		return phase0.Slot(slot), true
+	}
+}
+
+// StateRoot provides the state root for the given slot.
+func (c *Chain) StateRoot(slot phase0.Slot) phase0.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	stateRoot, _ := c.blocks[c.canonicalRootAt(slot)].StateRoot()
+
+	return stateRoot
+}
+
+// ForkAtEpoch provides the fork in effect at the given epoch.
+func (c *Chain) ForkAtEpoch(epoch phase0.Epoch) *phase0.Fork {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res := c.forkSchedule[0]
+	for _, fork := range c.forkSchedule {
+		if fork.Epoch <= epoch {
+			res = fork
+		}
+	}
+
+	return res
+}
+
+// Subscribe subscribes to events with the given topics.
+// The returned function must be called to unsubscribe.
+func (c *Chain) Subscribe(topics []string) (<-chan *apiv1.Event, func()) {
+	ch := make(chan *apiv1.Event, 64)
+	topicMap := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		topicMap[topic] = true
+	}
+
+	c.subscribersMu.Lock()
+	c.subscribers[ch] = topicMap
+	c.subscribersMu.Unlock()
+
+	return ch, func() {
+		c.subscribersMu.Lock()
+		delete(c.subscribers, ch)
+		c.subscribersMu.Unlock()
+	}
+}
+
+// Publish publishes an arbitrary event to subscribers.
+func (c *Chain) Publish(event *apiv1.Event) {
+	c.publish(event)
+}
+
+func (c *Chain) publish(event *apiv1.Event) {
+	c.subscribersMu.RLock()
+	defer c.subscribersMu.RUnlock()
+
+	for ch, topics := range c.subscribers {
+		if !topics[event.Topic] {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			// Subscriber is not keeping up; drop the event.
+		}
+	}
+}
+
+// parseRoot parses a 0x-prefixed hex string in to a root.
+func parseRoot(input string) (phase0.Root, error) {
+	var root phase0.Root
+	data, err := hexDecode(input)
+	if err != nil {
+		return root, err
+	}
+	if len(data) != len(root) {
+		return root, errors.New("incorrect length for root")
+	}
+	copy(root[:], data)
+
+	return root, nil
+}
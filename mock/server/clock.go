@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// Clock is a simulated slot clock.
+type Clock struct {
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+}
+
+// NewClock creates a new slot clock.
+func NewClock(genesisTime time.Time, slotDuration time.Duration, slotsPerEpoch uint64) *Clock {
+	return &Clock{
+		genesisTime:   genesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+	}
+}
+
+// CurrentSlot provides the current slot.
+// Prior to genesis this returns 0.
+func (c *Clock) CurrentSlot() phase0.Slot {
+	if time.Now().Before(c.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(uint64(time.Since(c.genesisTime) / c.slotDuration))
+}
+
+// CurrentEpoch provides the current epoch.
+func (c *Clock) CurrentEpoch() phase0.Epoch {
+	return phase0.Epoch(uint64(c.CurrentSlot()) / c.slotsPerEpoch)
+}
+
+// SlotStart provides the start time of the given slot.
+func (c *Clock) SlotStart(slot phase0.Slot) time.Time {
+	return c.genesisTime.Add(time.Duration(slot) * c.slotDuration)
+}
+
+// Ticker provides a channel that receives each slot as it starts.
+// The channel is closed when the context is done.
+func (c *Clock) Ticker(ctx context.Context) <-chan phase0.Slot {
+	ch := make(chan phase0.Slot)
+	go func() {
+		defer close(ch)
+		slot := c.CurrentSlot()
+		if !time.Now().Before(c.SlotStart(slot)) {
+			slot++
+		}
+		for {
+			timer := time.NewTimer(time.Until(c.SlotStart(slot)))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- slot:
+			}
+			slot++
+		}
+	}()
+
+	return ch
+}
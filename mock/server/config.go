@@ -0,0 +1,33 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+)
+
+// handleSpec serves the chain configuration.
+func (s *Server) handleSpec(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.Config())
+}
+
+// handleForkSchedule serves the fork schedule.
+func (s *Server) handleForkSchedule(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.ForkSchedule())
+}
+
+// handleDepositContract serves the deposit contract.
+func (s *Server) handleDepositContract(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.DepositContract())
+}
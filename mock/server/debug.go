@@ -0,0 +1,29 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+)
+
+// handleForkChoice serves a dump of the fork choice store.
+func (s *Server) handleForkChoice(w http.ResponseWriter, _ *http.Request, _ []string) {
+	// The fork choice is not wrapped in a data element.
+	writeJSON(w, http.StatusOK, s.chain.ForkChoice())
+}
+
+// handleChainHeads serves the heads of all branches of the chain.
+func (s *Server) handleChainHeads(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.ChainHeads())
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// keepaliveInterval is the interval between keepalive messages on the event stream.
+var keepaliveInterval = 10 * time.Second
+
+// handleEvents serves the server-sent events stream.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request, _ []string) {
+	topics := make([]string, 0)
+	for _, topic := range r.URL.Query()["topics"] {
+		topics = append(topics, strings.Split(topic, ",")...)
+	}
+	if len(topics) == 0 {
+		writeError(w, http.StatusBadRequest, "no topics supplied")
+		return
+	}
+	for _, topic := range topics {
+		if _, exists := apiv1.SupportedEventTopics[topic]; !exists {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported topic %s", topic))
+			return
+		}
+	}
+
+	flusher, isFlusher := w.(http.Flusher)
+	if !isFlusher {
+		writeError(w, http.StatusInternalServerError, "streaming not supported")
+		return
+	}
+
+	events, unsubscribe := s.chain.Subscribe(topics)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(w, ":\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event := <-events:
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				s.log.Error().Err(err).Str("topic", event.Topic).Msg("Failed to marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Topic, string(data)); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault is an artificial fault applied to requests.
+type Fault struct {
+	// Latency is the additional time to wait before handling the request.
+	Latency time.Duration
+	// StatusCode is the status code to return in place of the real response.
+	// If 0 the request is handled as normal after any latency.
+	StatusCode int
+	// Message is the error message returned with the status code.
+	Message string
+	// Count is the number of requests to which the fault applies.
+	// If 0 the fault applies to all matching requests until cleared.
+	Count int
+}
+
+type pathFault struct {
+	prefix    string
+	fault     Fault
+	remaining int
+}
+
+// SetLatency sets the base latency applied to every request.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.faultsMu.Lock()
+	defer s.faultsMu.Unlock()
+
+	s.latency = latency
+}
+
+// AddFault adds a fault for requests whose path starts with the given prefix.
+// Faults are checked in the order in which they were added, and the first
+// matching fault is applied.
+func (s *Server) AddFault(prefix string, fault Fault) {
+	s.faultsMu.Lock()
+	defer s.faultsMu.Unlock()
+
+	s.faults = append(s.faults, &pathFault{
+		prefix:    prefix,
+		fault:     fault,
+		remaining: fault.Count,
+	})
+}
+
+// ClearFaults removes all faults.
+func (s *Server) ClearFaults() {
+	s.faultsMu.Lock()
+	defer s.faultsMu.Unlock()
+
+	s.faults = nil
+}
+
+// applyFaults applies any relevant faults to the request.
+// Returns true if the request has been handled by the fault.
+func (s *Server) applyFaults(w http.ResponseWriter, r *http.Request) bool {
+	s.faultsMu.Lock()
+	latency := s.latency
+	var fault *Fault
+	for i, pathFault := range s.faults {
+		if !strings.HasPrefix(r.URL.Path, pathFault.prefix) {
+			continue
+		}
+		fault = &pathFault.fault
+		if pathFault.remaining > 0 {
+			pathFault.remaining--
+			if pathFault.remaining == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		break
+	}
+	s.faultsMu.Unlock()
+
+	if fault != nil {
+		latency += fault.Latency
+	}
+	if latency > 0 {
+		select {
+		case <-r.Context().Done():
+			return true
+		case <-time.After(latency):
+		}
+	}
+
+	if fault == nil || fault.StatusCode == 0 {
+		return false
+	}
+	message := fault.Message
+	if message == "" {
+		message = http.StatusText(fault.StatusCode)
+	}
+	writeError(w, fault.StatusCode, message)
+
+	return true
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+)
+
+type nodeVersionJSON struct {
+	Version string `json:"version"`
+}
+
+// handleNodeVersion serves the node version.
+func (s *Server) handleNodeVersion(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, &nodeVersionJSON{Version: s.nodeVersion})
+}
+
+// handleNodeSyncing serves the node's sync state.
+func (s *Server) handleNodeSyncing(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.SyncState())
+}
+
+// handleNodeHealth serves the node's health as a status code.
+func (s *Server) handleNodeHealth(w http.ResponseWriter, _ *http.Request, _ []string) {
+	if s.chain.SyncState().IsSyncing {
+		w.WriteHeader(http.StatusPartialContent)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
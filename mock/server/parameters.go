@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	nodeVersion   string
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	latency       time.Duration
+	autoPropose   bool
+	chain         *Chain
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithNodeVersion sets the version string returned by the node version endpoint.
+func WithNodeVersion(nodeVersion string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeVersion = nodeVersion
+	})
+}
+
+// WithGenesisTime sets the genesis time of the simulated chain.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithSlotDuration sets the duration of each slot of the simulated chain.
+func WithSlotDuration(slotDuration time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDuration = slotDuration
+	})
+}
+
+// WithSlotsPerEpoch sets the number of slots in each epoch of the simulated chain.
+func WithSlotsPerEpoch(slotsPerEpoch uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpoch = slotsPerEpoch
+	})
+}
+
+// WithLatency sets a base latency applied to every request.
+func WithLatency(latency time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.latency = latency
+	})
+}
+
+// WithAutoPropose sets the server to propose an empty block at the start of each slot.
+func WithAutoPropose(autoPropose bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.autoPropose = autoPropose
+	})
+}
+
+// WithChain sets a pre-populated chain for the server to serve.
+// If not supplied a new chain is created from the other parameters.
+func WithChain(chain *Chain) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chain = chain
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:      zerolog.GlobalLevel(),
+		nodeVersion:   "mock/v1.0.0",
+		genesisTime:   time.Now().Truncate(time.Second),
+		slotDuration:  12 * time.Second,
+		slotsPerEpoch: 32,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.nodeVersion == "" {
+		return nil, errors.New("no node version specified")
+	}
+	if parameters.slotDuration == 0 {
+		return nil, errors.New("no slot duration specified")
+	}
+	if parameters.slotsPerEpoch == 0 {
+		return nil, errors.New("no slots per epoch specified")
+	}
+	if parameters.latency < 0 {
+		return nil, errors.New("latency cannot be negative")
+	}
+
+	return &parameters, nil
+}
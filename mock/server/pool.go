@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// handleAttestationPool serves the attestations in the pool.
+func (s *Server) handleAttestationPool(w http.ResponseWriter, r *http.Request, _ []string) {
+	slot, err := strconv.ParseUint(r.URL.Query().Get("slot"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+
+	attestations := s.chain.Attestations(phase0.Slot(slot))
+	if r.URL.Query().Get("committee_index") != "" {
+		committeeIndex, err := strconv.ParseUint(r.URL.Query().Get("committee_index"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid committee index")
+			return
+		}
+		filtered := make([]*phase0.Attestation, 0, len(attestations))
+		for _, attestation := range attestations {
+			if attestation.Data.Index == phase0.CommitteeIndex(committeeIndex) {
+				filtered = append(filtered, attestation)
+			}
+		}
+		attestations = filtered
+	}
+
+	writeData(w, attestations)
+}
+
+// handleSubmitAttestations adds attestations to the pool.
+func (s *Server) handleSubmitAttestations(w http.ResponseWriter, r *http.Request, _ []string) {
+	body, err := s.recordSubmission(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var attestations []*phase0.Attestation
+	if err := json.Unmarshal(body, &attestations); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.chain.AddAttestations(attestations)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleOperationPool serves the operations previously submitted to a pool.
+func (s *Server) handleOperationPool(w http.ResponseWriter, r *http.Request, _ []string) {
+	operations := make([]json.RawMessage, 0)
+	for _, submission := range s.Submissions(r.URL.Path) {
+		// Some pools accept arrays of operations, others single operations.
+		var batch []json.RawMessage
+		if err := json.Unmarshal(submission, &batch); err == nil {
+			operations = append(operations, batch...)
+			continue
+		}
+		operations = append(operations, json.RawMessage(submission))
+	}
+
+	writeData(w, operations)
+}
@@ -0,0 +1,366 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server provides a mock beacon node that serves the standard beacon API
+// over HTTP, backed by a scriptable in-memory chain.  It implements http.Handler
+// so can be used directly with httptest.NewServer().
+//
+// The server implements the subset of the API registered in registerRoutes().
+// Other endpoints used by the http client return 501 Not Implemented, and
+// anything else returns 404 Not Found.
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Server is a mock beacon node HTTP server.
+type Server struct {
+	log zerolog.Logger
+
+	nodeVersion string
+	chain       *Chain
+	clock       *Clock
+	autoPropose bool
+	routes      []*route
+
+	faultsMu sync.Mutex
+	latency  time.Duration
+	faults   []*pathFault
+
+	submissionsMu sync.RWMutex
+	submissions   map[string][][]byte
+}
+
+// handlerFunc is the function that handles a route.
+// params contains the values of the route pattern's capture groups.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// New creates a new mock beacon node server.
+// The server runs its slot clock until the context is cancelled.
+func New(ctx context.Context, params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "server").Str("impl", "mock").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	chain := parameters.chain
+	if chain == nil {
+		chain, err = NewChain(parameters.genesisTime, parameters.slotDuration, parameters.slotsPerEpoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create chain")
+		}
+	}
+
+	s := &Server{
+		log:         log,
+		nodeVersion: parameters.nodeVersion,
+		chain:       chain,
+		clock:       NewClock(chain.Genesis().GenesisTime, chain.SlotDuration(), chain.SlotsPerEpoch()),
+		autoPropose: parameters.autoPropose,
+		latency:     parameters.latency,
+		submissions: make(map[string][][]byte),
+	}
+	s.registerRoutes()
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// Chain provides the chain backing the server, allowing it to be scripted.
+func (s *Server) Chain() *Chain {
+	return s.chain
+}
+
+// Clock provides the slot clock of the server.
+func (s *Server) Clock() *Clock {
+	return s.clock
+}
+
+// Submissions provides the raw bodies of requests submitted to the given endpoint path.
+func (s *Server) Submissions(path string) [][]byte {
+	s.submissionsMu.RLock()
+	defer s.submissionsMu.RUnlock()
+
+	return s.submissions[path]
+}
+
+// run carries out per-slot activity until the context is done.
+func (s *Server) run(ctx context.Context) {
+	ticker := s.clock.Ticker(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Trace().Msg("Context done; server stopping")
+			return
+		case slot := <-ticker:
+			if !s.autoPropose || slot == 0 {
+				continue
+			}
+			if _, err := s.chain.ProposeBlock(slot); err != nil {
+				s.log.Debug().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to propose block")
+			}
+		}
+	}
+}
+
+func (s *Server) registerRoutes() {
+	s.handle(http.MethodGet, `/eth/v1/beacon/genesis`, s.handleGenesis)
+	s.handle(http.MethodGet, `/eth/v2/beacon/blocks/([^/]+)`, s.handleSignedBeaconBlock)
+	s.handle(http.MethodGet, `/eth/v1/beacon/blocks/([^/]+)/root`, s.handleBeaconBlockRoot)
+	s.handle(http.MethodGet, `/eth/v1/beacon/blocks/([^/]+)/attestations`, s.handleBeaconBlockAttestations)
+	s.handle(http.MethodGet, `/eth/v1/beacon/blinded_blocks/([^/]+)`, s.handleSignedBlindedBeaconBlock)
+	s.handle(http.MethodPost, `/eth/v1/beacon/blocks`, s.handleSubmitBeaconBlock)
+	s.handle(http.MethodGet, `/eth/v1/beacon/headers`, s.handleBeaconBlockHeaders)
+	s.handle(http.MethodGet, `/eth/v1/beacon/headers/([^/]+)`, s.handleBeaconBlockHeader)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/root`, s.handleBeaconStateRoot)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/fork`, s.handleFork)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/finality_checkpoints`, s.handleFinality)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/validators`, s.handleValidators)
+	s.handle(http.MethodPost, `/eth/v1/beacon/states/([^/]+)/validators`, s.handlePostValidators)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/validator_balances`, s.handleValidatorBalances)
+	s.handle(http.MethodPost, `/eth/v1/beacon/states/([^/]+)/validator_balances`, s.handlePostValidatorBalances)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/committees`, s.handleBeaconCommittees)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/sync_committees`, s.handleSyncCommittee)
+	s.handle(http.MethodGet, `/eth/v1/beacon/states/([^/]+)/randao`, s.handleBeaconStateRandao)
+	s.handle(http.MethodGet, `/eth/v1/beacon/pool/attestations`, s.handleAttestationPool)
+	s.handle(http.MethodPost, `/eth/v1/beacon/pool/attestations`, s.handleSubmitAttestations)
+	s.handle(http.MethodGet, `/eth/v2/debug/beacon/states/([^/]+)`, s.handleBeaconState)
+	s.handle(http.MethodGet, `/eth/v1/debug/fork_choice`, s.handleForkChoice)
+	s.handle(http.MethodGet, `/eth/v2/debug/beacon/heads`, s.handleChainHeads)
+	s.handle(http.MethodGet, `/eth/v1/config/spec`, s.handleSpec)
+	s.handle(http.MethodGet, `/eth/v1/config/fork_schedule`, s.handleForkSchedule)
+	s.handle(http.MethodGet, `/eth/v1/config/deposit_contract`, s.handleDepositContract)
+	s.handle(http.MethodGet, `/eth/v1/events`, s.handleEvents)
+	s.handle(http.MethodGet, `/eth/v1/node/version`, s.handleNodeVersion)
+	s.handle(http.MethodGet, `/eth/v1/node/syncing`, s.handleNodeSyncing)
+	s.handle(http.MethodGet, `/eth/v1/node/health`, s.handleNodeHealth)
+	s.handle(http.MethodGet, `/eth/v1/validator/duties/proposer/(\d+)`, s.handleProposerDuties)
+	s.handle(http.MethodPost, `/eth/v1/validator/duties/attester/(\d+)`, s.handleAttesterDuties)
+	s.handle(http.MethodPost, `/eth/v1/validator/duties/sync/(\d+)`, s.handleSyncCommitteeDuties)
+	s.handle(http.MethodGet, `/eth/v2/validator/blocks/(\d+)`, s.handleBeaconBlockProposal)
+	s.handle(http.MethodGet, `/eth/v1/validator/attestation_data`, s.handleAttestationData)
+	s.handle(http.MethodGet, `/eth/v1/validator/aggregate_attestation`, s.handleAggregateAttestation)
+	s.handle(http.MethodGet, `/eth/v1/validator/sync_committee_contribution`, s.handleSyncCommitteeContribution)
+	s.handle(http.MethodPost, `/eth/v1/validator/beacon_committee_selections`, s.handleBeaconCommitteeSelections)
+	s.handle(http.MethodPost, `/eth/v1/validator/sync_committee_selections`, s.handleSyncCommitteeSelections)
+
+	// Endpoints that accept submissions without further processing.
+	for _, path := range []string{
+		`/eth/v1/beacon/blinded_blocks`,
+		`/eth/v1/beacon/pool/attester_slashings`,
+		`/eth/v1/beacon/pool/bls_to_execution_changes`,
+		`/eth/v1/beacon/pool/proposer_slashings`,
+		`/eth/v1/beacon/pool/sync_committees`,
+		`/eth/v1/beacon/pool/voluntary_exits`,
+		`/eth/v1/validator/aggregate_and_proofs`,
+		`/eth/v1/validator/beacon_committee_subscriptions`,
+		`/eth/v1/validator/contribution_and_proofs`,
+		`/eth/v1/validator/prepare_beacon_proposer`,
+		`/eth/v1/validator/register_validator`,
+		`/eth/v1/validator/sync_committee_subscriptions`,
+	} {
+		s.handle(http.MethodPost, path, s.handleSubmission)
+	}
+
+	// Pools that serve the operations submitted to them.
+	for _, path := range []string{
+		`/eth/v1/beacon/pool/attester_slashings`,
+		`/eth/v1/beacon/pool/bls_to_execution_changes`,
+		`/eth/v1/beacon/pool/proposer_slashings`,
+		`/eth/v1/beacon/pool/voluntary_exits`,
+	} {
+		s.handle(http.MethodGet, path, s.handleOperationPool)
+	}
+
+	// Endpoints used by the http client that the server does not implement.
+	// These return an explicit error rather than a 404, which the client
+	// would otherwise treat as an absence of data.
+	for _, route := range []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, `/eth/v1/beacon/deposit_snapshot`},
+		{http.MethodGet, `/eth/v1/beacon/light_client/.*`},
+		{http.MethodGet, `/eth/v1/beacon/rewards/blocks/([^/]+)`},
+		{http.MethodPost, `/eth/v1/beacon/rewards/attestations/(\d+)`},
+		{http.MethodPost, `/eth/v1/beacon/rewards/sync_committee/([^/]+)`},
+		{http.MethodGet, `/eth/v1/builder/states/([^/]+)/expected_withdrawals`},
+		{http.MethodGet, `/eth/v1/lightclient/historical_summaries/([^/]+)`},
+		{http.MethodGet, `/eth/v1/node/identity`},
+		{http.MethodGet, `/eth/v1/node/peer_count`},
+		{http.MethodGet, `/eth/v1/node/peers(/[^/]+)?`},
+		{http.MethodGet, `/eth/v1/validator/blinded_blocks/(\d+)`},
+		{http.MethodPost, `/eth/v1/validator/liveness/(\d+)`},
+	} {
+		s.handle(route.method, route.path, s.handleNotImplemented)
+	}
+}
+
+// handle registers a handler for the given method and path pattern.
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, &route{
+		method:  method,
+		pattern: regexp.MustCompile(fmt.Sprintf("^%s$", pattern)),
+		handler: handler,
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := s.log.With().Str("method", r.Method).Str("path", r.URL.Path).Logger()
+	log.Trace().Msg("Request received")
+
+	if handled := s.applyFaults(w, r); handled {
+		return
+	}
+
+	pathMatched := false
+	for _, route := range s.routes {
+		matches := route.pattern.FindStringSubmatch(r.URL.Path)
+		if matches == nil {
+			continue
+		}
+		pathMatched = true
+		if route.method != r.Method {
+			continue
+		}
+		route.handler(w, r, matches[1:])
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "endpoint not found")
+}
+
+// handleNotImplemented reports that the server does not implement an endpoint.
+func (s *Server) handleNotImplemented(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeError(w, http.StatusNotImplemented, "endpoint not implemented by mock server")
+}
+
+// handleSubmission records the body of a request for later inspection.
+func (s *Server) handleSubmission(w http.ResponseWriter, r *http.Request, _ []string) {
+	if _, err := s.recordSubmission(r); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// recordSubmission records the body of a request, returning it.
+func (s *Server) recordSubmission(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	if !json.Valid(body) {
+		return nil, errors.New("invalid JSON")
+	}
+
+	s.submissionsMu.Lock()
+	s.submissions[r.URL.Path] = append(s.submissions[r.URL.Path], body)
+	s.submissionsMu.Unlock()
+
+	return body, nil
+}
+
+// dataResponse is the standard wrapper for responses.
+type dataResponse struct {
+	Version             string      `json:"version,omitempty"`
+	ExecutionOptimistic *bool       `json:"execution_optimistic,omitempty"`
+	Data                interface{} `json:"data"`
+}
+
+// errorResponse is the standard error response.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeData writes a standard data response.
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, &dataResponse{Data: data})
+}
+
+// writeVersionedData writes a standard data response with a version.
+func writeVersionedData(w http.ResponseWriter, version string, data interface{}) {
+	w.Header().Set("Eth-Consensus-Version", version)
+	writeJSON(w, http.StatusOK, &dataResponse{Version: version, Data: data})
+}
+
+// writeError writes a standard error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, &errorResponse{Code: statusCode, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(fmt.Sprintf(`{"code":500,"message":%q}`, err.Error()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// writeSSZ writes an SSZ response.
+func writeSSZ(w http.ResponseWriter, version string, data []byte) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Eth-Consensus-Version", version)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// wantsSSZ returns true if the request prefers an SSZ response.
+func wantsSSZ(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "application/octet-stream")
+}
+
+// hexDecode decodes a 0x-prefixed hex string.
+func hexDecode(input string) ([]byte, error) {
+	if !strings.HasPrefix(input, "0x") {
+		return nil, errors.New("missing 0x prefix")
+	}
+
+	return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+}
@@ -0,0 +1,388 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	client "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/http"
+	"github.com/jefmcl/go-eth2-client/mock/server"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService creates a mock server and an http client connected to it.
+func newTestService(ctx context.Context, t *testing.T, params ...server.Parameter) (*server.Server, client.Service) {
+	t.Helper()
+
+	params = append([]server.Parameter{server.WithLogLevel(zerolog.Disabled)}, params...)
+	srv, err := server.New(ctx, params...)
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+
+	service, err := http.New(ctx,
+		http.WithLogLevel(zerolog.Disabled),
+		http.WithAddress(httpServer.URL),
+		http.WithTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	return srv, service
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		params []server.Parameter
+		err    string
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name:   "SlotDurationZero",
+			params: []server.Parameter{server.WithSlotDuration(0)},
+			err:    "problem with parameters: no slot duration specified",
+		},
+		{
+			name:   "SlotsPerEpochZero",
+			params: []server.Parameter{server.WithSlotsPerEpoch(0)},
+			err:    "problem with parameters: no slots per epoch specified",
+		},
+		{
+			name:   "LatencyNegative",
+			params: []server.Parameter{server.WithLatency(-1)},
+			err:    "problem with parameters: latency cannot be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := server.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaticValues(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t, server.WithNodeVersion("mock/test"), server.WithSlotsPerEpoch(8))
+
+	genesis, err := service.(client.GenesisProvider).Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, srv.Chain().Genesis().GenesisValidatorsRoot, genesis.GenesisValidatorsRoot)
+
+	nodeVersion, err := service.(client.NodeVersionProvider).NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "mock/test", nodeVersion)
+
+	slotsPerEpoch, err := service.(client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), slotsPerEpoch)
+
+	slotDuration, err := service.(client.SlotDurationProvider).SlotDuration(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, slotDuration)
+}
+
+func TestBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t)
+	chain := srv.Chain()
+
+	root1, err := chain.ProposeBlock(1)
+	require.NoError(t, err)
+	root2, err := chain.ProposeBlock(2)
+	require.NoError(t, err)
+
+	block, err := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	blockRoot, err := block.Root()
+	require.NoError(t, err)
+	require.Equal(t, root2, blockRoot)
+
+	block, err = service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "1")
+	require.NoError(t, err)
+	blockRoot, err = block.Root()
+	require.NoError(t, err)
+	require.Equal(t, root1, blockRoot)
+
+	// Unknown blocks return nil.
+	block, err = service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "100")
+	require.NoError(t, err)
+	require.Nil(t, block)
+
+	// Reorg out the block at slot 2.
+	srv.Chain().SetHead(root1)
+	root3, err := chain.ProposeBlock(3)
+	require.NoError(t, err)
+
+	header, err := service.(client.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, root2.String())
+	require.NoError(t, err)
+	require.False(t, header.Canonical)
+	header, err = service.(client.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.True(t, header.Canonical)
+	require.Equal(t, root3, header.Root)
+	require.Equal(t, root1, header.Header.Message.ParentRoot)
+}
+
+func TestSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx, server.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, httpServer.URL+"/eth/v2/beacon/blocks/genesis", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/octet-stream")
+	resp, err := nethttp.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, nethttp.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	require.Equal(t, "phase0", resp.Header.Get("Eth-Consensus-Version"))
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	block := &phase0.SignedBeaconBlock{}
+	require.NoError(t, block.UnmarshalSSZ(data))
+	root, err := block.Message.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, srv.Chain().Head(), phase0.Root(root))
+}
+
+func TestValidators(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t)
+	validators := make([]*apiv1.Validator, 4)
+	for i := range validators {
+		validators[i] = &apiv1.Validator{
+			Index:   phase0.ValidatorIndex(i),
+			Balance: 32000000000,
+			Status:  apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:             phase0.BLSPubKey{byte(i)},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+				ExitEpoch:             0xffffffffffffffff,
+				WithdrawableEpoch:     0xffffffffffffffff,
+			},
+		}
+	}
+	validators[3].Status = apiv1.ValidatorStatePendingQueued
+	srv.Chain().SetValidators(validators)
+
+	res, err := service.(client.ValidatorsProvider).Validators(ctx, "head", nil)
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+
+	res, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, apiv1.ValidatorStatePendingQueued, res[3].Status)
+
+	res, err = service.(client.ValidatorsProvider).ValidatorsByPubKey(ctx, "head", []phase0.BLSPubKey{{0x02}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.NotNil(t, res[2])
+
+	balances, err := service.(client.ValidatorBalancesProvider).ValidatorBalances(ctx, "head", []phase0.ValidatorIndex{0})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(32000000000), balances[0])
+}
+
+func TestFaults(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t)
+
+	srv.AddFault("/eth/v1/node/syncing", server.Fault{
+		StatusCode: nethttp.StatusServiceUnavailable,
+		Message:    "node unavailable",
+		Count:      1,
+	})
+	_, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.Error(t, err)
+	var httpErr http.Error
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, nethttp.StatusServiceUnavailable, httpErr.StatusCode)
+
+	// Fault only applied once.
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+
+	srv.AddFault("/eth/v1/node/syncing", server.Fault{
+		Latency: 200 * time.Millisecond,
+	})
+	started := time.Now()
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), 200*time.Millisecond)
+
+	srv.ClearFaults()
+	srv.Chain().SetSyncDistance(5)
+	syncState, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.True(t, syncState.IsSyncing)
+	require.Equal(t, phase0.Slot(5), syncState.SyncDistance)
+}
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t)
+
+	events := make(chan *apiv1.Event, 16)
+	err := service.(client.EventsProvider).Events(ctx, []string{"head", "chain_reorg"}, func(event *apiv1.Event) {
+		events <- event
+	})
+	require.NoError(t, err)
+
+	// Wait for the subscription to be established.
+	var root1 phase0.Root
+	require.Eventually(t, func() bool {
+		root1, err = srv.Chain().ProposeBlock(srv.Chain().Block(srv.Chain().Head()).Phase0.Message.Slot + 1)
+		require.NoError(t, err)
+		select {
+		case event := <-events:
+			return event.Topic == "head" && event.Data.(*apiv1.HeadEvent).Block == root1
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Fork off the parent and check for a reorg.
+	parent := srv.Chain().Block(root1).Phase0.Message.ParentRoot
+	require.NoError(t, srv.Chain().SetHead(parent))
+	for {
+		event := <-events
+		if event.Topic == "chain_reorg" {
+			require.Equal(t, root1, event.Data.(*apiv1.ChainReorgEvent).OldHeadBlock)
+			break
+		}
+	}
+}
+
+func TestAutoPropose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := server.New(ctx,
+		server.WithLogLevel(zerolog.Disabled),
+		server.WithGenesisTime(time.Now()),
+		server.WithSlotDuration(50*time.Millisecond),
+		server.WithAutoPropose(true),
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return srv.Chain().SyncState().HeadSlot >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.GreaterOrEqual(t, srv.Clock().CurrentSlot(), phase0.Slot(3))
+}
+
+func TestValidatorDuties(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, service := newTestService(ctx, t)
+	chain := srv.Chain()
+	chain.SetValidators([]*apiv1.Validator{
+		{
+			Index:     1,
+			Status:    apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{PublicKey: phase0.BLSPubKey{0x01}, WithdrawalCredentials: make([]byte, 32)},
+		},
+	})
+	chain.SetSyncCommittee(0, &apiv1.SyncCommittee{
+		Validators:          []phase0.ValidatorIndex{1, 2, 1},
+		ValidatorAggregates: [][]phase0.ValidatorIndex{{1, 2, 1}},
+	})
+
+	syncCommittee, err := service.(client.SyncCommitteesProvider).SyncCommittee(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1, 2, 1}, syncCommittee.Validators)
+
+	duties, err := service.(client.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, 0, []phase0.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.Len(t, duties, 1)
+	require.Equal(t, phase0.BLSPubKey{0x01}, duties[0].PubKey)
+	require.Equal(t, []phase0.CommitteeIndex{0, 2}, duties[0].ValidatorSyncCommitteeIndices)
+
+	contribution, err := service.(client.SyncCommitteeContributionProvider).SyncCommitteeContribution(ctx, 1, 2, phase0.Root{0x01})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), contribution.SubcommitteeIndex)
+	require.Equal(t, phase0.Root{0x01}, contribution.BeaconBlockRoot)
+
+	chain.SetRandao(0, phase0.Root{0x02})
+	randao, err := service.(client.BeaconStateRandaoProvider).BeaconStateRandao(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x02}, *randao)
+
+	proposal, err := service.(client.BeaconBlockProposalProvider).BeaconBlockProposal(ctx, 1, phase0.BLSSignature{0x03}, []byte("mock"))
+	require.NoError(t, err)
+	slot, err := proposal.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(1), slot)
+	parentRoot, err := proposal.ParentRoot()
+	require.NoError(t, err)
+	require.Equal(t, chain.Head(), parentRoot)
+
+	attestation := &phase0.Attestation{
+		AggregationBits: []byte{0x03},
+		Data: &phase0.AttestationData{
+			Slot:   1,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+	chain.AddAttestations([]*phase0.Attestation{attestation})
+	dataRoot, err := attestation.Data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate, err := service.(client.AggregateAttestationProvider).AggregateAttestation(ctx, 1, dataRoot)
+	require.NoError(t, err)
+	require.Equal(t, attestation.AggregationBits, aggregate.AggregationBits)
+
+	_, err = service.(client.NodePeerCountProvider).NodePeerCount(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "status 501")
+}
@@ -0,0 +1,366 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// handleGenesis serves the genesis information.
+func (s *Server) handleGenesis(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeData(w, s.chain.Genesis())
+}
+
+// handleBeaconStateRoot serves the root of a beacon state.
+func (s *Server) handleBeaconStateRoot(w http.ResponseWriter, _ *http.Request, params []string) {
+	slot, exists := s.resolveState(w, params[0])
+	if !exists {
+		return
+	}
+
+	writeData(w, &rootJSON{Root: fmt.Sprintf("%#x", s.chain.StateRoot(slot))})
+}
+
+// handleFork serves the fork of a beacon state.
+func (s *Server) handleFork(w http.ResponseWriter, _ *http.Request, params []string) {
+	slot, exists := s.resolveState(w, params[0])
+	if !exists {
+		return
+	}
+
+	writeData(w, s.chain.ForkAtEpoch(phase0.Epoch(uint64(slot)/s.chain.SlotsPerEpoch())))
+}
+
+// handleFinality serves the finality checkpoints of a beacon state.
+func (s *Server) handleFinality(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, exists := s.resolveState(w, params[0]); !exists {
+		return
+	}
+
+	writeData(w, s.chain.Finality())
+}
+
+// handleValidators serves the validators of a beacon state.
+func (s *Server) handleValidators(w http.ResponseWriter, r *http.Request, params []string) {
+	s.writeValidators(w, params[0], queryList(r.URL.Query(), "id"), queryList(r.URL.Query(), "status"))
+}
+
+// handlePostValidators serves the validators of a beacon state, with filters supplied in the request body.
+func (s *Server) handlePostValidators(w http.ResponseWriter, r *http.Request, params []string) {
+	var request struct {
+		IDs      []string `json:"ids"`
+		Statuses []string `json:"statuses"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	s.writeValidators(w, params[0], request.IDs, request.Statuses)
+}
+
+// writeValidators writes the validators of a beacon state matching the given filters.
+func (s *Server) writeValidators(w http.ResponseWriter, stateID string, ids []string, statuses []string) {
+	if _, exists := s.resolveState(w, stateID); !exists {
+		return
+	}
+
+	validators, err := filterValidators(s.chain.Validators(), ids, statuses)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeData(w, validators)
+}
+
+// handleValidatorBalances serves the validator balances of a beacon state.
+func (s *Server) handleValidatorBalances(w http.ResponseWriter, r *http.Request, params []string) {
+	s.writeValidatorBalances(w, params[0], queryList(r.URL.Query(), "id"))
+}
+
+// handlePostValidatorBalances serves the validator balances of a beacon state, with IDs supplied in the request body.
+func (s *Server) handlePostValidatorBalances(w http.ResponseWriter, r *http.Request, params []string) {
+	var ids []string
+	if err := json.NewDecoder(r.Body).Decode(&ids); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	s.writeValidatorBalances(w, params[0], ids)
+}
+
+// writeValidatorBalances writes the validator balances of a beacon state matching the given IDs.
+func (s *Server) writeValidatorBalances(w http.ResponseWriter, stateID string, ids []string) {
+	if _, exists := s.resolveState(w, stateID); !exists {
+		return
+	}
+
+	validators, err := filterValidators(s.chain.Validators(), ids, nil)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	balances := make([]*apiv1.ValidatorBalance, len(validators))
+	for i, validator := range validators {
+		balances[i] = &apiv1.ValidatorBalance{
+			Index:   validator.Index,
+			Balance: validator.Balance,
+		}
+	}
+
+	writeData(w, balances)
+}
+
+// handleBeaconCommittees serves the beacon committees of a beacon state.
+func (s *Server) handleBeaconCommittees(w http.ResponseWriter, r *http.Request, params []string) {
+	slot, exists := s.resolveState(w, params[0])
+	if !exists {
+		return
+	}
+
+	query := r.URL.Query()
+	epoch := phase0.Epoch(uint64(slot) / s.chain.SlotsPerEpoch())
+	if query.Get("epoch") != "" {
+		tmp, err := strconv.ParseUint(query.Get("epoch"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid epoch")
+			return
+		}
+		epoch = phase0.Epoch(tmp)
+	}
+
+	committees := make([]*apiv1.BeaconCommittee, 0)
+	for _, committee := range s.chain.BeaconCommittees(epoch) {
+		if query.Get("slot") != "" && query.Get("slot") != fmt.Sprintf("%d", committee.Slot) {
+			continue
+		}
+		if query.Get("index") != "" && query.Get("index") != fmt.Sprintf("%d", committee.Index) {
+			continue
+		}
+		committees = append(committees, committee)
+	}
+
+	writeData(w, committees)
+}
+
+// handleSyncCommittee serves the sync committee of a beacon state.
+func (s *Server) handleSyncCommittee(w http.ResponseWriter, r *http.Request, params []string) {
+	epoch, exists := s.resolveStateEpoch(w, r, params[0])
+	if !exists {
+		return
+	}
+
+	committee := s.chain.SyncCommittee(epoch)
+	if committee == nil {
+		writeError(w, http.StatusNotFound, "sync committee not found")
+		return
+	}
+
+	writeData(w, committee)
+}
+
+type randaoJSON struct {
+	Randao string `json:"randao"`
+}
+
+// handleBeaconStateRandao serves the RANDAO mix of a beacon state.
+func (s *Server) handleBeaconStateRandao(w http.ResponseWriter, r *http.Request, params []string) {
+	epoch, exists := s.resolveStateEpoch(w, r, params[0])
+	if !exists {
+		return
+	}
+
+	writeData(w, &randaoJSON{
+		Randao: s.chain.Randao(epoch).String(),
+	})
+}
+
+// handleBeaconState serves a beacon state, as JSON or SSZ.
+func (s *Server) handleBeaconState(w http.ResponseWriter, r *http.Request, params []string) {
+	slot, exists := s.resolveState(w, params[0])
+	if !exists {
+		return
+	}
+	state := s.chain.BeaconState(slot)
+	if state == nil {
+		writeError(w, http.StatusNotFound, "state not found")
+		return
+	}
+
+	var data interface{}
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		data = state.Phase0
+	case spec.DataVersionAltair:
+		data = state.Altair
+	case spec.DataVersionBellatrix:
+		data = state.Bellatrix
+	case spec.DataVersionCapella:
+		data = state.Capella
+	case spec.DataVersionDeneb:
+		data = state.Deneb
+	default:
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("unhandled state version %s", state.Version))
+		return
+	}
+
+	if wantsSSZ(r) {
+		marshaler, isMarshaler := data.(sszMarshaler)
+		if !isMarshaler {
+			writeError(w, http.StatusNotAcceptable, fmt.Sprintf("SSZ not available for %s state", state.Version))
+			return
+		}
+		ssz, err := marshaler.MarshalSSZ()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeSSZ(w, state.Version.String(), ssz)
+		return
+	}
+
+	writeVersionedData(w, state.Version.String(), data)
+}
+
+// resolveState resolves a state ID to a slot, writing an error response if not found.
+func (s *Server) resolveState(w http.ResponseWriter, stateID string) (phase0.Slot, bool) {
+	slot, exists := s.chain.StateSlot(stateID)
+	if !exists {
+		writeError(w, http.StatusNotFound, "state not found")
+		return 0, false
+	}
+
+	return slot, true
+}
+
+// resolveStateEpoch resolves a state ID and optional epoch query parameter to an epoch,
+// writing an error response if not found.
+func (s *Server) resolveStateEpoch(w http.ResponseWriter, r *http.Request, stateID string) (phase0.Epoch, bool) {
+	slot, exists := s.resolveState(w, stateID)
+	if !exists {
+		return 0, false
+	}
+
+	if r.URL.Query().Get("epoch") == "" {
+		return phase0.Epoch(uint64(slot) / s.chain.SlotsPerEpoch()), true
+	}
+	epoch, err := strconv.ParseUint(r.URL.Query().Get("epoch"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+		return 0, false
+	}
+
+	return phase0.Epoch(epoch), true
+}
+
+// queryList obtains a list of values from a query parameter, which can be
+// supplied either as a comma-separated list or as repeated parameters.
+func queryList(query url.Values, key string) []string {
+	res := make([]string, 0)
+	for _, value := range query[key] {
+		for _, item := range strings.Split(value, ",") {
+			if item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+
+	return res
+}
+
+// filterValidators filters validators by ID (index or public key) and status.
+func filterValidators(validators []*apiv1.Validator, ids []string, statuses []string) ([]*apiv1.Validator, error) {
+	indices := make(map[phase0.ValidatorIndex]bool)
+	pubKeys := make(map[phase0.BLSPubKey]bool)
+	for _, id := range ids {
+		if strings.HasPrefix(id, "0x") {
+			data, err := hexDecode(id)
+			if err != nil || len(data) != len(phase0.BLSPubKey{}) {
+				return nil, fmt.Errorf("invalid validator ID %s", id)
+			}
+			var pubKey phase0.BLSPubKey
+			copy(pubKey[:], data)
+			pubKeys[pubKey] = true
+			continue
+		}
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator ID %s", id)
+		}
+		indices[phase0.ValidatorIndex(index)] = true
+	}
+
+	res := make([]*apiv1.Validator, 0)
+	for _, validator := range validators {
+		if len(ids) > 0 {
+			matched := indices[validator.Index]
+			if !matched && validator.Validator != nil {
+				matched = pubKeys[validator.Validator.PublicKey]
+			}
+			if !matched {
+				continue
+			}
+		}
+		if len(statuses) > 0 && !statusMatches(validator.Status, statuses) {
+			continue
+		}
+		res = append(res, validator)
+	}
+
+	return res, nil
+}
+
+// statusMatches returns true if the state matches any of the given statuses.
+// Statuses can be either specific states or the general "pending", "active",
+// "exited" and "withdrawal" states.
+func statusMatches(state apiv1.ValidatorState, statuses []string) bool {
+	for _, status := range statuses {
+		switch status {
+		case "pending":
+			if state.IsPending() {
+				return true
+			}
+		case "active":
+			if state.IsActive() {
+				return true
+			}
+		case "exited":
+			if state.IsExited() {
+				return true
+			}
+		case "withdrawal":
+			if state == apiv1.ValidatorStateWithdrawalPossible || state == apiv1.ValidatorStateWithdrawalDone {
+				return true
+			}
+		default:
+			if state.String() == status {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,347 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// infinitySignature is the BLS signature of an empty set of signers.
+var infinitySignature = phase0.BLSSignature{0xc0}
+
+type dutiesResponse struct {
+	DependentRoot       string      `json:"dependent_root"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	Data                interface{} `json:"data"`
+}
+
+// handleProposerDuties serves the proposer duties for an epoch.
+func (s *Server) handleProposerDuties(w http.ResponseWriter, _ *http.Request, params []string) {
+	epoch, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+		return
+	}
+
+	duties := s.chain.ProposerDuties(phase0.Epoch(epoch))
+	if duties == nil {
+		duties = make([]*apiv1.ProposerDuty, 0)
+	}
+
+	writeJSON(w, http.StatusOK, &dutiesResponse{
+		DependentRoot: s.dependentRoot(phase0.Epoch(epoch)).String(),
+		Data:          duties,
+	})
+}
+
+// handleAttesterDuties serves the attester duties for an epoch, derived from the beacon committees.
+func (s *Server) handleAttesterDuties(w http.ResponseWriter, r *http.Request, params []string) {
+	epoch, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+		return
+	}
+
+	indices, err := decodeValidatorIndices(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pubKeys := s.validatorPubKeys()
+
+	committees := s.chain.BeaconCommittees(phase0.Epoch(epoch))
+	committeesAtSlot := make(map[phase0.Slot]uint64)
+	for _, committee := range committees {
+		committeesAtSlot[committee.Slot]++
+	}
+
+	duties := make([]*apiv1.AttesterDuty, 0)
+	for _, committee := range committees {
+		for i, index := range committee.Validators {
+			if !indices[index] {
+				continue
+			}
+			duties = append(duties, &apiv1.AttesterDuty{
+				PubKey:                  pubKeys[index],
+				Slot:                    committee.Slot,
+				ValidatorIndex:          index,
+				CommitteeIndex:          committee.Index,
+				CommitteeLength:         uint64(len(committee.Validators)),
+				CommitteesAtSlot:        committeesAtSlot[committee.Slot],
+				ValidatorCommitteeIndex: uint64(i),
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &dutiesResponse{
+		DependentRoot: s.dependentRoot(phase0.Epoch(epoch)).String(),
+		Data:          duties,
+	})
+}
+
+// handleSyncCommitteeDuties serves the sync committee duties for an epoch, derived from the sync committee.
+func (s *Server) handleSyncCommitteeDuties(w http.ResponseWriter, r *http.Request, params []string) {
+	epoch, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+		return
+	}
+
+	indices, err := decodeValidatorIndices(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	duties := make([]*apiv1.SyncCommitteeDuty, 0)
+	committee := s.chain.SyncCommittee(phase0.Epoch(epoch))
+	if committee == nil {
+		writeData(w, duties)
+		return
+	}
+
+	pubKeys := s.validatorPubKeys()
+	dutiesByIndex := make(map[phase0.ValidatorIndex]*apiv1.SyncCommitteeDuty)
+	for i, index := range committee.Validators {
+		if !indices[index] {
+			continue
+		}
+		duty, exists := dutiesByIndex[index]
+		if !exists {
+			duty = &apiv1.SyncCommitteeDuty{
+				PubKey:                        pubKeys[index],
+				ValidatorIndex:                index,
+				ValidatorSyncCommitteeIndices: make([]phase0.CommitteeIndex, 0),
+			}
+			dutiesByIndex[index] = duty
+			duties = append(duties, duty)
+		}
+		duty.ValidatorSyncCommitteeIndices = append(duty.ValidatorSyncCommitteeIndices, phase0.CommitteeIndex(i))
+	}
+
+	writeData(w, duties)
+}
+
+// handleAttestationData serves attestation data for a slot and committee index.
+func (s *Server) handleAttestationData(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query()
+	slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	committeeIndex, err := strconv.ParseUint(query.Get("committee_index"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid committee index")
+		return
+	}
+
+	slotsPerEpoch := s.chain.SlotsPerEpoch()
+	epoch := phase0.Epoch(slot / slotsPerEpoch)
+	finality := s.chain.Finality()
+	writeData(w, &phase0.AttestationData{
+		Slot:            phase0.Slot(slot),
+		Index:           phase0.CommitteeIndex(committeeIndex),
+		BeaconBlockRoot: s.chain.CanonicalRootAt(phase0.Slot(slot)),
+		Source:          finality.Justified,
+		Target: &phase0.Checkpoint{
+			Epoch: epoch,
+			Root:  s.chain.CanonicalRootAt(phase0.Slot(uint64(epoch) * slotsPerEpoch)),
+		},
+	})
+}
+
+// handleAggregateAttestation serves the aggregate attestation from the pool matching a slot and attestation data root.
+func (s *Server) handleAggregateAttestation(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query()
+	slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	attestationDataRoot, err := hexDecode(query.Get("attestation_data_root"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid attestation data root")
+		return
+	}
+
+	for _, attestation := range s.chain.Attestations(phase0.Slot(slot)) {
+		root, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if bytes.Equal(root[:], attestationDataRoot) {
+			writeData(w, attestation)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "aggregate attestation not found")
+}
+
+// handleSyncCommitteeContribution serves a sync committee contribution.
+// The server holds no sync committee messages, so the contribution has no participants.
+func (s *Server) handleSyncCommitteeContribution(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query()
+	slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	subcommitteeIndex, err := strconv.ParseUint(query.Get("subcommittee_index"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid subcommittee index")
+		return
+	}
+	beaconBlockRoot, err := parseRoot(query.Get("beacon_block_root"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid beacon block root")
+		return
+	}
+
+	writeData(w, &altair.SyncCommitteeContribution{
+		Slot:              phase0.Slot(slot),
+		BeaconBlockRoot:   beaconBlockRoot,
+		SubcommitteeIndex: subcommitteeIndex,
+		AggregationBits:   bitfield.NewBitvector128(),
+		Signature:         infinitySignature,
+	})
+}
+
+// handleBeaconBlockProposal serves an empty phase 0 beacon block proposal built on the current head.
+func (s *Server) handleBeaconBlockProposal(w http.ResponseWriter, r *http.Request, params []string) {
+	slot, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	query := r.URL.Query()
+	randaoReveal, err := hexDecode(query.Get("randao_reveal"))
+	if err != nil || len(randaoReveal) != phase0.SignatureLength {
+		writeError(w, http.StatusBadRequest, "invalid RANDAO reveal")
+		return
+	}
+	graffiti := make([]byte, 0)
+	if query.Get("graffiti") != "" {
+		graffiti, err = hexDecode(query.Get("graffiti"))
+		if err != nil || len(graffiti) > 32 {
+			writeError(w, http.StatusBadRequest, "invalid graffiti")
+			return
+		}
+	}
+
+	proposerIndex := phase0.ValidatorIndex(0)
+	epoch := phase0.Epoch(slot / s.chain.SlotsPerEpoch())
+	for _, duty := range s.chain.ProposerDuties(epoch) {
+		if duty.Slot == phase0.Slot(slot) {
+			proposerIndex = duty.ValidatorIndex
+			break
+		}
+	}
+
+	block := emptyBlock(phase0.Slot(slot), proposerIndex, s.chain.Head()).Phase0.Message
+	copy(block.Body.RANDAOReveal[:], randaoReveal)
+	copy(block.Body.Graffiti[:], graffiti)
+
+	writeVersionedData(w, spec.DataVersionPhase0.String(), block)
+}
+
+// handleBeaconCommitteeSelections serves aggregated beacon committee selection proofs.
+// The server acts as a distributed validator cluster of a single node, so the
+// aggregated selection proofs are the same as those supplied.
+func (s *Server) handleBeaconCommitteeSelections(w http.ResponseWriter, r *http.Request, _ []string) {
+	body, err := s.recordSubmission(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var selections []*apiv1.BeaconCommitteeSelection
+	if err := json.Unmarshal(body, &selections); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeData(w, selections)
+}
+
+// handleSyncCommitteeSelections serves aggregated sync committee selection proofs.
+// The server acts as a distributed validator cluster of a single node, so the
+// aggregated selection proofs are the same as those supplied.
+func (s *Server) handleSyncCommitteeSelections(w http.ResponseWriter, r *http.Request, _ []string) {
+	body, err := s.recordSubmission(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var selections []*apiv1.SyncCommitteeSelection
+	if err := json.Unmarshal(body, &selections); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeData(w, selections)
+}
+
+// decodeValidatorIndices decodes the validator indices supplied in the body of a duties request.
+func decodeValidatorIndices(r *http.Request) (map[phase0.ValidatorIndex]bool, error) {
+	var ids []string
+	if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+		return nil, errors.New("invalid validator indices")
+	}
+	indices := make(map[phase0.ValidatorIndex]bool, len(ids))
+	for _, id := range ids {
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid validator index")
+		}
+		indices[phase0.ValidatorIndex(index)] = true
+	}
+
+	return indices, nil
+}
+
+// validatorPubKeys provides the public keys of the chain's validators, keyed by index.
+func (s *Server) validatorPubKeys() map[phase0.ValidatorIndex]phase0.BLSPubKey {
+	pubKeys := make(map[phase0.ValidatorIndex]phase0.BLSPubKey)
+	for _, validator := range s.chain.Validators() {
+		if validator.Validator != nil {
+			pubKeys[validator.Index] = validator.Validator.PublicKey
+		}
+	}
+
+	return pubKeys
+}
+
+// dependentRoot provides the dependent root for duties in the given epoch.
+func (s *Server) dependentRoot(epoch phase0.Epoch) phase0.Root {
+	if epoch == 0 {
+		return s.chain.CanonicalRootAt(0)
+	}
+
+	return s.chain.CanonicalRootAt(phase0.Slot(uint64(epoch)*s.chain.SlotsPerEpoch() - 1))
+}
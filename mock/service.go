@@ -15,8 +15,15 @@ package mock
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -41,6 +48,106 @@ type Service struct {
 	// Values that can be altered if required.
 	HeadSlot     phase0.Slot
 	SyncDistance phase0.Slot
+
+	// Call recording, and per-method errors and delays.
+	callsMu    sync.Mutex
+	calls      []*Call
+	callErrors map[string]error
+	callDelays map[string]time.Duration
+
+	// Functions that can be set to override the default responses.
+	// Each is called with the arguments supplied to the method of the same
+	// name, after any configured delay and error have been applied.
+	AggregateAndProofDomainFunc            func(context.Context) (phase0.DomainType, error)
+	AggregateAttestationFunc               func(context.Context, phase0.Slot, phase0.Root) (*phase0.Attestation, error)
+	AttestationDataFunc                    func(context.Context, phase0.Slot, phase0.CommitteeIndex) (*phase0.AttestationData, error)
+	AttestationPoolFunc                    func(context.Context, phase0.Slot) ([]*phase0.Attestation, error)
+	AttestationPoolWithOptsFunc            func(context.Context, *api.AttestationPoolOpts) ([]*phase0.Attestation, error)
+	AttestationRewardsFunc                 func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error)
+	AttesterDutiesFunc                     func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error)
+	AttesterSlashingPoolFunc               func(context.Context) ([]*phase0.AttesterSlashing, error)
+	BLSToExecutionChangePoolFunc           func(context.Context) ([]*capella.SignedBLSToExecutionChange, error)
+	BeaconAttesterDomainFunc               func(context.Context) (phase0.DomainType, error)
+	BeaconBlockAttestationsFunc            func(context.Context, string) ([]*phase0.Attestation, error)
+	BeaconBlockHeaderFunc                  func(context.Context, string) (*apiv1.BeaconBlockHeader, error)
+	BeaconBlockHeadersFunc                 func(context.Context, *phase0.Slot, *phase0.Root) ([]*apiv1.BeaconBlockHeader, error)
+	BeaconBlockProposalFunc                func(context.Context, phase0.Slot, phase0.BLSSignature, []byte) (*spec.VersionedBeaconBlock, error)
+	BeaconBlockRootFunc                    func(context.Context, string) (*phase0.Root, error)
+	BeaconCommitteeSelectionsFunc          func(context.Context, []*apiv1.BeaconCommitteeSelection) ([]*apiv1.BeaconCommitteeSelection, error)
+	BeaconCommitteesAtEpochFunc            func(context.Context, string, phase0.Epoch) ([]*apiv1.BeaconCommittee, error)
+	BeaconCommitteesFunc                   func(context.Context, string) ([]*apiv1.BeaconCommittee, error)
+	BeaconCommitteesWithOptsFunc           func(context.Context, *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error)
+	BeaconProposerDomainFunc               func(context.Context) (phase0.DomainType, error)
+	BeaconStateFunc                        func(context.Context, string) (*spec.VersionedBeaconState, error)
+	BeaconStateRootFunc                    func(context.Context, string) (*phase0.Root, error)
+	BlindedBeaconBlockProposalFunc         func(context.Context, phase0.Slot, phase0.BLSSignature, []byte) (*api.VersionedBlindedBeaconBlock, error)
+	BlockRewardsFunc                       func(context.Context, string) (*apiv1.BlockRewards, error)
+	ChainHeadsFunc                         func(context.Context) ([]*apiv1.ChainHead, error)
+	ChainSpecFunc                          func(context.Context) (*apiv1.ChainSpec, error)
+	DepositContractFunc                    func(context.Context) (*apiv1.DepositContract, error)
+	DepositDomainFunc                      func(context.Context) (phase0.DomainType, error)
+	DepositSnapshotFunc                    func(context.Context) (*apiv1.DepositSnapshot, error)
+	DomainFunc                             func(context.Context, phase0.DomainType, phase0.Epoch) (phase0.Domain, error)
+	EventsFunc                             func(context.Context, []string, client.EventHandlerFunc) error
+	ExpectedWithdrawalsFunc                func(context.Context, string, phase0.Slot) ([]*capella.Withdrawal, error)
+	FarFutureEpochFunc                     func(context.Context) (phase0.Epoch, error)
+	FilteredValidatorsFunc                 func(context.Context, string, []phase0.ValidatorIndex, []phase0.BLSPubKey, []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	FinalityFunc                           func(context.Context, string) (*apiv1.Finality, error)
+	ForkChoiceFunc                         func(context.Context) (*apiv1.ForkChoice, error)
+	ForkFunc                               func(context.Context, string) (*phase0.Fork, error)
+	ForkScheduleFunc                       func(context.Context) ([]*phase0.Fork, error)
+	GenesisDomainFunc                      func(context.Context, phase0.DomainType) (phase0.Domain, error)
+	GenesisFunc                            func(context.Context) (*apiv1.Genesis, error)
+	GenesisTimeFunc                        func(context.Context) (time.Time, error)
+	HistoricalRootsFunc                    func(context.Context, string) ([]phase0.Root, error)
+	HistoricalSummariesFunc                func(context.Context, string) ([]*capella.HistoricalSummary, error)
+	LightClientBootstrapFunc               func(context.Context, phase0.Root) (*spec.VersionedLightClientBootstrap, error)
+	LightClientFinalityUpdateFunc          func(context.Context) (*spec.VersionedLightClientFinalityUpdate, error)
+	LightClientOptimisticUpdateFunc        func(context.Context) (*spec.VersionedLightClientOptimisticUpdate, error)
+	LightClientUpdatesFunc                 func(context.Context, uint64, uint64) ([]*spec.VersionedLightClientUpdate, error)
+	NodeHealthFunc                         func(context.Context) (apiv1.NodeHealth, error)
+	NodeIdentityFunc                       func(context.Context) (*apiv1.NodeIdentity, error)
+	NodePeerCountFunc                      func(context.Context) (*apiv1.PeerCount, error)
+	NodePeerFunc                           func(context.Context, string) (*apiv1.Peer, error)
+	NodePeersFunc                          func(context.Context, []string, []string) ([]*apiv1.Peer, error)
+	NodeSyncingFunc                        func(context.Context) (*apiv1.SyncState, error)
+	NodeVersionFunc                        func(context.Context) (string, error)
+	ProposerDutiesFunc                     func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error)
+	ProposerSlashingPoolFunc               func(context.Context) ([]*phase0.ProposerSlashing, error)
+	RANDAODomainFunc                       func(context.Context) (phase0.DomainType, error)
+	SelectionProofDomainFunc               func(context.Context) (phase0.DomainType, error)
+	SignedBeaconBlockFunc                  func(context.Context, string) (*spec.VersionedSignedBeaconBlock, error)
+	SignedBlindedBeaconBlockFunc           func(context.Context, string) (*api.VersionedSignedBlindedBeaconBlock, error)
+	SlotDurationFunc                       func(context.Context) (time.Duration, error)
+	SlotsPerEpochFunc                      func(context.Context) (uint64, error)
+	SpecFunc                               func(context.Context) (map[string]interface{}, error)
+	SubmitAggregateAttestationsFunc        func(context.Context, []*phase0.SignedAggregateAndProof) error
+	SubmitAttestationsFunc                 func(context.Context, []*phase0.Attestation) error
+	SubmitAttesterSlashingFunc             func(context.Context, *phase0.AttesterSlashing) error
+	SubmitBLSToExecutionChangeFunc         func(context.Context, *capella.SignedBLSToExecutionChange) error
+	SubmitBeaconBlockFunc                  func(context.Context, *spec.VersionedSignedBeaconBlock) error
+	SubmitBeaconCommitteeSubscriptionsFunc func(context.Context, []*apiv1.BeaconCommitteeSubscription) error
+	SubmitBlindedBeaconBlockFunc           func(context.Context, *api.VersionedSignedBlindedBeaconBlock) error
+	SubmitProposalPreparationsFunc         func(context.Context, []*apiv1.ProposalPreparation) error
+	SubmitProposerSlashingFunc             func(context.Context, *phase0.ProposerSlashing) error
+	SubmitSyncCommitteeContributionsFunc   func(context.Context, []*altair.SignedContributionAndProof) error
+	SubmitSyncCommitteeMessagesFunc        func(context.Context, []*altair.SyncCommitteeMessage) error
+	SubmitSyncCommitteeSubscriptionsFunc   func(context.Context, []*apiv1.SyncCommitteeSubscription) error
+	SubmitValidatorRegistrationsFunc       func(context.Context, []*api.VersionedSignedValidatorRegistration) error
+	SubmitVoluntaryExitFunc                func(context.Context, *phase0.SignedVoluntaryExit) error
+	SyncCommitteeAtEpochFunc               func(context.Context, string, phase0.Epoch) (*apiv1.SyncCommittee, error)
+	SyncCommitteeContributionFunc          func(context.Context, phase0.Slot, uint64, phase0.Root) (*altair.SyncCommitteeContribution, error)
+	SyncCommitteeDutiesFunc                func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error)
+	SyncCommitteeFunc                      func(context.Context, string) (*apiv1.SyncCommittee, error)
+	SyncCommitteeRewardsFunc               func(context.Context, string, []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error)
+	SyncCommitteeSelectionsFunc            func(context.Context, []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error)
+	TargetAggregatorsPerCommitteeFunc      func(context.Context) (uint64, error)
+	ValidatorBalancesFunc                  func(context.Context, string, []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error)
+	ValidatorLivenessFunc                  func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error)
+	ValidatorsByPubKeyFunc                 func(context.Context, string, []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	ValidatorsFunc                         func(context.Context, string, []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	VoluntaryExitDomainFunc                func(context.Context) (phase0.DomainType, error)
+	VoluntaryExitPoolFunc                  func(context.Context) ([]*phase0.SignedVoluntaryExit, error)
 }
 
 // log is a service-wide logger.
@@ -67,12 +174,18 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 
 		HeadSlot:     12345,
 		SyncDistance: 0,
+
+		calls:      make([]*Call, 0),
+		callErrors: make(map[string]error),
+		callDelays: make(map[string]time.Duration),
 	}
 
 	// Fetch static values to confirm the connection is good.
 	if err := s.fetchStaticValues(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
+	// Calls made during setup are not of interest to callers.
+	s.ResetCalls()
 
 	// Close the service on context done.
 	go func(s *Service) {
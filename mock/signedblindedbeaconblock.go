@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/api"
+	apiv1capella "github.com/jefmcl/go-eth2-client/api/v1/capella"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	if err := s.call(ctx, "SignedBlindedBeaconBlock", blockID); err != nil {
+		return nil, err
+	}
+	if s.SignedBlindedBeaconBlockFunc != nil {
+		return s.SignedBlindedBeaconBlockFunc(ctx, blockID)
+	}
+
+	return &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{
+			Message: &apiv1capella.BlindedBeaconBlock{
+				Body: &apiv1capella.BlindedBeaconBlockBody{
+					ETH1Data:               &phase0.ETH1Data{},
+					SyncAggregate:          &altair.SyncAggregate{},
+					ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+				},
+			},
+		},
+	}, nil
+}
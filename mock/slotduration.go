@@ -19,6 +19,13 @@ import (
 )
 
 // SlotDuration provides the duration of a slot of the chain.
-func (s *Service) SlotDuration(_ context.Context) (time.Duration, error) {
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	if err := s.call(ctx, "SlotDuration"); err != nil {
+		return 0, err
+	}
+	if s.SlotDurationFunc != nil {
+		return s.SlotDurationFunc(ctx)
+	}
+
 	return 12 * time.Second, nil
 }
@@ -20,6 +20,13 @@ import (
 )
 
 // BeaconStateRoot fetches a beacon state root given a state ID.
-func (s *Service) BeaconStateRoot(_ context.Context, _ string) (*spec.Root, error) {
+func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*spec.Root, error) {
+	if err := s.call(ctx, "BeaconStateRoot", stateID); err != nil {
+		return nil, err
+	}
+	if s.BeaconStateRootFunc != nil {
+		return s.BeaconStateRootFunc(ctx, stateID)
+	}
+
 	return &spec.Root{}, nil
 }
@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, attesterSlashing *phase0.AttesterSlashing) error {
+	if err := s.call(ctx, "SubmitAttesterSlashing", attesterSlashing); err != nil {
+		return err
+	}
+	if s.SubmitAttesterSlashingFunc != nil {
+		return s.SubmitAttesterSlashingFunc(ctx, attesterSlashing)
+	}
+
+	return nil
+}
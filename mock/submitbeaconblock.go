@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitBeaconBlock submits a beacon block.
-func (s *Service) SubmitBeaconBlock(_ context.Context, _ *spec.VersionedSignedBeaconBlock) error {
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	if err := s.call(ctx, "SubmitBeaconBlock", block); err != nil {
+		return err
+	}
+	if s.SubmitBeaconBlockFunc != nil {
+		return s.SubmitBeaconBlockFunc(ctx, block)
+	}
+
 	return nil
 }
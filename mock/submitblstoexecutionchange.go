@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitBLSToExecutionChange submits a BLS to execution address change operation.
-func (s *Service) SubmitBLSToExecutionChange(_ context.Context, _ *capella.SignedBLSToExecutionChange) error {
+func (s *Service) SubmitBLSToExecutionChange(ctx context.Context, blsToExecutionChange *capella.SignedBLSToExecutionChange) error {
+	if err := s.call(ctx, "SubmitBLSToExecutionChange", blsToExecutionChange); err != nil {
+		return err
+	}
+	if s.SubmitBLSToExecutionChangeFunc != nil {
+		return s.SubmitBLSToExecutionChangeFunc(ctx, blsToExecutionChange)
+	}
+
 	return nil
 }
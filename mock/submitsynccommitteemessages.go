@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitSyncCommitteeMessages submits sync committee messages.
-func (s *Service) SubmitSyncCommitteeMessages(_ context.Context, _ []*altair.SyncCommitteeMessage) error {
+func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	if err := s.call(ctx, "SubmitSyncCommitteeMessages", messages); err != nil {
+		return err
+	}
+	if s.SubmitSyncCommitteeMessagesFunc != nil {
+		return s.SubmitSyncCommitteeMessagesFunc(ctx, messages)
+	}
+
 	return nil
 }
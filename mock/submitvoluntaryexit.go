@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitVoluntaryExit submits a voluntary exit.
-func (s *Service) SubmitVoluntaryExit(_ context.Context, _ *spec.SignedVoluntaryExit) error {
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	if err := s.call(ctx, "SubmitVoluntaryExit", voluntaryExit); err != nil {
+		return err
+	}
+	if s.SubmitVoluntaryExitFunc != nil {
+		return s.SubmitVoluntaryExitFunc(ctx, voluntaryExit)
+	}
+
 	return nil
 }
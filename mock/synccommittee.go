@@ -21,11 +21,25 @@ import (
 )
 
 // SyncCommittee fetches the sync committee for the given state.
-func (s *Service) SyncCommittee(_ context.Context, _ string) (*api.SyncCommittee, error) {
+func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncCommittee, error) {
+	if err := s.call(ctx, "SyncCommittee", stateID); err != nil {
+		return nil, err
+	}
+	if s.SyncCommitteeFunc != nil {
+		return s.SyncCommitteeFunc(ctx, stateID)
+	}
+
 	return &api.SyncCommittee{}, nil
 }
 
 // SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
-func (s *Service) SyncCommitteeAtEpoch(_ context.Context, _ string, _ phase0.Epoch) (*api.SyncCommittee, error) {
+func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*api.SyncCommittee, error) {
+	if err := s.call(ctx, "SyncCommitteeAtEpoch", stateID, epoch); err != nil {
+		return nil, err
+	}
+	if s.SyncCommitteeAtEpochFunc != nil {
+		return s.SyncCommitteeAtEpochFunc(ctx, stateID, epoch)
+	}
+
 	return &api.SyncCommittee{}, nil
 }
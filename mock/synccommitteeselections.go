@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// SyncCommitteeSelections submits partial sync committee selection proofs and returns the aggregated selection proofs.
+func (s *Service) SyncCommitteeSelections(ctx context.Context, selections []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error) {
+	if err := s.call(ctx, "SyncCommitteeSelections", selections); err != nil {
+		return nil, err
+	}
+	if s.SyncCommitteeSelectionsFunc != nil {
+		return s.SyncCommitteeSelectionsFunc(ctx, selections)
+	}
+
+	return selections, nil
+}
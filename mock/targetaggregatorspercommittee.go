@@ -18,6 +18,13 @@ import (
 )
 
 // TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
-func (s *Service) TargetAggregatorsPerCommittee(_ context.Context) (uint64, error) {
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	if err := s.call(ctx, "TargetAggregatorsPerCommittee"); err != nil {
+		return 0, err
+	}
+	if s.TargetAggregatorsPerCommitteeFunc != nil {
+		return s.TargetAggregatorsPerCommitteeFunc(ctx)
+	}
+
 	return 4, nil
 }
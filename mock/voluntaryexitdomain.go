@@ -20,6 +20,13 @@ import (
 )
 
 // VoluntaryExitDomain provides the voluntary exit domain.
-func (s *Service) VoluntaryExitDomain(_ context.Context) (spec.DomainType, error) {
+func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.call(ctx, "VoluntaryExitDomain"); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.VoluntaryExitDomainFunc != nil {
+		return s.VoluntaryExitDomainFunc(ctx)
+	}
+
 	return spec.DomainType{0x04, 0x00, 0x00, 0x00}, nil
 }
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	consensusclient "github.com/jefmcl/go-eth2-client"
+	api "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -119,6 +120,21 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 func ping(ctx context.Context, client consensusclient.Service) bool {
 	log := zerolog.Ctx(ctx)
 
+	// Prefer the health endpoint, as it is cheaper than obtaining the sync state.
+	if provider, isProvider := client.(consensusclient.NodeHealthProvider); isProvider {
+		health, err := provider.NodeHealth(ctx)
+		switch {
+		case err != nil:
+			log.Debug().Str("provider", client.Address()).Err(err).Msg("Failed to obtain health from node; falling back to sync state")
+		case health != api.NodeHealthSyncing:
+			return health.IsReady()
+		default:
+			// Nodes report as syncing prior to genesis, so use the sync state to
+			// find out if this is the case.
+			log.Trace().Str("provider", client.Address()).Msg("Node reports syncing; checking sync state")
+		}
+	}
+
 	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
 	if !isProvider {
 		log.Debug().Str("provider", client.Address()).Msg("Client does not provide sync state")
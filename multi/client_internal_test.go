@@ -19,8 +19,10 @@ import (
 	"testing"
 
 	consensusclient "github.com/jefmcl/go-eth2-client"
+	api "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/jefmcl/go-eth2-client/mock"
 	"github.com/jefmcl/go-eth2-client/testclients"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -141,3 +143,78 @@ func TestRecheck(t *testing.T) {
 	// Should re-activate in recheck so not return an error.
 	require.NoError(t, err)
 }
+
+// TestPingHealth ensures that ping uses node health where available, falling
+// back to sync state if health cannot be obtained.
+func TestPingHealth(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		health     api.NodeHealth
+		healthErr  error
+		syncing    bool
+		preGenesis bool
+		expected   bool
+	}{
+		{
+			name:     "Ready",
+			health:   api.NodeHealthReady,
+			syncing:  true,
+			expected: true,
+		},
+		{
+			name:     "Syncing",
+			health:   api.NodeHealthSyncing,
+			syncing:  true,
+			expected: false,
+		},
+		{
+			name:       "SyncingPreGenesis",
+			health:     api.NodeHealthSyncing,
+			syncing:    true,
+			preGenesis: true,
+			expected:   true,
+		},
+		{
+			name:     "NotInitialized",
+			health:   api.NodeHealthNotInitialized,
+			expected: false,
+		},
+		{
+			name:      "HealthErrorSynced",
+			healthErr: errors.New("not supported"),
+			expected:  true,
+		},
+		{
+			name:      "HealthErrorSyncing",
+			healthErr: errors.New("not supported"),
+			syncing:   true,
+			expected:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consensusClient, err := mock.New(ctx)
+			require.NoError(t, err)
+			consensusClient.NodeHealthFunc = func(context.Context) (api.NodeHealth, error) {
+				return test.health, test.healthErr
+			}
+			consensusClient.NodeSyncingFunc = func(context.Context) (*api.SyncState, error) {
+				if test.preGenesis {
+					return &api.SyncState{
+						IsSyncing: test.syncing,
+					}, nil
+				}
+				return &api.SyncState{
+					HeadSlot:     100,
+					SyncDistance: 10,
+					IsSyncing:    test.syncing,
+				}, nil
+			}
+
+			require.Equal(t, test.expected, ping(ctx, consensusClient))
+		})
+	}
+}
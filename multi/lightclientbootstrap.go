@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// LightClientBootstrap fetches the light client bootstrap for the given block root.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		lightClientBootstrap, err := client.(consensusclient.LightClientBootstrapProvider).LightClientBootstrap(ctx, blockRoot)
+		if err != nil {
+			return nil, err
+		}
+		return lightClientBootstrap, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*spec.VersionedLightClientBootstrap), nil
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+// Blocks prior to bellatrix have no blinded form, and return api.ErrNotBlinded.
+// N.B if a signed blinded beacon block for the block ID is not available this will return nil without an error.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context,
+	blockID string,
+) (
+	*api.VersionedSignedBlindedBeaconBlock,
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		block, err := client.(consensusclient.SignedBlindedBeaconBlockProvider).SignedBlindedBeaconBlock(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		return block, nil
+	}, func(ctx context.Context, client consensusclient.Service, err error) (bool, error) {
+		// A block without a blinded form is not a failure of the client.
+		if errors.Is(err, api.ErrNotBlinded) {
+			return false /* failover */, err
+		}
+		return true /* failover */, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.VersionedSignedBlindedBeaconBlock), nil
+}
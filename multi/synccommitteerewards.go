@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	api "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// SyncCommitteeRewards provides the sync committee rewards for the given block.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*api.SyncCommitteeReward, error) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		syncCommitteeRewards, err := client.(consensusclient.SyncCommitteeRewardsProvider).SyncCommitteeRewards(ctx, blockID, validatorIndices)
+		if err != nil {
+			return nil, err
+		}
+		return syncCommitteeRewards, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*api.SyncCommitteeReward), nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks
+
+import (
+	"context"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// applicationMaskDomainType is the domain type used for application domains,
+// which are independent of the chain's genesis validators root.
+var applicationMaskDomainType = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(_ context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	// Work through the fork schedule to obtain the fork for the epoch.
+	fork := s.forkSchedule[0]
+	for i := range s.forkSchedule {
+		if s.forkSchedule[i].Epoch > epoch {
+			break
+		}
+		fork = s.forkSchedule[i]
+	}
+
+	return s.domain(domainType, fork.CurrentVersion)
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (s *Service) GenesisDomain(_ context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	return s.domain(domainType, s.forkSchedule[0].CurrentVersion)
+}
+
+func (s *Service) domain(domainType phase0.DomainType, forkVersion phase0.Version) (phase0.Domain, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion: forkVersion,
+	}
+	if domainType != applicationMaskDomainType {
+		// Use the chain's genesis validators root for non-application domain types.
+		forkData.GenesisValidatorsRoot = s.genesis.GenesisValidatorsRoot
+	}
+
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to calculate signature domain")
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:])
+	return domain, nil
+}
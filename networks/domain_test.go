@@ -0,0 +1,104 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/networks"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomain(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := networks.New(ctx,
+		networks.WithLogLevel(zerolog.Disabled),
+		networks.WithNetwork("mainnet"),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		domainType phase0.DomainType
+		epoch      phase0.Epoch
+		expected   string
+	}{
+		{
+			name:       "Application",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+			epoch:      0,
+			expected:   "0x00000001f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+		},
+		{
+			name:       "BeaconProposerGenesis",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			epoch:      0,
+			expected:   "0x00000000b5303f2a",
+		},
+		{
+			name:       "BeaconProposerAltair",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			epoch:      74240,
+			expected:   "0x00000000afcaaba0",
+		},
+		{
+			name:       "BeaconProposerBellatrix",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			epoch:      144896,
+			expected:   "0x000000004a26c58b",
+		},
+		{
+			name:       "BeaconProposerCapella",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			epoch:      194048,
+			expected:   "0x00000000bba4da96",
+		},
+		{
+			name:       "BeaconProposerDeneb",
+			domainType: phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+			epoch:      300000,
+			expected:   "0x000000006a95a1a9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := s.(client.DomainProvider).Domain(ctx, test.domainType, test.epoch)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(fmt.Sprintf("%#x", domain), test.expected))
+		})
+	}
+}
+
+func TestGenesisDomain(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := networks.New(ctx,
+		networks.WithLogLevel(zerolog.Disabled),
+		networks.WithNetwork("mainnet"),
+	)
+	require.NoError(t, err)
+
+	domain, err := s.(client.DomainProvider).GenesisDomain(ctx, phase0.DomainType{0x00, 0x00, 0x00, 0x01})
+	require.NoError(t, err)
+	require.Equal(t, "00000001f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// farFutureEpoch is the epoch used for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// Genesis provides the genesis information of the chain.
+func (s *Service) Genesis(_ context.Context) (*apiv1.Genesis, error) {
+	return s.genesis, nil
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Service) GenesisTime(_ context.Context) (time.Time, error) {
+	return s.genesis.GenesisTime, nil
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Service) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
+	return s.genesis.GenesisValidatorsRoot[:], nil
+}
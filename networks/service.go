@@ -0,0 +1,191 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks
+
+import (
+	"context"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	consensusclient "github.com/jefmcl/go-eth2-client"
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// files contains the configuration and preset files for the networks.
+//
+//go:embed configs presets
+var files embed.FS
+
+// network contains the information about a network that is not part of its configuration.
+type network struct {
+	preset                string
+	genesisTime           int64
+	genesisValidatorsRoot string
+}
+
+// networks contains the networks for which configurations are available.
+var networks = map[string]*network{
+	"mainnet": {
+		preset:                "mainnet",
+		genesisTime:           1606824023,
+		genesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	},
+	"sepolia": {
+		preset:                "mainnet",
+		genesisTime:           1655733600,
+		genesisValidatorsRoot: "0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078",
+	},
+	"holesky": {
+		preset:                "mainnet",
+		genesisTime:           1695902400,
+		genesisValidatorsRoot: "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+	},
+	"gnosis": {
+		preset:                "gnosis",
+		genesisTime:           1638993340,
+		genesisValidatorsRoot: "0xf5dcb5564e829aab27264b9becd5dfaa017085611224cb3036f573368dbb9d47",
+	},
+}
+
+// Networks returns the names of the networks for which configurations are available.
+func Networks() []string {
+	res := make([]string, 0, len(networks))
+	for name := range networks {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// Service is a consensus client service that provides information about a
+// network from built-in configuration, without connecting to a beacon node.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	network      string
+	chainSpec    *apiv1.ChainSpec
+	spec         map[string]interface{}
+	genesis      *apiv1.Genesis
+	forkSchedule []*phase0.Fork
+}
+
+// New creates a new built-in network service.
+func New(_ context.Context, params ...Parameter) (consensusclient.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "networks").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	chainSpec, err := loadChainSpec(parameters.network)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load chain spec")
+	}
+
+	genesisValidatorsRoot, err := hex.DecodeString(strings.TrimPrefix(networks[parameters.network].genesisValidatorsRoot, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+
+	s := &Service{
+		log:       log,
+		network:   parameters.network,
+		chainSpec: chainSpec,
+		spec:      apiv1.ParseSpec(chainSpec.Map()),
+		genesis: &apiv1.Genesis{
+			GenesisTime:        time.Unix(networks[parameters.network].genesisTime, 0),
+			GenesisForkVersion: chainSpec.GenesisForkVersion,
+		},
+		forkSchedule: forkSchedule(chainSpec),
+	}
+	copy(s.genesis.GenesisValidatorsRoot[:], genesisValidatorsRoot)
+	log.Trace().Str("network", s.network).Msg("Loaded network configuration")
+
+	return s, nil
+}
+
+// loadChainSpec loads the chain spec for the given network from its
+// configuration and preset files.
+func loadChainSpec(name string) (*apiv1.ChainSpec, error) {
+	config, err := files.ReadFile(fmt.Sprintf("configs/%s/config.yaml", name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read configuration")
+	}
+	preset, err := files.ReadFile(fmt.Sprintf("presets/%s.yaml", networks[name].preset))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read preset")
+	}
+
+	return apiv1.ChainSpecFromYAML(preset, config)
+}
+
+// forkSchedule calculates the fork schedule from a chain spec.
+// Forks that are not scheduled are not included.
+func forkSchedule(chainSpec *apiv1.ChainSpec) []*phase0.Fork {
+	forks := []struct {
+		version phase0.Version
+		epoch   phase0.Epoch
+	}{
+		{chainSpec.AltairForkVersion, chainSpec.AltairForkEpoch},
+		{chainSpec.BellatrixForkVersion, chainSpec.BellatrixForkEpoch},
+		{chainSpec.CapellaForkVersion, chainSpec.CapellaForkEpoch},
+		{chainSpec.DenebForkVersion, chainSpec.DenebForkEpoch},
+	}
+
+	res := []*phase0.Fork{
+		{
+			PreviousVersion: chainSpec.GenesisForkVersion,
+			CurrentVersion:  chainSpec.GenesisForkVersion,
+			Epoch:           0,
+		},
+	}
+	for _, fork := range forks {
+		if fork.epoch == farFutureEpoch {
+			break
+		}
+		res = append(res, &phase0.Fork{
+			PreviousVersion: res[len(res)-1].CurrentVersion,
+			CurrentVersion:  fork.version,
+			Epoch:           fork.epoch,
+		})
+	}
+
+	return res
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Networks (built-in)"
+}
+
+// Address provides the address for the connection.
+// As there is no connection this is the name of the network.
+func (s *Service) Address() string {
+	return s.network
+}
@@ -0,0 +1,104 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/jefmcl/go-eth2-client"
+	"github.com/jefmcl/go-eth2-client/networks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []networks.Parameter
+		err    string
+	}{
+		{
+			name: "NetworkMissing",
+			params: []networks.Parameter{
+				networks.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no network specified",
+		},
+		{
+			name: "NetworkUnknown",
+			params: []networks.Parameter{
+				networks.WithLogLevel(zerolog.Disabled),
+				networks.WithNetwork("unknown"),
+			},
+			err: "problem with parameters: unknown network unknown",
+		},
+		{
+			name: "Good",
+			params: []networks.Parameter{
+				networks.WithLogLevel(zerolog.Disabled),
+				networks.WithNetwork("mainnet"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := networks.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNetworks(t *testing.T) {
+	ctx := context.Background()
+
+	for _, network := range networks.Networks() {
+		t.Run(network, func(t *testing.T) {
+			s, err := networks.New(ctx,
+				networks.WithLogLevel(zerolog.Disabled),
+				networks.WithNetwork(network),
+			)
+			require.NoError(t, err)
+			require.Equal(t, network, s.Address())
+
+			chainSpec, err := s.(client.ChainSpecProvider).ChainSpec(ctx)
+			require.NoError(t, err)
+			require.Equal(t, network, chainSpec.ConfigName)
+			require.NoError(t, chainSpec.Validate())
+
+			spec, err := s.(client.SpecProvider).Spec(ctx)
+			require.NoError(t, err)
+			require.Equal(t, chainSpec.SlotsPerEpoch, spec["SLOTS_PER_EPOCH"])
+
+			genesis, err := s.(client.GenesisProvider).Genesis(ctx)
+			require.NoError(t, err)
+			require.Equal(t, chainSpec.GenesisForkVersion, genesis.GenesisForkVersion)
+
+			forkSchedule, err := s.(client.ForkScheduleProvider).ForkSchedule(ctx)
+			require.NoError(t, err)
+			require.Len(t, forkSchedule, 5)
+			for i := 1; i < len(forkSchedule); i++ {
+				require.Equal(t, forkSchedule[i-1].CurrentVersion, forkSchedule[i].PreviousVersion)
+				require.LessOrEqual(t, forkSchedule[i-1].Epoch, forkSchedule[i].Epoch)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
+)
+
+// Spec provides the spec information of the chain.
+// The values are typed in the same way as those returned by a beacon node.
+func (s *Service) Spec(_ context.Context) (map[string]interface{}, error) {
+	return s.spec, nil
+}
+
+// ChainSpec provides the spec information of the chain.
+func (s *Service) ChainSpec(_ context.Context) (*apiv1.ChainSpec, error) {
+	return s.chainSpec, nil
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Service) SlotDuration(_ context.Context) (time.Duration, error) {
+	return s.chainSpec.SecondsPerSlot, nil
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+func (s *Service) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return s.chainSpec.SlotsPerEpoch, nil
+}
+
+// TargetAggregatorsPerCommittee provides the target aggregators per committee of the chain.
+func (s *Service) TargetAggregatorsPerCommittee(_ context.Context) (uint64, error) {
+	return s.chainSpec.TargetAggregatorsPerCommittee, nil
+}
@@ -107,12 +107,40 @@ type DepositContractProvider interface {
 	DepositContract(ctx context.Context) (*apiv1.DepositContract, error)
 }
 
+// DepositSnapshotProvider is the interface for providing snapshots of the deposit tree.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+	// If the node does not have a snapshot available then nil is returned.
+	DepositSnapshot(ctx context.Context) (*apiv1.DepositSnapshot, error)
+}
+
 // SignedBeaconBlockProvider is the interface for providing beacon blocks.
 type SignedBeaconBlockProvider interface {
 	// SignedBeaconBlock fetches a signed beacon block given a block ID.
 	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
 }
 
+// SignedBlindedBeaconBlockProvider is the interface for providing blinded beacon blocks.
+type SignedBlindedBeaconBlockProvider interface {
+	// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+	// Blocks prior to bellatrix have no blinded form, and return api.ErrNotBlinded.
+	SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error)
+}
+
+// BeaconBlockAttestationsProvider is the interface for providing the attestations in beacon blocks.
+type BeaconBlockAttestationsProvider interface {
+	// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+	BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error)
+}
+
+// BeaconCommitteeSelectionsProvider is the interface for providing beacon committee selections.
+type BeaconCommitteeSelectionsProvider interface {
+	// BeaconCommitteeSelections submits partial beacon committee selection proofs to distributed validator
+	// middleware and returns the aggregated selection proofs.
+	// If not connected to distributed validator middleware the supplied selections are returned unaltered.
+	BeaconCommitteeSelections(ctx context.Context, selections []*apiv1.BeaconCommitteeSelection) ([]*apiv1.BeaconCommitteeSelection, error)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the epoch at the given state.
@@ -122,6 +150,20 @@ type BeaconCommitteesProvider interface {
 	BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error)
 }
 
+// BeaconCommitteesWithOptsProvider is the interface for providing beacon committees with options.
+type BeaconCommitteesWithOptsProvider interface {
+	// BeaconCommitteesWithOpts fetches the beacon committees matching the given options.
+	BeaconCommitteesWithOpts(ctx context.Context, opts *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error)
+}
+
+// SyncCommitteeSelectionsProvider is the interface for providing sync committee selections.
+type SyncCommitteeSelectionsProvider interface {
+	// SyncCommitteeSelections submits partial sync committee selection proofs to distributed validator
+	// middleware and returns the aggregated selection proofs.
+	// If not connected to distributed validator middleware the supplied selections are returned unaltered.
+	SyncCommitteeSelections(ctx context.Context, selections []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error)
+}
+
 // SyncCommitteesProvider is the interface for providing sync committees.
 type SyncCommitteesProvider interface {
 	// SyncCommittee fetches the sync committee for the given state.
@@ -162,12 +204,37 @@ type AttestationPoolProvider interface {
 	AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error)
 }
 
+// AttestationPoolWithOptsProvider is the interface for providing attestation pools with options.
+type AttestationPoolWithOptsProvider interface {
+	// AttestationPoolWithOpts fetches the attestations in the pool matching the given options.
+	AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error)
+}
+
 // AttestationsSubmitter is the interface for submitting attestations.
 type AttestationsSubmitter interface {
 	// SubmitAttestations submits attestations.
 	SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error
 }
 
+// AttesterSlashingPoolProvider is the interface for providing attester slashing pools.
+type AttesterSlashingPoolProvider interface {
+	// AttesterSlashingPool fetches the attester slashing pool.
+	AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error)
+}
+
+// AttesterSlashingSubmitter is the interface for submitting attester slashings.
+type AttesterSlashingSubmitter interface {
+	// SubmitAttesterSlashing submits an attester slashing.
+	SubmitAttesterSlashing(ctx context.Context, attesterSlashing *phase0.AttesterSlashing) error
+}
+
+// AttestationRewardsProvider is the interface for providing attestation rewards.
+type AttestationRewardsProvider interface {
+	// AttestationRewards provides the attestation rewards for the given epoch.
+	// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+	AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error)
+}
+
 // AttesterDutiesProvider is the interface for providing attester duties.
 type AttesterDutiesProvider interface {
 	// AttesterDuties obtains attester duties.
@@ -182,6 +249,14 @@ type SyncCommitteeDutiesProvider interface {
 	SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error)
 }
 
+// SyncCommitteeRewardsProvider is the interface for providing sync committee rewards.
+type SyncCommitteeRewardsProvider interface {
+	// SyncCommitteeRewards provides the sync committee rewards for the given block.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+	SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error)
+}
+
 // SyncCommitteeMessagesSubmitter is the interface for submitting sync committee messages.
 type SyncCommitteeMessagesSubmitter interface {
 	// SubmitSyncCommitteeMessages submits sync committee messages.
@@ -212,12 +287,25 @@ type BLSToExecutionChangesSubmitter interface {
 	SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error
 }
 
+// BLSToExecutionChangePoolProvider is the interface for providing BLS to execution address change pools.
+type BLSToExecutionChangePoolProvider interface {
+	// BLSToExecutionChangePool fetches the BLS to execution address change pool.
+	BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error)
+}
+
 // BeaconBlockHeadersProvider is the interface for providing beacon block headers.
 type BeaconBlockHeadersProvider interface {
 	// BeaconBlockHeader provides the block header of a given block ID.
 	BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error)
 }
 
+// BlockRewardsProvider is the interface for providing block rewards.
+type BlockRewardsProvider interface {
+	// BlockRewards provides the rewards paid to the proposer of the given block.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error)
+}
+
 // BeaconBlockProposalProvider is the interface for providing beacon block proposals.
 type BeaconBlockProposalProvider interface {
 	// BeaconBlockProposal fetches a proposed beacon block for signing.
@@ -254,6 +342,12 @@ type BeaconStateRandaoProvider interface {
 	BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error)
 }
 
+// BeaconStateRandaoWithOptsProvider is the interface for providing beacon state RANDAOs with options.
+type BeaconStateRandaoWithOptsProvider interface {
+	// BeaconStateRandaoWithOpts fetches a beacon state RANDAO matching the given options.
+	BeaconStateRandaoWithOpts(ctx context.Context, opts *api.BeaconStateRandaoOpts) (*phase0.Root, error)
+}
+
 // BeaconStateRootProvider is the interface for providing beacon state roots.
 type BeaconStateRootProvider interface {
 	// BeaconStateRoot fetches a beacon state root given a state ID.
@@ -278,18 +372,68 @@ type ValidatorRegistrationsSubmitter interface {
 	SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error
 }
 
+// ChainHeadsProvider is the interface for providing the chain heads known to the node.
+type ChainHeadsProvider interface {
+	// ChainHeads provides the heads of all chains known to the node, including
+	// heads of branches that are not canonical.
+	ChainHeads(ctx context.Context) ([]*apiv1.ChainHead, error)
+}
+
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events feeds requested events with the given topics to the supplied handler.
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// ExpectedWithdrawalsProvider is the interface for providing expected withdrawals.
+type ExpectedWithdrawalsProvider interface {
+	// ExpectedWithdrawals provides the withdrawals that will be included in the next
+	// block built on the given state.
+	// proposalSlot is optional; if 0 then the slot after the state is used.
+	ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error)
+}
+
+// FilteredBeaconBlockHeadersProvider is the interface for providing beacon block headers matching filters.
+type FilteredBeaconBlockHeadersProvider interface {
+	// BeaconBlockHeaders provides the block headers matching the given filters, including
+	// headers of blocks that are not canonical.
+	// slot and parentRoot are optional filters; if both are nil then the header of the
+	// canonical head is returned.
+	BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error)
+}
+
+// FilteredValidatorsProvider is the interface for providing validator information filtered by state.
+type FilteredValidatorsProvider interface {
+	// FilteredValidators provides the validators, with their balance and status, for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices and validatorPubKeys restrict the returned values to the given validators; if neither is supplied
+	// no validator filter will be applied.
+	// validatorStates restricts the returned values to validators in the given states; if none are supplied no state
+	// filter will be applied.  Group names such as "pending" or "active" are not supported; to filter by a group supply
+	// each of its states, for example all states for which IsPending returns true.
+	FilteredValidators(ctx context.Context,
+		stateID string,
+		validatorIndices []phase0.ValidatorIndex,
+		validatorPubKeys []phase0.BLSPubKey,
+		validatorStates []apiv1.ValidatorState,
+	) (
+		map[phase0.ValidatorIndex]*apiv1.Validator,
+		error,
+	)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
 	Finality(ctx context.Context, stateID string) (*apiv1.Finality, error)
 }
 
+// ForkChoiceProvider is the interface for providing the fork choice store of the node.
+type ForkChoiceProvider interface {
+	// ForkChoice provides a dump of the fork choice store of the node.
+	ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error)
+}
+
 // ForkProvider is the interface for providing fork information.
 type ForkProvider interface {
 	// Fork fetches fork information for the given state.
@@ -308,6 +452,77 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context) (*apiv1.Genesis, error)
 }
 
+// HistoricalRootsProvider is the interface for providing historical roots.
+type HistoricalRootsProvider interface {
+	// HistoricalRoots fetches the historical roots of the given state.
+	// Historical roots are frozen from Capella onwards; see HistoricalSummariesProvider.
+	// Beacon nodes do not serve historical roots directly, so implementations may
+	// need to download the full state, which can be hundreds of megabytes.
+	HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error)
+}
+
+// HistoricalSummariesProvider is the interface for providing historical summaries.
+type HistoricalSummariesProvider interface {
+	// HistoricalSummaries fetches the historical summaries of the given state.
+	// Few beacon nodes serve historical summaries directly, so implementations may
+	// need to download the full state, which can be hundreds of megabytes.
+	HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error)
+}
+
+// LightClientBootstrapProvider is the interface for providing light client bootstraps.
+type LightClientBootstrapProvider interface {
+	// LightClientBootstrap fetches the light client bootstrap for the given block root.
+	LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error)
+}
+
+// LightClientUpdatesProvider is the interface for providing light client updates.
+type LightClientUpdatesProvider interface {
+	// LightClientUpdates fetches light client updates for count sync committee
+	// periods, starting with the given period.
+	LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error)
+}
+
+// LightClientFinalityUpdateProvider is the interface for providing light client finality updates.
+type LightClientFinalityUpdateProvider interface {
+	// LightClientFinalityUpdate fetches the latest light client finality update.
+	LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error)
+}
+
+// LightClientOptimisticUpdateProvider is the interface for providing light client optimistic updates.
+type LightClientOptimisticUpdateProvider interface {
+	// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+	LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error)
+}
+
+// NodeHealthProvider is the interface for providing the health of the node.
+type NodeHealthProvider interface {
+	// NodeHealth provides the health of the node.
+	NodeHealth(ctx context.Context) (apiv1.NodeHealth, error)
+}
+
+// NodeIdentityProvider is the interface for providing the network identity of the node.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the network identity of the node.
+	NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error)
+}
+
+// NodePeersProvider is the interface for providing information about the peers of the node.
+type NodePeersProvider interface {
+	// NodePeers provides the peers of the node.
+	// states and directions are optional filters; if empty then peers in all states and directions are returned.
+	NodePeers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error)
+
+	// NodePeer provides a single peer of the node.
+	// If the peer is not known to the node then nil is returned.
+	NodePeer(ctx context.Context, peerID string) (*apiv1.Peer, error)
+}
+
+// NodePeerCountProvider is the interface for providing the number of peers of the node.
+type NodePeerCountProvider interface {
+	// NodePeerCount provides the number of peers of the node in each state.
+	NodePeerCount(ctx context.Context) (*apiv1.PeerCount, error)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
@@ -321,6 +536,18 @@ type ProposalPreparationsSubmitter interface {
 	SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error
 }
 
+// ProposerSlashingPoolProvider is the interface for providing proposer slashing pools.
+type ProposerSlashingPoolProvider interface {
+	// ProposerSlashingPool fetches the proposer slashing pool.
+	ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error)
+}
+
+// ProposerSlashingSubmitter is the interface for submitting proposer slashings.
+type ProposerSlashingSubmitter interface {
+	// SubmitProposerSlashing submits a proposer slashing.
+	SubmitProposerSlashing(ctx context.Context, proposerSlashing *phase0.ProposerSlashing) error
+}
+
 // ProposerDutiesProvider is the interface for providing proposer duties.
 type ProposerDutiesProvider interface {
 	// ProposerDuties obtains proposer duties for the given epoch.
@@ -334,6 +561,12 @@ type SpecProvider interface {
 	Spec(ctx context.Context) (map[string]interface{}, error)
 }
 
+// ChainSpecProvider is the interface for providing typed spec data.
+type ChainSpecProvider interface {
+	// ChainSpec provides the spec information of the chain.
+	ChainSpec(ctx context.Context) (*apiv1.ChainSpec, error)
+}
+
 // SyncStateProvider is the interface for providing synchronization state.
 type SyncStateProvider interface {
 	// SyncState provides the state of the node's synchronization with the chain.
@@ -349,6 +582,12 @@ type ValidatorBalancesProvider interface {
 	ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error)
 }
 
+// ValidatorLivenessProvider is the interface for providing validator liveness.
+type ValidatorLivenessProvider interface {
+	// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+	ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for a given state.
@@ -370,6 +609,12 @@ type VoluntaryExitSubmitter interface {
 	SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error
 }
 
+// VoluntaryExitPoolProvider is the interface for providing voluntary exit pools.
+type VoluntaryExitPoolProvider interface {
+	// VoluntaryExitPool fetches the voluntary exit pool.
+	VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error)
+}
+
 //
 // Local extensions
 //
@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientBootstrap is the data required to bootstrap a light client from a trusted block.
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader
+	CurrentSyncCommittee       *SyncCommittee
+	CurrentSyncCommitteeBranch []phase0.Root `ssz-size:"5,32"`
+}
+
+// lightClientBootstrapJSON is the spec representation of the struct.
+type lightClientBootstrapJSON struct {
+	Header                     *LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string           `json:"current_sync_committee_branch"`
+}
+
+// lightClientBootstrapYAML is the spec representation of the struct.
+type lightClientBootstrapYAML struct {
+	Header                     *LightClientHeader `yaml:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `yaml:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string           `yaml:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientBootstrap) MarshalJSON() ([]byte, error) {
+	currentSyncCommitteeBranch := make([]string, len(l.CurrentSyncCommitteeBranch))
+	for i := range l.CurrentSyncCommitteeBranch {
+		currentSyncCommitteeBranch[i] = l.CurrentSyncCommitteeBranch[i].String()
+	}
+
+	return json.Marshal(&lightClientBootstrapJSON{
+		Header:                     l.Header,
+		CurrentSyncCommittee:       l.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientBootstrap) UnmarshalJSON(input []byte) error {
+	var lightClientBootstrapJSON lightClientBootstrapJSON
+	if err := json.Unmarshal(input, &lightClientBootstrapJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&lightClientBootstrapJSON)
+}
+
+func (l *LightClientBootstrap) unpack(lightClientBootstrapJSON *lightClientBootstrapJSON) error {
+	if lightClientBootstrapJSON.Header == nil {
+		return errors.New("header missing")
+	}
+	l.Header = lightClientBootstrapJSON.Header
+
+	if lightClientBootstrapJSON.CurrentSyncCommittee == nil {
+		return errors.New("current sync committee missing")
+	}
+	l.CurrentSyncCommittee = lightClientBootstrapJSON.CurrentSyncCommittee
+
+	if len(lightClientBootstrapJSON.CurrentSyncCommitteeBranch) == 0 {
+		return errors.New("current sync committee branch missing")
+	}
+	if len(lightClientBootstrapJSON.CurrentSyncCommitteeBranch) != 5 {
+		return errors.New("incorrect length for current sync committee branch")
+	}
+	l.CurrentSyncCommitteeBranch = make([]phase0.Root, len(lightClientBootstrapJSON.CurrentSyncCommitteeBranch))
+	for i := range lightClientBootstrapJSON.CurrentSyncCommitteeBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(lightClientBootstrapJSON.CurrentSyncCommitteeBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for current sync committee branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for current sync committee branch root")
+		}
+		copy(l.CurrentSyncCommitteeBranch[i][:], root)
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientBootstrap) MarshalYAML() ([]byte, error) {
+	currentSyncCommitteeBranch := make([]string, len(l.CurrentSyncCommitteeBranch))
+	for i := range l.CurrentSyncCommitteeBranch {
+		currentSyncCommitteeBranch[i] = l.CurrentSyncCommitteeBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientBootstrapYAML{
+		Header:                     l.Header,
+		CurrentSyncCommittee:       l.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientBootstrap) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var lightClientBootstrapJSON lightClientBootstrapJSON
+	if err := yaml.Unmarshal(input, &lightClientBootstrapJSON); err != nil {
+		return err
+	}
+	return l.unpack(&lightClientBootstrapJSON)
+}
+
+// String returns a string version of the structure.
+func (l *LightClientBootstrap) String() string {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientHeader is the header of a block as seen by a light client.
+type LightClientHeader struct {
+	Beacon *phase0.BeaconBlockHeader
+}
+
+// lightClientHeaderJSON is the spec representation of the struct.
+type lightClientHeaderJSON struct {
+	Beacon *phase0.BeaconBlockHeader `json:"beacon"`
+}
+
+// lightClientHeaderYAML is the spec representation of the struct.
+type lightClientHeaderYAML struct {
+	Beacon *phase0.BeaconBlockHeader `yaml:"beacon"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientHeaderJSON{
+		Beacon: l.Beacon,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientHeader) UnmarshalJSON(input []byte) error {
+	var lightClientHeaderJSON lightClientHeaderJSON
+	if err := json.Unmarshal(input, &lightClientHeaderJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&lightClientHeaderJSON)
+}
+
+func (l *LightClientHeader) unpack(lightClientHeaderJSON *lightClientHeaderJSON) error {
+	if lightClientHeaderJSON.Beacon == nil {
+		return errors.New("beacon missing")
+	}
+	l.Beacon = lightClientHeaderJSON.Beacon
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientHeader) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientHeaderYAML{
+		Beacon: l.Beacon,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientHeader) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var lightClientHeaderJSON lightClientHeaderJSON
+	if err := yaml.Unmarshal(input, &lightClientHeaderJSON); err != nil {
+		return err
+	}
+	return l.unpack(&lightClientHeaderJSON)
+}
+
+// String returns a string version of the structure.
+func (l *LightClientHeader) String() string {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,207 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientUpdate is an update that allows a light client to follow the sync committee.
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader
+	NextSyncCommittee       *SyncCommittee
+	NextSyncCommitteeBranch []phase0.Root `ssz-size:"5,32"`
+	FinalizedHeader         *LightClientHeader
+	FinalityBranch          []phase0.Root `ssz-size:"6,32"`
+	SyncAggregate           *SyncAggregate
+	SignatureSlot           phase0.Slot
+}
+
+// lightClientUpdateJSON is the spec representation of the struct.
+type lightClientUpdateJSON struct {
+	AttestedHeader          *LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string           `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []string           `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           string             `json:"signature_slot"`
+}
+
+// lightClientUpdateYAML is the spec representation of the struct.
+type lightClientUpdateYAML struct {
+	AttestedHeader          *LightClientHeader `yaml:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `yaml:"next_sync_committee"`
+	NextSyncCommitteeBranch []string           `yaml:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader `yaml:"finalized_header"`
+	FinalityBranch          []string           `yaml:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `yaml:"sync_aggregate"`
+	SignatureSlot           uint64             `yaml:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	nextSyncCommitteeBranch := make([]string, len(l.NextSyncCommitteeBranch))
+	for i := range l.NextSyncCommitteeBranch {
+		nextSyncCommitteeBranch[i] = l.NextSyncCommitteeBranch[i].String()
+	}
+
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = l.FinalityBranch[i].String()
+	}
+
+	return json.Marshal(&lightClientUpdateJSON{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           fmt.Sprintf("%d", l.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalJSON(input []byte) error {
+	var lightClientUpdateJSON lightClientUpdateJSON
+	if err := json.Unmarshal(input, &lightClientUpdateJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&lightClientUpdateJSON)
+}
+
+func (l *LightClientUpdate) unpack(lightClientUpdateJSON *lightClientUpdateJSON) error {
+	if lightClientUpdateJSON.AttestedHeader == nil {
+		return errors.New("attested header missing")
+	}
+	l.AttestedHeader = lightClientUpdateJSON.AttestedHeader
+
+	if lightClientUpdateJSON.NextSyncCommittee == nil {
+		return errors.New("next sync committee missing")
+	}
+	l.NextSyncCommittee = lightClientUpdateJSON.NextSyncCommittee
+
+	if len(lightClientUpdateJSON.NextSyncCommitteeBranch) == 0 {
+		return errors.New("next sync committee branch missing")
+	}
+	if len(lightClientUpdateJSON.NextSyncCommitteeBranch) != 5 {
+		return errors.New("incorrect length for next sync committee branch")
+	}
+	l.NextSyncCommitteeBranch = make([]phase0.Root, len(lightClientUpdateJSON.NextSyncCommitteeBranch))
+	for i := range lightClientUpdateJSON.NextSyncCommitteeBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(lightClientUpdateJSON.NextSyncCommitteeBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for next sync committee branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for next sync committee branch root")
+		}
+		copy(l.NextSyncCommitteeBranch[i][:], root)
+	}
+
+	if lightClientUpdateJSON.FinalizedHeader == nil {
+		return errors.New("finalized header missing")
+	}
+	l.FinalizedHeader = lightClientUpdateJSON.FinalizedHeader
+
+	if len(lightClientUpdateJSON.FinalityBranch) == 0 {
+		return errors.New("finality branch missing")
+	}
+	if len(lightClientUpdateJSON.FinalityBranch) != 6 {
+		return errors.New("incorrect length for finality branch")
+	}
+	l.FinalityBranch = make([]phase0.Root, len(lightClientUpdateJSON.FinalityBranch))
+	for i := range lightClientUpdateJSON.FinalityBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(lightClientUpdateJSON.FinalityBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for finality branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for finality branch root")
+		}
+		copy(l.FinalityBranch[i][:], root)
+	}
+
+	if lightClientUpdateJSON.SyncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	l.SyncAggregate = lightClientUpdateJSON.SyncAggregate
+
+	if lightClientUpdateJSON.SignatureSlot == "" {
+		return errors.New("signature slot missing")
+	}
+	signatureSlot, err := strconv.ParseUint(lightClientUpdateJSON.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature slot")
+	}
+	l.SignatureSlot = phase0.Slot(signatureSlot)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientUpdate) MarshalYAML() ([]byte, error) {
+	nextSyncCommitteeBranch := make([]string, len(l.NextSyncCommitteeBranch))
+	for i := range l.NextSyncCommitteeBranch {
+		nextSyncCommitteeBranch[i] = l.NextSyncCommitteeBranch[i].String()
+	}
+
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = l.FinalityBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientUpdateYAML{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           uint64(l.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var lightClientUpdateJSON lightClientUpdateJSON
+	if err := yaml.Unmarshal(input, &lightClientUpdateJSON); err != nil {
+		return err
+	}
+	return l.unpack(&lightClientUpdateJSON)
+}
+
+// String returns a string version of the structure.
+func (l *LightClientUpdate) String() string {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
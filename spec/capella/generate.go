@@ -14,6 +14,6 @@
 package capella
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
-//go:generate rm -f blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal.go
-//go:generate sszgen --path . --objs BLSToExecutionChange SignedBLSToExecutionChange Withdrawal
-//go:generate goimports -w blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal_encoding.go
+//go:generate rm -f beaconblock_ssz.go beaconblockbody_ssz.go beaconstate_ssz.go blstoexecutionchange_ssz.go executionpayload_ssz.go executionpayloadheader_ssz.go historicalsummary_ssz.go lightclientbootstrap_ssz.go lightclientfinalityupdate_ssz.go lightclientheader_ssz.go lightclientoptimisticupdate_ssz.go lightclientupdate_ssz.go signedbeaconblock_ssz.go signedblstoexecutionchange_ssz.go withdrawal_ssz.go
+//go:generate sszgen --suffix=ssz --path . --include ../phase0,../altair,../bellatrix --objs BeaconBlock,BeaconBlockBody,BeaconState,BLSToExecutionChange,ExecutionPayload,ExecutionPayloadHeader,HistoricalSummary,LightClientBootstrap,LightClientFinalityUpdate,LightClientHeader,LightClientOptimisticUpdate,LightClientUpdate,SignedBeaconBlock,SignedBLSToExecutionChange,Withdrawal
+//go:generate goimports -w beaconblock_ssz.go beaconblockbody_ssz.go beaconstate_ssz.go blstoexecutionchange_ssz.go executionpayload_ssz.go executionpayloadheader_ssz.go historicalsummary_ssz.go lightclientbootstrap_ssz.go lightclientfinalityupdate_ssz.go lightclientheader_ssz.go lightclientoptimisticupdate_ssz.go lightclientupdate_ssz.go signedbeaconblock_ssz.go signedblstoexecutionchange_ssz.go withdrawal_ssz.go
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientBootstrapJSON is the spec representation of the struct.
+type lightClientBootstrapJSON struct {
+	Header                     *LightClientHeader    `json:"header"`
+	CurrentSyncCommittee       *altair.SyncCommittee `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string              `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientBootstrap) MarshalJSON() ([]byte, error) {
+	currentSyncCommitteeBranch := make([]string, len(l.CurrentSyncCommitteeBranch))
+	for i := range l.CurrentSyncCommitteeBranch {
+		currentSyncCommitteeBranch[i] = l.CurrentSyncCommitteeBranch[i].String()
+	}
+
+	return json.Marshal(&lightClientBootstrapJSON{
+		Header:                     l.Header,
+		CurrentSyncCommittee:       l.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientBootstrap) UnmarshalJSON(input []byte) error {
+	var data lightClientBootstrapJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&data)
+}
+
+func (l *LightClientBootstrap) unpack(data *lightClientBootstrapJSON) error {
+	if data.Header == nil {
+		return errors.New("header missing")
+	}
+	l.Header = data.Header
+
+	if data.CurrentSyncCommittee == nil {
+		return errors.New("current sync committee missing")
+	}
+	l.CurrentSyncCommittee = data.CurrentSyncCommittee
+
+	if len(data.CurrentSyncCommitteeBranch) == 0 {
+		return errors.New("current sync committee branch missing")
+	}
+	if len(data.CurrentSyncCommitteeBranch) != 5 {
+		return errors.New("incorrect length for current sync committee branch")
+	}
+	l.CurrentSyncCommitteeBranch = make([]phase0.Root, len(data.CurrentSyncCommitteeBranch))
+	for i := range data.CurrentSyncCommitteeBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(data.CurrentSyncCommitteeBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for current sync committee branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for current sync committee branch root")
+		}
+		copy(l.CurrentSyncCommitteeBranch[i][:], root)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"bytes"
+
+	"github.com/goccy/go-yaml"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+)
+
+// lightClientBootstrapYAML is the spec representation of the struct.
+type lightClientBootstrapYAML struct {
+	Header                     *LightClientHeader    `yaml:"header"`
+	CurrentSyncCommittee       *altair.SyncCommittee `yaml:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string              `yaml:"current_sync_committee_branch"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientBootstrap) MarshalYAML() ([]byte, error) {
+	currentSyncCommitteeBranch := make([]string, len(l.CurrentSyncCommitteeBranch))
+	for i := range l.CurrentSyncCommitteeBranch {
+		currentSyncCommitteeBranch[i] = l.CurrentSyncCommitteeBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientBootstrapYAML{
+		Header:                     l.Header,
+		CurrentSyncCommittee:       l.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientBootstrap) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data lightClientBootstrapJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return err
+	}
+	return l.unpack(&data)
+}
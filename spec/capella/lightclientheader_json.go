@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientHeaderJSON is the spec representation of the struct.
+type lightClientHeaderJSON struct {
+	Beacon          *phase0.BeaconBlockHeader `json:"beacon"`
+	Execution       *ExecutionPayloadHeader   `json:"execution"`
+	ExecutionBranch []string                  `json:"execution_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientHeader) MarshalJSON() ([]byte, error) {
+	executionBranch := make([]string, len(l.ExecutionBranch))
+	for i := range l.ExecutionBranch {
+		executionBranch[i] = l.ExecutionBranch[i].String()
+	}
+
+	return json.Marshal(&lightClientHeaderJSON{
+		Beacon:          l.Beacon,
+		Execution:       l.Execution,
+		ExecutionBranch: executionBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientHeader) UnmarshalJSON(input []byte) error {
+	var data lightClientHeaderJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&data)
+}
+
+func (l *LightClientHeader) unpack(data *lightClientHeaderJSON) error {
+	if data.Beacon == nil {
+		return errors.New("beacon missing")
+	}
+	l.Beacon = data.Beacon
+
+	if data.Execution == nil {
+		return errors.New("execution missing")
+	}
+	l.Execution = data.Execution
+
+	if len(data.ExecutionBranch) == 0 {
+		return errors.New("execution branch missing")
+	}
+	if len(data.ExecutionBranch) != 4 {
+		return errors.New("incorrect length for execution branch")
+	}
+	l.ExecutionBranch = make([]phase0.Root, len(data.ExecutionBranch))
+	for i := range data.ExecutionBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(data.ExecutionBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for execution branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for execution branch root")
+		}
+		copy(l.ExecutionBranch[i][:], root)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientUpdateJSON is the spec representation of the struct.
+type lightClientUpdateJSON struct {
+	AttestedHeader          *LightClientHeader    `json:"attested_header"`
+	NextSyncCommittee       *altair.SyncCommittee `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string              `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader    `json:"finalized_header"`
+	FinalityBranch          []string              `json:"finality_branch"`
+	SyncAggregate           *altair.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot           string                `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	nextSyncCommitteeBranch := make([]string, len(l.NextSyncCommitteeBranch))
+	for i := range l.NextSyncCommitteeBranch {
+		nextSyncCommitteeBranch[i] = l.NextSyncCommitteeBranch[i].String()
+	}
+
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = l.FinalityBranch[i].String()
+	}
+
+	return json.Marshal(&lightClientUpdateJSON{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           fmt.Sprintf("%d", l.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalJSON(input []byte) error {
+	var data lightClientUpdateJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&data)
+}
+
+func (l *LightClientUpdate) unpack(data *lightClientUpdateJSON) error {
+	if data.AttestedHeader == nil {
+		return errors.New("attested header missing")
+	}
+	l.AttestedHeader = data.AttestedHeader
+
+	if data.NextSyncCommittee == nil {
+		return errors.New("next sync committee missing")
+	}
+	l.NextSyncCommittee = data.NextSyncCommittee
+
+	if len(data.NextSyncCommitteeBranch) == 0 {
+		return errors.New("next sync committee branch missing")
+	}
+	if len(data.NextSyncCommitteeBranch) != 5 {
+		return errors.New("incorrect length for next sync committee branch")
+	}
+	l.NextSyncCommitteeBranch = make([]phase0.Root, len(data.NextSyncCommitteeBranch))
+	for i := range data.NextSyncCommitteeBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(data.NextSyncCommitteeBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for next sync committee branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for next sync committee branch root")
+		}
+		copy(l.NextSyncCommitteeBranch[i][:], root)
+	}
+
+	if data.FinalizedHeader == nil {
+		return errors.New("finalized header missing")
+	}
+	l.FinalizedHeader = data.FinalizedHeader
+
+	if len(data.FinalityBranch) == 0 {
+		return errors.New("finality branch missing")
+	}
+	if len(data.FinalityBranch) != 6 {
+		return errors.New("incorrect length for finality branch")
+	}
+	l.FinalityBranch = make([]phase0.Root, len(data.FinalityBranch))
+	for i := range data.FinalityBranch {
+		root, err := hex.DecodeString(strings.TrimPrefix(data.FinalityBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for finality branch")
+		}
+		if len(root) != phase0.RootLength {
+			return errors.New("incorrect length for finality branch root")
+		}
+		copy(l.FinalityBranch[i][:], root)
+	}
+
+	if data.SyncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	l.SyncAggregate = data.SyncAggregate
+
+	if data.SignatureSlot == "" {
+		return errors.New("signature slot missing")
+	}
+	signatureSlot, err := strconv.ParseUint(data.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature slot")
+	}
+	l.SignatureSlot = phase0.Slot(signatureSlot)
+
+	return nil
+}
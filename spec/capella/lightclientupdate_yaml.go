@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"bytes"
+
+	"github.com/goccy/go-yaml"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+)
+
+// lightClientUpdateYAML is the spec representation of the struct.
+type lightClientUpdateYAML struct {
+	AttestedHeader          *LightClientHeader    `yaml:"attested_header"`
+	NextSyncCommittee       *altair.SyncCommittee `yaml:"next_sync_committee"`
+	NextSyncCommitteeBranch []string              `yaml:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader    `yaml:"finalized_header"`
+	FinalityBranch          []string              `yaml:"finality_branch"`
+	SyncAggregate           *altair.SyncAggregate `yaml:"sync_aggregate"`
+	SignatureSlot           uint64                `yaml:"signature_slot"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientUpdate) MarshalYAML() ([]byte, error) {
+	nextSyncCommitteeBranch := make([]string, len(l.NextSyncCommitteeBranch))
+	for i := range l.NextSyncCommitteeBranch {
+		nextSyncCommitteeBranch[i] = l.NextSyncCommitteeBranch[i].String()
+	}
+
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = l.FinalityBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientUpdateYAML{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           uint64(l.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data lightClientUpdateJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return err
+	}
+	return l.unpack(&data)
+}
@@ -71,6 +71,26 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 	}
 }
 
+// NextWithdrawalIndex returns the next withdrawal index of the state.
+func (v *VersionedBeaconState) NextWithdrawalIndex() (capella.WithdrawalIndex, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
+		return 0, errors.New("state does not provide next withdrawal index")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no Capella state")
+		}
+		return v.Capella.NextWithdrawalIndex, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no Deneb state")
+		}
+		return v.Deneb.NextWithdrawalIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
 // NextWithdrawalValidatorIndex returns the next withdrawal validator index of the state.
 func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
@@ -157,6 +177,125 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 	}
 }
 
+// BlockRoots returns the recent block roots of the state.
+func (v *VersionedBeaconState) BlockRoots() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.BlockRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.BlockRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.BlockRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.BlockRoots, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.BlockRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// StateRoots returns the recent state roots of the state.
+func (v *VersionedBeaconState) StateRoots() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.StateRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.StateRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.StateRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.StateRoots, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.StateRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// HistoricalRoots returns the historical roots of the state.
+func (v *VersionedBeaconState) HistoricalRoots() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.HistoricalRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.HistoricalRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.HistoricalRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.HistoricalRoots, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.HistoricalRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// HistoricalSummaries returns the historical summaries of the state.
+func (v *VersionedBeaconState) HistoricalSummaries() ([]*capella.HistoricalSummary, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
+		return nil, errors.New("state does not provide historical summaries")
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.HistoricalSummaries, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.HistoricalSummaries, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconState) String() string {
 	switch v.Version {
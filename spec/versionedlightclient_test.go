@@ -0,0 +1,161 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedLightClientBootstrapHeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		bootstrap *spec.VersionedLightClientBootstrap
+		err       string
+	}{
+		{
+			name:      "AltairMissing",
+			bootstrap: &spec.VersionedLightClientBootstrap{Version: spec.DataVersionAltair},
+			err:       "no altair light client bootstrap",
+		},
+		{
+			name: "AltairHeaderMissing",
+			bootstrap: &spec.VersionedLightClientBootstrap{
+				Version: spec.DataVersionAltair,
+				Altair:  &altair.LightClientBootstrap{},
+			},
+			err: "no altair light client bootstrap",
+		},
+		{
+			name: "CapellaBeaconMissing",
+			bootstrap: &spec.VersionedLightClientBootstrap{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.LightClientBootstrap{Header: &capella.LightClientHeader{}},
+			},
+			err: "no capella light client bootstrap",
+		},
+		{
+			name: "DenebHeaderMissing",
+			bootstrap: &spec.VersionedLightClientBootstrap{
+				Version: spec.DataVersionDeneb,
+				Deneb:   &deneb.LightClientBootstrap{},
+			},
+			err: "no deneb light client bootstrap",
+		},
+		{
+			name: "Good",
+			bootstrap: &spec.VersionedLightClientBootstrap{
+				Version: spec.DataVersionBellatrix,
+				Altair: &altair.LightClientBootstrap{
+					Header: &altair.LightClientHeader{Beacon: &phase0.BeaconBlockHeader{Slot: 1}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header, err := test.bootstrap.Header()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(1), header.Slot)
+			}
+		})
+	}
+}
+
+func TestVersionedLightClientUpdateHeaders(t *testing.T) {
+	tests := []struct {
+		name               string
+		update             *spec.VersionedLightClientUpdate
+		attestedHeaderErr  string
+		finalizedHeaderErr string
+	}{
+		{
+			name:               "AltairHeadersMissing",
+			update:             &spec.VersionedLightClientUpdate{Version: spec.DataVersionAltair, Altair: &altair.LightClientUpdate{}},
+			attestedHeaderErr:  "no altair light client update",
+			finalizedHeaderErr: "no altair light client update",
+		},
+		{
+			name: "CapellaFinalizedBeaconMissing",
+			update: &spec.VersionedLightClientUpdate{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.LightClientUpdate{
+					AttestedHeader:  &capella.LightClientHeader{Beacon: &phase0.BeaconBlockHeader{}},
+					FinalizedHeader: &capella.LightClientHeader{},
+				},
+			},
+			finalizedHeaderErr: "no capella light client update",
+		},
+		{
+			name: "DenebAttestedBeaconMissing",
+			update: &spec.VersionedLightClientUpdate{
+				Version: spec.DataVersionDeneb,
+				Deneb: &deneb.LightClientUpdate{
+					AttestedHeader:  &deneb.LightClientHeader{},
+					FinalizedHeader: &deneb.LightClientHeader{Beacon: &phase0.BeaconBlockHeader{}},
+				},
+			},
+			attestedHeaderErr: "no deneb light client update",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.update.AttestedHeader()
+			if test.attestedHeaderErr != "" {
+				require.EqualError(t, err, test.attestedHeaderErr)
+			} else {
+				require.NoError(t, err)
+			}
+			_, err = test.update.FinalizedHeader()
+			if test.finalizedHeaderErr != "" {
+				require.EqualError(t, err, test.finalizedHeaderErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVersionedLightClientFinalityUpdateHeaders(t *testing.T) {
+	update := &spec.VersionedLightClientFinalityUpdate{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.LightClientFinalityUpdate{
+			AttestedHeader: &capella.LightClientHeader{},
+		},
+	}
+	_, err := update.AttestedHeader()
+	require.EqualError(t, err, "no capella light client finality update")
+	_, err = update.FinalizedHeader()
+	require.EqualError(t, err, "no capella light client finality update")
+}
+
+func TestVersionedLightClientOptimisticUpdateHeaders(t *testing.T) {
+	update := &spec.VersionedLightClientOptimisticUpdate{
+		Version: spec.DataVersionAltair,
+		Altair:  &altair.LightClientOptimisticUpdate{},
+	}
+	_, err := update.AttestedHeader()
+	require.EqualError(t, err, "no altair light client optimistic update")
+}
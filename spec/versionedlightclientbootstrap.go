@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/deneb"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientBootstrap contains a versioned light client bootstrap.
+// Light client data is unchanged between Altair and Bellatrix, so Bellatrix
+// data is held in the Altair field.
+type VersionedLightClientBootstrap struct {
+	Version DataVersion
+	Altair  *altair.LightClientBootstrap
+	Capella *capella.LightClientBootstrap
+	Deneb   *deneb.LightClientBootstrap
+}
+
+// IsEmpty returns true if there is no light client bootstrap.
+func (v *VersionedLightClientBootstrap) IsEmpty() bool {
+	return v.Altair == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// Header returns the beacon block header of the trusted block.
+func (v *VersionedLightClientBootstrap) Header() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionAltair, DataVersionBellatrix:
+		if v.Altair == nil || v.Altair.Header == nil || v.Altair.Header.Beacon == nil {
+			return nil, errors.New("no altair light client bootstrap")
+		}
+		return v.Altair.Header.Beacon, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Header == nil || v.Capella.Header.Beacon == nil {
+			return nil, errors.New("no capella light client bootstrap")
+		}
+		return v.Capella.Header.Beacon, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Header == nil || v.Deneb.Header.Beacon == nil {
+			return nil, errors.New("no deneb light client bootstrap")
+		}
+		return v.Deneb.Header.Beacon, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientBootstrap) String() string {
+	switch v.Version {
+	case DataVersionAltair, DataVersionBellatrix:
+		if v.Altair == nil {
+			return ""
+		}
+		return v.Altair.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	default:
+		return "unknown version"
+	}
+}
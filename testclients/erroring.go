@@ -25,6 +25,7 @@ import (
 	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/jefmcl/go-eth2-client/spec"
 	"github.com/jefmcl/go-eth2-client/spec/altair"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 )
 
@@ -221,6 +222,18 @@ func (s *Erroring) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*ph
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolWithOpts fetches the attestations in the pool matching the given options.
+func (s *Erroring) AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationPoolWithOptsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationPoolWithOpts(ctx, opts)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -294,6 +307,18 @@ func (s *Erroring) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv
 	return next.BeaconBlockHeader(ctx, blockID)
 }
 
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Erroring) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.FilteredBeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockHeaders(ctx, slot, parentRoot)
+}
+
 // BeaconBlockRoot fetches a block's root given a block ID.
 func (s *Erroring) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -306,6 +331,18 @@ func (s *Erroring) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0
 	return next.BeaconBlockRoot(ctx, blockID)
 }
 
+// BeaconCommitteeSelections submits partial beacon committee selection proofs and returns the aggregated selection proofs.
+func (s *Erroring) BeaconCommitteeSelections(ctx context.Context, selections []*apiv1.BeaconCommitteeSelection) ([]*apiv1.BeaconCommitteeSelection, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconCommitteeSelections(ctx, selections)
+}
+
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
 func (s *Erroring) BeaconCommittees(ctx context.Context, stateID string) ([]*apiv1.BeaconCommittee, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -330,6 +367,18 @@ func (s *Erroring) BeaconCommitteesAtEpoch(ctx context.Context, stateID string,
 	return next.BeaconCommitteesAtEpoch(ctx, stateID, epoch)
 }
 
+// BeaconCommitteesWithOpts fetches the beacon committees matching the given options.
+func (s *Erroring) BeaconCommitteesWithOpts(ctx context.Context, opts *api.BeaconCommitteesOpts) ([]*apiv1.BeaconCommittee, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconCommitteesWithOptsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconCommitteesWithOpts(ctx, opts)
+}
+
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Erroring) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -426,6 +475,30 @@ func (s *Erroring) Events(ctx context.Context, topics []string, handler consensu
 	return next.Events(ctx, topics, handler)
 }
 
+// ExpectedWithdrawals provides the withdrawals that will be included in the next block built on the given state.
+func (s *Erroring) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ExpectedWithdrawals(ctx, stateID, proposalSlot)
+}
+
+// FilteredValidators provides the validators, with their balance and status, for a given state.
+func (s *Erroring) FilteredValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.FilteredValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.FilteredValidators(ctx, stateID, validatorIndices, validatorPubKeys, validatorStates)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Erroring) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -474,6 +547,282 @@ func (s *Erroring) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
 	return next.Genesis(ctx)
 }
 
+// HistoricalRoots fetches the historical roots of the given state.
+func (s *Erroring) HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.HistoricalRootsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.HistoricalRoots(ctx, stateID)
+}
+
+// HistoricalSummaries fetches the historical summaries of the given state.
+func (s *Erroring) HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.HistoricalSummariesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.HistoricalSummaries(ctx, stateID)
+}
+
+// AttesterSlashingPool provides the attester slashing pool.
+func (s *Erroring) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttesterSlashingPool(ctx)
+}
+
+// BLSToExecutionChangePool provides the BLS to execution address change pool.
+func (s *Erroring) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BLSToExecutionChangePool(ctx)
+}
+
+// ProposerSlashingPool provides the proposer slashing pool.
+func (s *Erroring) ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ProposerSlashingPool(ctx)
+}
+
+// VoluntaryExitPool provides the voluntary exit pool.
+func (s *Erroring) VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.VoluntaryExitPool(ctx)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Erroring) SubmitAttesterSlashing(ctx context.Context, attesterSlashing *phase0.AttesterSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitAttesterSlashing(ctx, attesterSlashing)
+}
+
+// SubmitProposerSlashing submits a proposer slashing.
+func (s *Erroring) SubmitProposerSlashing(ctx context.Context, proposerSlashing *phase0.ProposerSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitProposerSlashing(ctx, proposerSlashing)
+}
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+func (s *Erroring) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationRewards(ctx, epoch, validatorIndices)
+}
+
+// BlockRewards provides the rewards paid to the proposer of the given block.
+func (s *Erroring) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given block.
+func (s *Erroring) SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeRewards(ctx, blockID, validatorIndices)
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Erroring) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ValidatorLiveness(ctx, epoch, validatorIndices)
+}
+
+// LightClientBootstrap fetches the light client bootstrap for the given block root.
+func (s *Erroring) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientBootstrap(ctx, blockRoot)
+}
+
+// LightClientUpdates fetches light client updates for count sync committee periods, starting with the given period.
+func (s *Erroring) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientUpdates(ctx, startPeriod, count)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+func (s *Erroring) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+func (s *Erroring) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// ChainHeads provides the heads of all chains known to the node.
+func (s *Erroring) ChainHeads(ctx context.Context) ([]*apiv1.ChainHead, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ChainHeadsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ChainHeads(ctx)
+}
+
+// ForkChoice provides a dump of the fork choice store of the node.
+func (s *Erroring) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ForkChoiceProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkChoice(ctx)
+}
+
+// NodeHealth provides the health of the node.
+func (s *Erroring) NodeHealth(ctx context.Context) (apiv1.NodeHealth, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return apiv1.NodeHealthUnknown, err
+	}
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return apiv1.NodeHealthUnknown, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeHealth(ctx)
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeIdentity(ctx)
+}
+
+// NodePeers provides the peers of the node.
+func (s *Erroring) NodePeers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeers(ctx, states, directions)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Erroring) NodePeer(ctx context.Context, peerID string) (*apiv1.Peer, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeer(ctx, peerID)
+}
+
+// NodePeerCount provides the number of peers of the node in each state.
+func (s *Erroring) NodePeerCount(ctx context.Context) (*apiv1.PeerCount, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodePeerCount(ctx)
+}
+
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Erroring) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -499,6 +848,18 @@ func (s *Erroring) ProposerDuties(ctx context.Context, epoch phase0.Epoch, valid
 	return next.ProposerDuties(ctx, epoch, validatorIndices)
 }
 
+// SyncCommitteeSelections submits partial sync committee selection proofs and returns the aggregated selection proofs.
+func (s *Erroring) SyncCommitteeSelections(ctx context.Context, selections []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeSelections(ctx, selections)
+}
+
 // SyncCommittee fetches the sync committee for the given state.
 func (s *Erroring) SyncCommittee(ctx context.Context, stateID string) (*apiv1.SyncCommittee, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -560,6 +921,18 @@ func (s *Erroring) Spec(ctx context.Context) (map[string]interface{}, error) {
 	return next.Spec(ctx)
 }
 
+// ChainSpec provides the spec information of the chain.
+func (s *Erroring) ChainSpec(ctx context.Context) (*apiv1.ChainSpec, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ChainSpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ChainSpec(ctx)
+}
+
 // ValidatorBalances provides the validator balances for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
@@ -653,6 +1026,18 @@ func (s *Erroring) GenesisTime(ctx context.Context) (time.Time, error) {
 	return next.GenesisTime(ctx)
 }
 
+// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+func (s *Erroring) DepositSnapshot(ctx context.Context) (*apiv1.DepositSnapshot, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DepositSnapshot(ctx)
+}
+
 // DepositContract provides details of the Ethereum 1 deposit contract for the chain.
 func (s *Erroring) DepositContract(ctx context.Context) (*apiv1.DepositContract, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -677,6 +1062,30 @@ func (s *Erroring) SignedBeaconBlock(ctx context.Context, blockID string) (*spec
 	return next.SignedBeaconBlock(ctx, blockID)
 }
 
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Erroring) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SignedBlindedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SignedBlindedBeaconBlock(ctx, blockID)
+}
+
+// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+func (s *Erroring) BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockAttestationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockAttestations(ctx, blockID)
+}
+
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Erroring) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/jefmcl/go-eth2-client/api"
 	apiv1 "github.com/jefmcl/go-eth2-client/api/v1"
 	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
 	"github.com/jefmcl/go-eth2-client/spec/phase0"
 )
 
@@ -195,6 +196,16 @@ func (s *Sleepy) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phas
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolWithOpts fetches the attestations in the pool matching the given options.
+func (s *Sleepy) AttestationPoolWithOpts(ctx context.Context, opts *api.AttestationPoolOpts) ([]*phase0.Attestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationPoolWithOptsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationPoolWithOpts(ctx, opts)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	s.sleep(ctx)
@@ -226,6 +237,16 @@ func (s *Sleepy) BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.
 	return next.BeaconBlockHeader(ctx, blockID)
 }
 
+// BeaconBlockHeaders provides the block headers matching the given filters.
+func (s *Sleepy) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.FilteredBeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconBlockHeaders(ctx, slot, parentRoot)
+}
+
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Sleepy) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	s.sleep(ctx)
@@ -246,6 +267,26 @@ func (s *Sleepy) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSig
 	return next.SubmitBeaconBlock(ctx, block)
 }
 
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Sleepy) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SignedBlindedBeaconBlockProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SignedBlindedBeaconBlock(ctx, blockID)
+}
+
+// BeaconBlockAttestations fetches the attestations included in a beacon block given a block ID.
+func (s *Sleepy) BeaconBlockAttestations(ctx context.Context, blockID string) ([]*phase0.Attestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconBlockAttestationsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconBlockAttestations(ctx, blockID)
+}
+
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Sleepy) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
 	s.sleep(ctx)
@@ -286,6 +327,16 @@ func (s *Sleepy) SubmitValidatorRegistrations(ctx context.Context, registrations
 	return next.SubmitValidatorRegistrations(ctx, registrations)
 }
 
+// BeaconCommitteeSelections submits partial beacon committee selection proofs and returns the aggregated selection proofs.
+func (s *Sleepy) BeaconCommitteeSelections(ctx context.Context, selections []*apiv1.BeaconCommitteeSelection) ([]*apiv1.BeaconCommitteeSelection, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconCommitteeSelections(ctx, selections)
+}
+
 // BeaconState fetches a beacon state.
 func (s *Sleepy) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
 	s.sleep(ctx)
@@ -306,6 +357,26 @@ func (s *Sleepy) Events(ctx context.Context, topics []string, handler consensusc
 	return next.Events(ctx, topics, handler)
 }
 
+// ExpectedWithdrawals provides the withdrawals that will be included in the next block built on the given state.
+func (s *Sleepy) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot phase0.Slot) ([]*capella.Withdrawal, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ExpectedWithdrawals(ctx, stateID, proposalSlot)
+}
+
+// FilteredValidators provides the validators, with their balance and status, for a given state.
+func (s *Sleepy) FilteredValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex, validatorPubKeys []phase0.BLSPubKey, validatorStates []apiv1.ValidatorState) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.FilteredValidatorsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.FilteredValidators(ctx, stateID, validatorIndices, validatorPubKeys, validatorStates)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Sleepy) Finality(ctx context.Context, stateID string) (*apiv1.Finality, error) {
 	s.sleep(ctx)
@@ -346,6 +417,246 @@ func (s *Sleepy) Genesis(ctx context.Context) (*apiv1.Genesis, error) {
 	return next.Genesis(ctx)
 }
 
+// HistoricalRoots fetches the historical roots of the given state.
+func (s *Sleepy) HistoricalRoots(ctx context.Context, stateID string) ([]phase0.Root, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.HistoricalRootsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.HistoricalRoots(ctx, stateID)
+}
+
+// HistoricalSummaries fetches the historical summaries of the given state.
+func (s *Sleepy) HistoricalSummaries(ctx context.Context, stateID string) ([]*capella.HistoricalSummary, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.HistoricalSummariesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.HistoricalSummaries(ctx, stateID)
+}
+
+// AttesterSlashingPool provides the attester slashing pool.
+func (s *Sleepy) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttesterSlashingPool(ctx)
+}
+
+// BLSToExecutionChangePool provides the BLS to execution address change pool.
+func (s *Sleepy) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BLSToExecutionChangePool(ctx)
+}
+
+// ProposerSlashingPool provides the proposer slashing pool.
+func (s *Sleepy) ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ProposerSlashingPool(ctx)
+}
+
+// VoluntaryExitPool provides the voluntary exit pool.
+func (s *Sleepy) VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.VoluntaryExitPool(ctx)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Sleepy) SubmitAttesterSlashing(ctx context.Context, attesterSlashing *phase0.AttesterSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.SubmitAttesterSlashing(ctx, attesterSlashing)
+}
+
+// SubmitProposerSlashing submits a proposer slashing.
+func (s *Sleepy) SubmitProposerSlashing(ctx context.Context, proposerSlashing *phase0.ProposerSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.SubmitProposerSlashing(ctx, proposerSlashing)
+}
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+func (s *Sleepy) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationRewards(ctx, epoch, validatorIndices)
+}
+
+// BlockRewards provides the rewards paid to the proposer of the given block.
+func (s *Sleepy) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// SyncCommitteeSelections submits partial sync committee selection proofs and returns the aggregated selection proofs.
+func (s *Sleepy) SyncCommitteeSelections(ctx context.Context, selections []*apiv1.SyncCommitteeSelection) ([]*apiv1.SyncCommitteeSelection, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SyncCommitteeSelections(ctx, selections)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given block.
+func (s *Sleepy) SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SyncCommitteeRewards(ctx, blockID, validatorIndices)
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Sleepy) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ValidatorLiveness(ctx, epoch, validatorIndices)
+}
+
+// LightClientBootstrap fetches the light client bootstrap for the given block root.
+func (s *Sleepy) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*spec.VersionedLightClientBootstrap, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientBootstrapProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientBootstrap(ctx, blockRoot)
+}
+
+// LightClientUpdates fetches light client updates for count sync committee periods, starting with the given period.
+func (s *Sleepy) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*spec.VersionedLightClientUpdate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientUpdatesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientUpdates(ctx, startPeriod, count)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+func (s *Sleepy) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+func (s *Sleepy) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// ChainHeads provides the heads of all chains known to the node.
+func (s *Sleepy) ChainHeads(ctx context.Context) ([]*apiv1.ChainHead, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ChainHeadsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ChainHeads(ctx)
+}
+
+// ForkChoice provides a dump of the fork choice store of the node.
+func (s *Sleepy) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ForkChoiceProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ForkChoice(ctx)
+}
+
+// NodeHealth provides the health of the node.
+func (s *Sleepy) NodeHealth(ctx context.Context) (apiv1.NodeHealth, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return apiv1.NodeHealthUnknown, errors.New("next does not support this call")
+	}
+	return next.NodeHealth(ctx)
+}
+
+// NodeIdentity provides the network identity of the node.
+func (s *Sleepy) NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.NodeIdentity(ctx)
+}
+
+// NodePeers provides the peers of the node.
+func (s *Sleepy) NodePeers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.NodePeers(ctx, states, directions)
+}
+
+// NodePeer provides a single peer of the node.
+func (s *Sleepy) NodePeer(ctx context.Context, peerID string) (*apiv1.Peer, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.NodePeer(ctx, peerID)
+}
+
+// NodePeerCount provides the number of peers of the node in each state.
+func (s *Sleepy) NodePeerCount(ctx context.Context) (*apiv1.PeerCount, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.NodePeerCount(ctx)
+}
+
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Sleepy) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
 	s.sleep(ctx)
@@ -377,6 +688,16 @@ func (s *Sleepy) Spec(ctx context.Context) (map[string]interface{}, error) {
 	return next.Spec(ctx)
 }
 
+// ChainSpec provides the spec information of the chain.
+func (s *Sleepy) ChainSpec(ctx context.Context) (*apiv1.ChainSpec, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ChainSpecProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ChainSpec(ctx)
+}
+
 // ValidatorBalances provides the validator balances for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
@@ -446,6 +767,16 @@ func (s *Sleepy) GenesisDomain(ctx context.Context, domainType phase0.DomainType
 	return next.GenesisDomain(ctx, domainType)
 }
 
+// DepositSnapshot provides a snapshot of the finalized deposit tree, as defined in EIP-4881.
+func (s *Sleepy) DepositSnapshot(ctx context.Context) (*apiv1.DepositSnapshot, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.DepositSnapshot(ctx)
+}
+
 // GenesisTime provides the genesis time of the chain.
 func (s *Sleepy) GenesisTime(ctx context.Context) (time.Time, error) {
 	s.sleep(ctx)
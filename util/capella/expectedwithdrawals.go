@@ -0,0 +1,269 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"fmt"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// WithdrawalsConfig contains the chain configuration required to calculate withdrawals.
+type WithdrawalsConfig struct {
+	SlotsPerEpoch                    uint64
+	MaxEffectiveBalance              phase0.Gwei
+	MaxWithdrawalsPerPayload         uint64
+	MaxValidatorsPerWithdrawalsSweep uint64
+}
+
+// WithdrawalsConfigFromSpec obtains the withdrawals configuration from a chain
+// specification, as returned by SpecProvider.
+func WithdrawalsConfigFromSpec(chainSpec map[string]interface{}) (*WithdrawalsConfig, error) {
+	values := make(map[string]uint64)
+	for _, key := range []string{
+		"SLOTS_PER_EPOCH",
+		"MAX_EFFECTIVE_BALANCE",
+		"MAX_WITHDRAWALS_PER_PAYLOAD",
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP",
+	} {
+		tmp, exists := chainSpec[key]
+		if !exists {
+			return nil, fmt.Errorf("%s not found in spec", key)
+		}
+		value, isValue := tmp.(uint64)
+		if !isValue {
+			return nil, fmt.Errorf("%s of unexpected type", key)
+		}
+		values[key] = value
+	}
+
+	config := &WithdrawalsConfig{
+		SlotsPerEpoch:                    values["SLOTS_PER_EPOCH"],
+		MaxEffectiveBalance:              phase0.Gwei(values["MAX_EFFECTIVE_BALANCE"]),
+		MaxWithdrawalsPerPayload:         values["MAX_WITHDRAWALS_PER_PAYLOAD"],
+		MaxValidatorsPerWithdrawalsSweep: values["MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"],
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that the configuration is usable.
+func (c *WithdrawalsConfig) check() error {
+	if c.SlotsPerEpoch == 0 {
+		return errors.New("slots per epoch must be greater than 0")
+	}
+	if c.MaxWithdrawalsPerPayload == 0 {
+		return errors.New("max withdrawals per payload must be greater than 0")
+	}
+	if c.MaxValidatorsPerWithdrawalsSweep == 0 {
+		return errors.New("max validators per withdrawals sweep must be greater than 0")
+	}
+
+	return nil
+}
+
+// withdrawalsState is the subset of the beacon state used to calculate withdrawals.
+type withdrawalsState struct {
+	slot                         phase0.Slot
+	validators                   []*phase0.Validator
+	balances                     []phase0.Gwei
+	nextWithdrawalIndex          capella.WithdrawalIndex
+	nextWithdrawalValidatorIndex phase0.ValidatorIndex
+}
+
+// ExpectedWithdrawals calculates the withdrawals that will be included in the
+// execution payload of the next block built on the given state.
+// The state should have been advanced to the slot of the block.
+func ExpectedWithdrawals(state *spec.VersionedBeaconState, config *WithdrawalsConfig) ([]*capella.Withdrawal, error) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	ws, err := newWithdrawalsState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws.expectedWithdrawals(config), nil
+}
+
+// PredictWithdrawals predicts the withdrawals for each of the given number of slots
+// after the state.
+// The prediction assumes that there is a block in every slot, and that balances
+// change only as a result of withdrawals.
+func PredictWithdrawals(state *spec.VersionedBeaconState,
+	config *WithdrawalsConfig,
+	slots uint64,
+) (
+	map[phase0.Slot][]*capella.Withdrawal,
+	error,
+) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	ws, err := newWithdrawalsState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	// Balances are altered as part of the prediction, so take a copy.
+	balances := make([]phase0.Gwei, len(ws.balances))
+	copy(balances, ws.balances)
+	ws.balances = balances
+
+	res := make(map[phase0.Slot][]*capella.Withdrawal, slots)
+	startSlot := ws.slot
+	for slot := startSlot + 1; slot <= startSlot+phase0.Slot(slots); slot++ {
+		ws.slot = slot
+		withdrawals := ws.expectedWithdrawals(config)
+		ws.apply(withdrawals, config)
+		res[slot] = withdrawals
+	}
+
+	return res, nil
+}
+
+// newWithdrawalsState obtains the data required to calculate withdrawals from the state.
+func newWithdrawalsState(state *spec.VersionedBeaconState) (*withdrawalsState, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	slot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot")
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+	balances, err := state.ValidatorBalances()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validator balances")
+	}
+	if len(validators) != len(balances) {
+		return nil, fmt.Errorf("state has %d validators but %d balances", len(validators), len(balances))
+	}
+	nextWithdrawalIndex, err := state.NextWithdrawalIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain next withdrawal index")
+	}
+	nextWithdrawalValidatorIndex, err := state.NextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain next withdrawal validator index")
+	}
+	if len(validators) > 0 && int(nextWithdrawalValidatorIndex) >= len(validators) {
+		return nil, fmt.Errorf("next withdrawal validator index %d out of range", nextWithdrawalValidatorIndex)
+	}
+
+	return &withdrawalsState{
+		slot:                         slot,
+		validators:                   validators,
+		balances:                     balances,
+		nextWithdrawalIndex:          nextWithdrawalIndex,
+		nextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
+	}, nil
+}
+
+// expectedWithdrawals implements get_expected_withdrawals from the Capella specification.
+func (s *withdrawalsState) expectedWithdrawals(config *WithdrawalsConfig) []*capella.Withdrawal {
+	withdrawals := make([]*capella.Withdrawal, 0)
+	if len(s.validators) == 0 {
+		return withdrawals
+	}
+
+	epoch := phase0.Epoch(uint64(s.slot) / config.SlotsPerEpoch)
+	withdrawalIndex := s.nextWithdrawalIndex
+	validatorIndex := s.nextWithdrawalValidatorIndex
+	bound := uint64(len(s.validators))
+	if bound > config.MaxValidatorsPerWithdrawalsSweep {
+		bound = config.MaxValidatorsPerWithdrawalsSweep
+	}
+	for i := uint64(0); i < bound; i++ {
+		validator := s.validators[validatorIndex]
+		balance := s.balances[validatorIndex]
+		var amount phase0.Gwei
+		switch {
+		case isFullyWithdrawable(validator, balance, epoch):
+			amount = balance
+		case isPartiallyWithdrawable(validator, balance, config.MaxEffectiveBalance):
+			amount = balance - config.MaxEffectiveBalance
+		}
+		if amount > 0 {
+			withdrawal := &capella.Withdrawal{
+				Index:          withdrawalIndex,
+				ValidatorIndex: validatorIndex,
+				Amount:         amount,
+			}
+			copy(withdrawal.Address[:], validator.WithdrawalCredentials[12:])
+			withdrawals = append(withdrawals, withdrawal)
+			withdrawalIndex++
+		}
+		if uint64(len(withdrawals)) == config.MaxWithdrawalsPerPayload {
+			break
+		}
+		validatorIndex = phase0.ValidatorIndex((uint64(validatorIndex) + 1) % uint64(len(s.validators)))
+	}
+
+	return withdrawals
+}
+
+// apply implements process_withdrawals from the Capella specification, for
+// withdrawals that have already been calculated.
+func (s *withdrawalsState) apply(withdrawals []*capella.Withdrawal, config *WithdrawalsConfig) {
+	if len(s.validators) == 0 {
+		return
+	}
+
+	for _, withdrawal := range withdrawals {
+		s.balances[withdrawal.ValidatorIndex] -= withdrawal.Amount
+	}
+
+	if len(withdrawals) > 0 {
+		s.nextWithdrawalIndex = withdrawals[len(withdrawals)-1].Index + 1
+	}
+	if uint64(len(withdrawals)) == config.MaxWithdrawalsPerPayload {
+		s.nextWithdrawalValidatorIndex = phase0.ValidatorIndex((uint64(withdrawals[len(withdrawals)-1].ValidatorIndex) + 1) % uint64(len(s.validators)))
+	} else {
+		s.nextWithdrawalValidatorIndex = phase0.ValidatorIndex((uint64(s.nextWithdrawalValidatorIndex) + config.MaxValidatorsPerWithdrawalsSweep) % uint64(len(s.validators)))
+	}
+}
+
+// hasETH1WithdrawalCredential returns true if the validator has an execution withdrawal credential.
+func hasETH1WithdrawalCredential(validator *phase0.Validator) bool {
+	return len(validator.WithdrawalCredentials) == 32 && validator.WithdrawalCredentials[0] == 0x01
+}
+
+// isFullyWithdrawable returns true if the validator's balance is fully withdrawable.
+func isFullyWithdrawable(validator *phase0.Validator, balance phase0.Gwei, epoch phase0.Epoch) bool {
+	return hasETH1WithdrawalCredential(validator) && validator.WithdrawableEpoch <= epoch && balance > 0
+}
+
+// isPartiallyWithdrawable returns true if the validator's balance is partially withdrawable.
+func isPartiallyWithdrawable(validator *phase0.Validator, balance phase0.Gwei, maxEffectiveBalance phase0.Gwei) bool {
+	return hasETH1WithdrawalCredential(validator) &&
+		validator.EffectiveBalance == maxEffectiveBalance &&
+		balance > maxEffectiveBalance
+}
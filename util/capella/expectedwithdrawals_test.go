@@ -0,0 +1,198 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/spec"
+	"github.com/jefmcl/go-eth2-client/spec/bellatrix"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	utilcapella "github.com/jefmcl/go-eth2-client/util/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func withdrawalsTestState() *spec.VersionedBeaconState {
+	validator := func(credentialType byte, index byte, effectiveBalance phase0.Gwei, withdrawableEpoch phase0.Epoch) *phase0.Validator {
+		withdrawalCredentials := make([]byte, 32)
+		withdrawalCredentials[0] = credentialType
+		withdrawalCredentials[31] = index
+		return &phase0.Validator{
+			WithdrawalCredentials: withdrawalCredentials,
+			EffectiveBalance:      effectiveBalance,
+			WithdrawableEpoch:     withdrawableEpoch,
+		}
+	}
+	farFuture := phase0.Epoch(0xffffffffffffffff)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.BeaconState{
+			Slot: 64,
+			Validators: []*phase0.Validator{
+				validator(0x01, 0, 32000000000, farFuture),
+				validator(0x00, 1, 32000000000, farFuture),
+				validator(0x01, 2, 10000000000, 0),
+				validator(0x01, 3, 32000000000, farFuture),
+				validator(0x01, 4, 32000000000, farFuture),
+				validator(0x01, 5, 32000000000, farFuture),
+				validator(0x01, 6, 32000000000, farFuture),
+				validator(0x01, 7, 32000000000, farFuture),
+			},
+			Balances: []phase0.Gwei{
+				32500000000,
+				33000000000,
+				10000000000,
+				32000000000,
+				33000000000,
+				32000000000,
+				32000000000,
+				32000000000,
+			},
+			NextWithdrawalIndex:          10,
+			NextWithdrawalValidatorIndex: 0,
+		},
+	}
+}
+
+func withdrawalsTestConfig() *utilcapella.WithdrawalsConfig {
+	return &utilcapella.WithdrawalsConfig{
+		SlotsPerEpoch:                    32,
+		MaxEffectiveBalance:              32000000000,
+		MaxWithdrawalsPerPayload:         2,
+		MaxValidatorsPerWithdrawalsSweep: 4,
+	}
+}
+
+func TestExpectedWithdrawals(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       *spec.VersionedBeaconState
+		config      *utilcapella.WithdrawalsConfig
+		withdrawals []*capella.Withdrawal
+		err         string
+	}{
+		{
+			name:   "StateMissing",
+			config: withdrawalsTestConfig(),
+			err:    "no state supplied",
+		},
+		{
+			name:  "ConfigMissing",
+			state: withdrawalsTestState(),
+			err:   "no config supplied",
+		},
+		{
+			name:  "ConfigInvalid",
+			state: withdrawalsTestState(),
+			config: &utilcapella.WithdrawalsConfig{
+				SlotsPerEpoch: 32,
+			},
+			err: "max withdrawals per payload must be greater than 0",
+		},
+		{
+			name: "Phase0",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionPhase0,
+				Phase0:  &phase0.BeaconState{},
+			},
+			config: withdrawalsTestConfig(),
+			err:    "failed to obtain next withdrawal index: state does not provide next withdrawal index",
+		},
+		{
+			name:   "Good",
+			state:  withdrawalsTestState(),
+			config: withdrawalsTestConfig(),
+			withdrawals: []*capella.Withdrawal{
+				{
+					Index:          10,
+					ValidatorIndex: 0,
+					Address:        bellatrix.ExecutionAddress{19: 0x00},
+					Amount:         500000000,
+				},
+				{
+					Index:          11,
+					ValidatorIndex: 2,
+					Address:        bellatrix.ExecutionAddress{19: 0x02},
+					Amount:         10000000000,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			withdrawals, err := utilcapella.ExpectedWithdrawals(test.state, test.config)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.withdrawals, withdrawals)
+		})
+	}
+}
+
+func TestPredictWithdrawals(t *testing.T) {
+	state := withdrawalsTestState()
+	predictions, err := utilcapella.PredictWithdrawals(state, withdrawalsTestConfig(), 3)
+	require.NoError(t, err)
+	require.Len(t, predictions, 3)
+
+	// The first slot matches the expected withdrawals.
+	require.Len(t, predictions[65], 2)
+	require.Equal(t, phase0.ValidatorIndex(2), predictions[65][1].ValidatorIndex)
+
+	// The second slot continues the sweep after the last withdrawal.
+	require.Equal(t, []*capella.Withdrawal{
+		{
+			Index:          12,
+			ValidatorIndex: 4,
+			Address:        bellatrix.ExecutionAddress{19: 0x04},
+			Amount:         1000000000,
+		},
+	}, predictions[66])
+
+	// The third slot finds nothing, as earlier withdrawals have been applied.
+	require.Empty(t, predictions[67])
+
+	// The state itself is not altered.
+	require.Equal(t, phase0.Gwei(10000000000), state.Capella.Balances[2])
+}
+
+func TestWithdrawalsConfigFromSpec(t *testing.T) {
+	chainSpec := map[string]interface{}{
+		"SLOTS_PER_EPOCH":                      uint64(32),
+		"MAX_EFFECTIVE_BALANCE":                uint64(32000000000),
+		"MAX_WITHDRAWALS_PER_PAYLOAD":          uint64(16),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP": uint64(16384),
+	}
+	config, err := utilcapella.WithdrawalsConfigFromSpec(chainSpec)
+	require.NoError(t, err)
+	require.Equal(t, &utilcapella.WithdrawalsConfig{
+		SlotsPerEpoch:                    32,
+		MaxEffectiveBalance:              32000000000,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 16384,
+	}, config)
+
+	delete(chainSpec, "MAX_WITHDRAWALS_PER_PAYLOAD")
+	_, err = utilcapella.WithdrawalsConfigFromSpec(chainSpec)
+	require.EqualError(t, err, "MAX_WITHDRAWALS_PER_PAYLOAD not found in spec")
+
+	chainSpec["MAX_WITHDRAWALS_PER_PAYLOAD"] = "16"
+	_, err = utilcapella.WithdrawalsConfigFromSpec(chainSpec)
+	require.EqualError(t, err, "MAX_WITHDRAWALS_PER_PAYLOAD of unexpected type")
+}
@@ -0,0 +1,187 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HistoricalConfig contains the chain configuration required to verify historical block roots.
+type HistoricalConfig struct {
+	SlotsPerHistoricalRoot uint64
+}
+
+// HistoricalConfigFromSpec obtains the historical configuration from a chain
+// specification, as returned by SpecProvider.
+func HistoricalConfigFromSpec(chainSpec map[string]interface{}) (*HistoricalConfig, error) {
+	tmp, exists := chainSpec["SLOTS_PER_HISTORICAL_ROOT"]
+	if !exists {
+		return nil, errors.New("SLOTS_PER_HISTORICAL_ROOT not found in spec")
+	}
+	slotsPerHistoricalRoot, isValue := tmp.(uint64)
+	if !isValue {
+		return nil, errors.New("SLOTS_PER_HISTORICAL_ROOT of unexpected type")
+	}
+
+	config := &HistoricalConfig{
+		SlotsPerHistoricalRoot: slotsPerHistoricalRoot,
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that the configuration is usable.
+func (c *HistoricalConfig) check() error {
+	if c.SlotsPerHistoricalRoot == 0 {
+		return errors.New("slots per historical root must be greater than 0")
+	}
+	if c.SlotsPerHistoricalRoot&(c.SlotsPerHistoricalRoot-1) != 0 {
+		return errors.New("slots per historical root must be a power of 2")
+	}
+
+	return nil
+}
+
+// HistoricalBatch contains the block and state roots for a single period of
+// SLOTS_PER_HISTORICAL_ROOT slots, as held in the beacon state at the end of the period.
+type HistoricalBatch struct {
+	BlockRoots []phase0.Root
+	StateRoots []phase0.Root
+}
+
+// BlockSummaryRoot calculates the block summary root for a period, as stored
+// in the historical summary for that period.
+func BlockSummaryRoot(blockRoots []phase0.Root, config *HistoricalConfig) (phase0.Root, error) {
+	if config == nil {
+		return phase0.Root{}, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return phase0.Root{}, err
+	}
+
+	return rootsVectorRoot(blockRoots, config.SlotsPerHistoricalRoot)
+}
+
+// HistoricalBatchRoot calculates the root of a historical batch, as stored in
+// the historical roots for that period.
+func HistoricalBatchRoot(batch *HistoricalBatch, config *HistoricalConfig) (phase0.Root, error) {
+	if config == nil {
+		return phase0.Root{}, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return phase0.Root{}, err
+	}
+	if batch == nil {
+		return phase0.Root{}, errors.New("no batch supplied")
+	}
+
+	blockRootsRoot, err := rootsVectorRoot(batch.BlockRoots, config.SlotsPerHistoricalRoot)
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "invalid block roots")
+	}
+	stateRootsRoot, err := rootsVectorRoot(batch.StateRoots, config.SlotsPerHistoricalRoot)
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "invalid state roots")
+	}
+
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	hh.Append(blockRootsRoot[:])
+	hh.Append(stateRootsRoot[:])
+	hh.Merkleize(indx)
+
+	return hh.HashRoot()
+}
+
+// VerifyHistoricalBlockRoot verifies that the given block root is that of the
+// canonical block at the given slot.
+// historicalRoots and historicalSummaries are taken from a state that is later
+// than the end of the period containing the slot, and batch contains the block
+// (and, for periods covered by historical roots, state) roots for that period.
+// An error is returned if the block root cannot be verified.
+func VerifyHistoricalBlockRoot(historicalRoots []phase0.Root,
+	historicalSummaries []*capella.HistoricalSummary,
+	batch *HistoricalBatch,
+	slot phase0.Slot,
+	blockRoot phase0.Root,
+	config *HistoricalConfig,
+) error {
+	if config == nil {
+		return errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return err
+	}
+	if batch == nil {
+		return errors.New("no batch supplied")
+	}
+
+	period := uint64(slot) / config.SlotsPerHistoricalRoot
+	switch {
+	case period < uint64(len(historicalRoots)):
+		// Period prior to Capella, check against the historical roots.
+		root, err := HistoricalBatchRoot(batch, config)
+		if err != nil {
+			return err
+		}
+		if root != historicalRoots[period] {
+			return fmt.Errorf("batch does not match historical root for period %d", period)
+		}
+	case period < uint64(len(historicalRoots)+len(historicalSummaries)):
+		// Period from Capella onwards, check against the historical summaries.
+		summary := historicalSummaries[period-uint64(len(historicalRoots))]
+		if summary == nil {
+			return fmt.Errorf("missing historical summary for period %d", period)
+		}
+		root, err := BlockSummaryRoot(batch.BlockRoots, config)
+		if err != nil {
+			return errors.Wrap(err, "invalid block roots")
+		}
+		if root != summary.BlockSummaryRoot {
+			return fmt.Errorf("block roots do not match historical summary for period %d", period)
+		}
+	default:
+		return fmt.Errorf("period %d not covered by historical data", period)
+	}
+
+	if batch.BlockRoots[uint64(slot)%config.SlotsPerHistoricalRoot] != blockRoot {
+		return fmt.Errorf("block root does not match that for slot %d", slot)
+	}
+
+	return nil
+}
+
+// rootsVectorRoot calculates the hash tree root of a vector of roots of the given length.
+func rootsVectorRoot(roots []phase0.Root, length uint64) (phase0.Root, error) {
+	if uint64(len(roots)) != length {
+		return phase0.Root{}, fmt.Errorf("expected %d roots, found %d", length, len(roots))
+	}
+
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for i := range roots {
+		hh.Append(roots[i][:])
+	}
+	hh.Merkleize(indx)
+
+	return hh.HashRoot()
+}
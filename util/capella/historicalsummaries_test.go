@@ -0,0 +1,176 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/jefmcl/go-eth2-client/spec/capella"
+	"github.com/jefmcl/go-eth2-client/spec/phase0"
+	utilcapella "github.com/jefmcl/go-eth2-client/util/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func hashPair(a phase0.Root, b phase0.Root) phase0.Root {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func historicalTestBatch(period byte) *utilcapella.HistoricalBatch {
+	return &utilcapella.HistoricalBatch{
+		BlockRoots: []phase0.Root{{period, 0x01}, {period, 0x02}},
+		StateRoots: []phase0.Root{{period, 0x11}, {period, 0x12}},
+	}
+}
+
+func TestHistoricalBatchRoot(t *testing.T) {
+	config := &utilcapella.HistoricalConfig{SlotsPerHistoricalRoot: 2}
+	batch := historicalTestBatch(0)
+
+	root, err := utilcapella.BlockSummaryRoot(batch.BlockRoots, config)
+	require.NoError(t, err)
+	require.Equal(t, hashPair(batch.BlockRoots[0], batch.BlockRoots[1]), root)
+
+	root, err = utilcapella.HistoricalBatchRoot(batch, config)
+	require.NoError(t, err)
+	require.Equal(t, hashPair(
+		hashPair(batch.BlockRoots[0], batch.BlockRoots[1]),
+		hashPair(batch.StateRoots[0], batch.StateRoots[1]),
+	), root)
+
+	_, err = utilcapella.BlockSummaryRoot(batch.BlockRoots[:1], config)
+	require.EqualError(t, err, "expected 2 roots, found 1")
+
+	_, err = utilcapella.HistoricalBatchRoot(batch, &utilcapella.HistoricalConfig{SlotsPerHistoricalRoot: 3})
+	require.EqualError(t, err, "slots per historical root must be a power of 2")
+}
+
+func TestVerifyHistoricalBlockRoot(t *testing.T) {
+	config := &utilcapella.HistoricalConfig{SlotsPerHistoricalRoot: 2}
+
+	// Period 0 is covered by historical roots, periods 1 and 2 by historical summaries.
+	batches := []*utilcapella.HistoricalBatch{historicalTestBatch(0), historicalTestBatch(1), historicalTestBatch(2)}
+	historicalRoot, err := utilcapella.HistoricalBatchRoot(batches[0], config)
+	require.NoError(t, err)
+	historicalRoots := []phase0.Root{historicalRoot}
+	historicalSummaries := make([]*capella.HistoricalSummary, 0, 2)
+	for _, batch := range batches[1:] {
+		blockSummaryRoot, err := utilcapella.BlockSummaryRoot(batch.BlockRoots, config)
+		require.NoError(t, err)
+		stateSummaryRoot, err := utilcapella.BlockSummaryRoot(batch.StateRoots, config)
+		require.NoError(t, err)
+		historicalSummaries = append(historicalSummaries, &capella.HistoricalSummary{
+			BlockSummaryRoot: blockSummaryRoot,
+			StateSummaryRoot: stateSummaryRoot,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		batch     *utilcapella.HistoricalBatch
+		slot      phase0.Slot
+		blockRoot phase0.Root
+		config    *utilcapella.HistoricalConfig
+		err       string
+	}{
+		{
+			name:      "ConfigMissing",
+			batch:     batches[0],
+			blockRoot: batches[0].BlockRoots[0],
+			err:       "no config supplied",
+		},
+		{
+			name:      "BatchMissing",
+			blockRoot: batches[0].BlockRoots[0],
+			config:    config,
+			err:       "no batch supplied",
+		},
+		{
+			name:      "HistoricalRoot",
+			batch:     batches[0],
+			slot:      1,
+			blockRoot: batches[0].BlockRoots[1],
+			config:    config,
+		},
+		{
+			name:      "HistoricalRootBatchMismatch",
+			batch:     batches[1],
+			slot:      1,
+			blockRoot: batches[1].BlockRoots[1],
+			config:    config,
+			err:       "batch does not match historical root for period 0",
+		},
+		{
+			name:      "HistoricalSummary",
+			batch:     batches[2],
+			slot:      4,
+			blockRoot: batches[2].BlockRoots[0],
+			config:    config,
+		},
+		{
+			name:      "HistoricalSummaryBatchMismatch",
+			batch:     batches[1],
+			slot:      5,
+			blockRoot: batches[1].BlockRoots[1],
+			config:    config,
+			err:       "block roots do not match historical summary for period 2",
+		},
+		{
+			name:      "BlockRootMismatch",
+			batch:     batches[1],
+			slot:      2,
+			blockRoot: batches[1].BlockRoots[1],
+			config:    config,
+			err:       "block root does not match that for slot 2",
+		},
+		{
+			name:      "PeriodNotCovered",
+			batch:     batches[2],
+			slot:      6,
+			blockRoot: batches[2].BlockRoots[0],
+			config:    config,
+			err:       "period 3 not covered by historical data",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := utilcapella.VerifyHistoricalBlockRoot(historicalRoots, historicalSummaries, test.batch, test.slot, test.blockRoot, test.config)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHistoricalConfigFromSpec(t *testing.T) {
+	chainSpec := map[string]interface{}{
+		"SLOTS_PER_HISTORICAL_ROOT": uint64(8192),
+	}
+	config, err := utilcapella.HistoricalConfigFromSpec(chainSpec)
+	require.NoError(t, err)
+	require.Equal(t, &utilcapella.HistoricalConfig{
+		SlotsPerHistoricalRoot: 8192,
+	}, config)
+
+	chainSpec["SLOTS_PER_HISTORICAL_ROOT"] = "8192"
+	_, err = utilcapella.HistoricalConfigFromSpec(chainSpec)
+	require.EqualError(t, err, "SLOTS_PER_HISTORICAL_ROOT of unexpected type")
+
+	delete(chainSpec, "SLOTS_PER_HISTORICAL_ROOT")
+	_, err = utilcapella.HistoricalConfigFromSpec(chainSpec)
+	require.EqualError(t, err, "SLOTS_PER_HISTORICAL_ROOT not found in spec")
+}